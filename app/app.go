@@ -2,26 +2,35 @@ package app
 
 import (
 	"context"
-	"log"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
-	"github.com/gookit/color"
 	blk "github.com/itzmeanjan/ette/app/block"
 	cfg "github.com/itzmeanjan/ette/app/config"
-	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/grpc"
+	"github.com/itzmeanjan/ette/app/metrics"
 
 	"github.com/itzmeanjan/ette/app/rest"
 	ss "github.com/itzmeanjan/ette/app/snapshot"
+	"github.com/itzmeanjan/ette/app/tracing"
+	"github.com/itzmeanjan/ette/app/webhook"
+	log "github.com/sirupsen/logrus"
 )
 
 // Run - Application to be invoked from main runner using this function
 func Run(configFile, subscriptionPlansFile string) {
 
 	ctx, cancel := context.WithCancel(context.Background())
-	_connection, _redisClient, _redisInfo, _db, _status, _queue := bootstrap(configFile, subscriptionPlansFile)
+	_connection, _, _redisInfo, _db, _status, _queue := bootstrap(configFile, subscriptionPlansFile)
+
+	// Exporting spans, when asked for, which are
+	// flushed while shutting down
+	flushSpans, err := tracing.Setup(ctx)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to set up tracing")
+	}
 
 	// Attempting to listen to Ctrl+C signal
 	// and when received gracefully shutting down `ette`
@@ -43,34 +52,32 @@ func Run(configFile, subscriptionPlansFile string) {
 		// @note This can ( needs to ) be improved
 		cancel()
 
-		sql, err := _db.DB()
-		if err != nil {
-			log.Print(color.Red.Sprintf("[!] Failed to get underlying DB connection : %s", err.Error()))
-			return
+		if err := flushSpans(context.Background()); err != nil {
+			log.WithError(err).Error("Failed to flush spans")
 		}
 
-		if err := sql.Close(); err != nil {
-			log.Print(color.Red.Sprintf("[!] Failed to close underlying DB connection : %s", err.Error()))
+		if err := _db.Close(); err != nil {
+			log.WithError(err).Error("Failed to close underlying DB connection")
 			return
 		}
 
 		if err := _redisInfo.Client.Close(); err != nil {
-			log.Print(color.Red.Sprintf("[!] Failed to close connection to Redis : %s", err.Error()))
+			log.WithError(err).Error("Failed to close connection to Redis")
 			return
 		}
 
 		// Stopping process
-		log.Print(color.Magenta.Sprintf("\n[+] Gracefully shut down `ette`"))
+		log.Info("Gracefully shut down `ette`")
 		os.Exit(0)
 
 	}()
 
 	// User has requested `ette` to take a snapshot of current database state
-	if cfg.Get("EtteMode") == "4" {
+	if cfg.Current().EtteMode == 4 {
 
 		// checking if there's anything to snapshot or not
 		if _status.BlockCountInDB() == 0 {
-			log.Printf("[*] Nothing to snapshot\n")
+			log.Info("Nothing to snapshot")
 			return
 		}
 
@@ -78,14 +85,14 @@ func Run(configFile, subscriptionPlansFile string) {
 		_snapshotFile := cfg.GetSnapshotFile()
 		_start := time.Now().UTC()
 
-		log.Printf("[*] Starting snapshotting at : %s [ Sink : %s ]\n", _start, _snapshotFile)
+		log.WithField("sink", _snapshotFile).Info("Starting snapshotting")
 
 		// taking snapshot, this might take some time
-		_ret := ss.TakeSnapshot(_db, _snapshotFile, db.GetCurrentOldestBlockNumber(_db), db.GetCurrentBlockNumber(_db), _status.BlockCountInDB())
+		_ret := ss.TakeSnapshot(_db, _snapshotFile, _db.GetCurrentOldestBlockNumber(), _db.GetCurrentBlockNumber(), _status.BlockCountInDB())
 		if _ret {
-			log.Print(color.Green.Sprintf("[+] Snapshotted in : %s [ Count : %d ]", time.Now().UTC().Sub(_start), _status.BlockCountInDB()))
+			log.WithFields(log.Fields{"took": time.Now().UTC().Sub(_start).String(), "count": _status.BlockCountInDB()}).Info("Snapshotted")
 		} else {
-			log.Print(color.Red.Sprintf("[!] Snapshotting failed in : %s", time.Now().UTC().Sub(_start)))
+			log.WithField("took", time.Now().UTC().Sub(_start).String()).Error("Snapshotting failed")
 		}
 
 		return
@@ -95,23 +102,61 @@ func Run(configFile, subscriptionPlansFile string) {
 	// User has asked `ette` to attempt to restore from snapshotted data
 	// where data file is `snapshot.bin` in current working directory,
 	// if nothing specified for `SnapshotFile` variable in `.env`
-	if cfg.Get("EtteMode") == "5" {
+	if cfg.Current().EtteMode == 5 {
 
 		_snapshotFile := cfg.GetSnapshotFile()
 		_start := time.Now().UTC()
 
-		log.Printf("[*] Starting snapshot restoring at : %s [ Sink : %s ]\n", _start, _snapshotFile)
+		log.WithField("sink", _snapshotFile).Info("Starting snapshot restoring")
 
 		_, _count := ss.RestoreFromSnapshot(_db, _snapshotFile)
 
-		log.Print(color.Green.Sprintf("[+] Restored from snapshot in : %s [ Count : %d ]", time.Now().UTC().Sub(_start), _count))
+		log.WithFields(log.Fields{"took": time.Now().UTC().Sub(_start).String(), "count": _count}).Info("Restored from snapshot")
 
 		return
 
 	}
 
+	// Re-reading subscription plans & reloadable configuration
+	// on SIGHUP, without restarting `ette`
+	hangupChan := make(chan os.Signal, 1)
+	signal.Notify(hangupChan, syscall.SIGHUP)
+
+	go func() {
+
+		for range hangupChan {
+			reload(_db, configFile, subscriptionPlansFile)
+		}
+
+	}()
+
 	go _queue.Start(ctx)
 
+	// Exposing sync progress & queue state, to be read when
+	// metrics are scraped
+	metrics.RegisterStatus(_status)
+	metrics.RegisterQueue(_queue)
+
+	// Creating partitions as chain grows & dropping ones
+	// beyond retention, when partitioning is enabled
+	go _db.ManagePartitions(ctx, _status)
+
+	// Dropping per sender tx counts of chain stats rollups, once
+	// their intervals are final
+	go _db.ManageChainStats(ctx, _status)
+
+	// Delivering real-time data to webhooks, registered by users,
+	// same as it's delivered to websocket clients
+	if cfg.Current().RealtimeModeEnabled() {
+		go webhook.Start(ctx, _db, _redisInfo)
+	}
+
+	// Serving lookups & subscriptions over gRPC, along with
+	// REST/ GraphQL/ websocket, when asked for
+	if cfg.Current().GRPCEnabled() {
+		go grpc.RunGRPCServer(ctx, _db, _redisInfo)
+	}
+
 	// Pushing block header propagation listener to another thread of execution
 	go blk.SubscribeToNewBlocks(_connection, _db, _status, _redisInfo, _queue)
 
@@ -122,6 +167,8 @@ func Run(configFile, subscriptionPlansFile string) {
 	// go srv.DeliveryHistoryCleanUpService(_db)
 
 	// Starting http server on main thread
-	rest.RunHTTPServer(_db, _status, _redisClient)
+	rest.RunHTTPServer(_db, _status, _redisInfo, _connection, func() ([]string, error) {
+		return reload(_db, configFile, subscriptionPlansFile)
+	})
 
 }
@@ -1,7 +1,7 @@
 package block
 
 import (
-	"log"
+	"context"
 	"runtime"
 	"time"
 
@@ -11,12 +11,19 @@ import (
 	cfg "github.com/itzmeanjan/ette/app/config"
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
 	q "github.com/itzmeanjan/ette/app/queue"
-	"gorm.io/gorm"
+	"github.com/itzmeanjan/ette/app/tracing"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/label"
 )
 
 // ProcessBlockContent - Processes everything inside this block i.e. block data, tx data, event data
-func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm.DB, redis *d.RedisInfo, publishable bool, queue *q.BlockProcessorQueue, status *d.StatusHolder, startingAt time.Time) bool {
+func ProcessBlockContent(ctx context.Context, client *ethclient.Client, block *types.Block, _db db.Store, redis *d.RedisInfo, publishable bool, queue *q.BlockProcessorQueue, status *d.StatusHolder, startingAt time.Time) bool {
+
+	// Everything logged while processing this block carries its
+	// number & hash, so that it can be correlated
+	_log := log.WithFields(log.Fields{"block": block.NumberU64(), "hash": block.Hash().Hex()})
 
 	// Closure managing publishing whole block data i.e. block header, txn(s), event logs
 	// on redis pubsub channel
@@ -29,7 +36,7 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 		//
 		// Attempting to publish whole block data to redis pubsub channel
 		// when eligible `EtteMode` is set
-		if publishable && (cfg.Get("EtteMode") == "2" || cfg.Get("EtteMode") == "3") {
+		if publishable && cfg.Current().RealtimeModeEnabled() {
 
 			// 1. Asking queue whether we need to publish block or not
 			if !queue.CanPublish(block.NumberU64()) {
@@ -37,7 +44,7 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 			}
 
 			// 2. Attempting to publish block on Pub/Sub topic
-			if !PublishBlock(packedBlock, redis) {
+			if !PublishBlock(ctx, packedBlock, redis) {
 				return nil, false
 			}
 
@@ -55,6 +62,8 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 
 	if block.Transactions().Len() == 0 {
 
+		metrics.BlockFetched(time.Now().UTC().Sub(startingAt))
+
 		// Constructing block data to be persisted
 		//
 		// This is what we just published on pubsub channel
@@ -67,26 +76,31 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 		// pubsub channel, no need to persist data
 		//
 		// We simply publish & return from execution scope
-		if !(cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "3") {
+		if !cfg.Current().HistoricalModeEnabled() {
 
-			log.Printf("✅ Block %d with 0 tx(s) [ Took : %s ]\n", block.NumberU64(), time.Now().UTC().Sub(startingAt))
+			_log.WithFields(log.Fields{"txs": 0, "took": time.Now().UTC().Sub(startingAt).String()}).Info("Processed block")
 			status.IncrementBlocksProcessed()
+			metrics.BlockProcessed(block.NumberU64())
 
 			return true
 
 		}
 
 		// If block doesn't contain any tx, we'll attempt to persist only block
-		if err := db.StoreBlock(_db, packedBlock, status, queue); err != nil {
+		storingAt := time.Now().UTC()
+		if err := storeBlock(ctx, _db, packedBlock, status, queue); err != nil {
 
-			log.Printf("❗️ Failed to process block %d : %s\n", block.NumberU64(), err.Error())
+			_log.WithError(err).Error("Failed to process block")
 			return false
 
 		}
 
+		metrics.BlockStored(time.Now().UTC().Sub(storingAt))
+
 		// Successfully processed block
-		log.Printf("✅ Block %d with 0 tx(s) [ Took : %s ]\n", block.NumberU64(), time.Now().UTC().Sub(startingAt))
+		_log.WithFields(log.Fields{"txs": 0, "took": time.Now().UTC().Sub(startingAt).String()}).Info("Processed block")
 		status.IncrementBlocksProcessed()
+		metrics.BlockProcessed(block.NumberU64())
 
 		return true
 
@@ -111,7 +125,8 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 		func(tx *types.Transaction) {
 			wp.Submit(func() {
 
-				FetchTransactionByHash(client,
+				FetchTransactionByHash(ctx,
+					client,
 					block,
 					tx,
 					_db,
@@ -162,6 +177,8 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 		return false
 	}
 
+	metrics.BlockFetched(time.Now().UTC().Sub(startingAt))
+
 	// Constructing block data to be persisted
 	//
 	// This is what we just published on pubsub channel
@@ -174,27 +191,49 @@ func ProcessBlockContent(client *ethclient.Client, block *types.Block, _db *gorm
 	// pubsub channel, no need to persist data
 	//
 	// We simply publish & return from execution scope
-	if !(cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "3") {
+	if !cfg.Current().HistoricalModeEnabled() {
 
-		log.Printf("✅ Block %d with %d tx(s) [ Took : %s ]\n", block.NumberU64(), block.Transactions().Len(), time.Now().UTC().Sub(startingAt))
+		_log.WithFields(log.Fields{"txs": block.Transactions().Len(), "took": time.Now().UTC().Sub(startingAt).String()}).Info("Processed block")
 		status.IncrementBlocksProcessed()
+		metrics.BlockProcessed(block.NumberU64())
 
 		return true
 
 	}
 
 	// If block doesn't contain any tx, we'll attempt to persist only block
-	if err := db.StoreBlock(_db, packedBlock, status, queue); err != nil {
+	storingAt := time.Now().UTC()
+	if err := storeBlock(ctx, _db, packedBlock, status, queue); err != nil {
 
-		log.Printf("❗️ Failed to process block %d : %s\n", block.NumberU64(), err.Error())
+		_log.WithError(err).Error("Failed to process block")
 		return false
 
 	}
 
+	metrics.BlockStored(time.Now().UTC().Sub(storingAt))
+
 	// Successfully processed block
-	log.Printf("✅ Block %d with %d tx(s) [ Took : %s ]\n", block.NumberU64(), block.Transactions().Len(), time.Now().UTC().Sub(startingAt))
+	_log.WithFields(log.Fields{"txs": block.Transactions().Len(), "took": time.Now().UTC().Sub(startingAt).String()}).Info("Processed block")
 
 	status.IncrementBlocksProcessed()
+	metrics.BlockProcessed(block.NumberU64())
+
 	return true
 
 }
+
+// storeBlock - Persists whole block, in single database tx, recording
+// how long it took, as span
+func storeBlock(ctx context.Context, _db db.Store, block *db.PackedBlock, status *d.StatusHolder, queue *q.BlockProcessorQueue) error {
+
+	ctx, span := tracing.Start(ctx, "StoreBlock", label.Uint64("block.number", block.Block.Number), label.String("block.hash", block.Block.Hash))
+	defer span.End()
+
+	err := _db.StoreBlock(block, status, queue)
+	if err != nil {
+		tracing.Fail(ctx, err)
+	}
+
+	return err
+
+}
@@ -2,7 +2,7 @@ package block
 
 import (
 	"context"
-	"log"
+	"errors"
 	"math/big"
 	"time"
 
@@ -11,57 +11,98 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
 	q "github.com/itzmeanjan/ette/app/queue"
-	"gorm.io/gorm"
+	"github.com/itzmeanjan/ette/app/tracing"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // FetchBlockByHash - Fetching block content using blockHash
-func FetchBlockByHash(client *ethclient.Client, hash common.Hash, number string, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, _status *d.StatusHolder) bool {
+func FetchBlockByHash(client *ethclient.Client, hash common.Hash, number string, _db db.Store, redis *d.RedisInfo, queue *q.BlockProcessorQueue, _status *d.StatusHolder) bool {
 
 	// Starting block processing at
 	startingAt := time.Now().UTC()
 
-	block, err := client.BlockByHash(context.Background(), hash)
+	// Root span, covering whole processing of this block i.e.
+	// fetching, storing & publishing
+	ctx, span := tracing.Start(context.Background(), "FetchBlockByHash", label.String("block.number", number), label.String("block.hash", hash.Hex()))
+	defer span.End()
+
+	block, err := client.BlockByHash(ctx, hash)
 	if err != nil {
 
-		log.Printf("❗️ Failed to fetch block %s : %s\n", number, err.Error())
+		metrics.RPCFailed("eth_getBlockByHash")
+		tracing.Fail(ctx, err)
+
+		log.WithError(err).WithFields(log.Fields{"block": number, "hash": hash.Hex()}).Error("Failed to fetch block")
 		return false
 
 	}
 
-	return ProcessBlockContent(client, block, _db, redis, true, queue, _status, startingAt)
+	return processBlock(ctx, client, block, _db, redis, true, queue, _status, startingAt)
 
 }
 
 // FetchBlockByNumber - Fetching block content using block number
-func FetchBlockByNumber(client *ethclient.Client, number uint64, _db *gorm.DB, redis *d.RedisInfo, publishable bool, queue *q.BlockProcessorQueue, _status *d.StatusHolder) bool {
+func FetchBlockByNumber(client *ethclient.Client, number uint64, _db db.Store, redis *d.RedisInfo, publishable bool, queue *q.BlockProcessorQueue, _status *d.StatusHolder) bool {
 
 	// Starting block processing at
 	startingAt := time.Now().UTC()
 
+	// Root span, covering whole processing of this block i.e.
+	// fetching, storing & publishing
+	ctx, span := tracing.Start(context.Background(), "FetchBlockByNumber", label.Uint64("block.number", number))
+	defer span.End()
+
 	_num := big.NewInt(0)
 	_num.SetUint64(number)
 
-	block, err := client.BlockByNumber(context.Background(), _num)
+	block, err := client.BlockByNumber(ctx, _num)
 	if err != nil {
 
-		log.Printf("❗️ Failed to fetch block %d : %s\n", number, err)
+		metrics.RPCFailed("eth_getBlockByNumber")
+		tracing.Fail(ctx, err)
+
+		log.WithError(err).WithField("block", number).Error("Failed to fetch block")
 		return false
 
 	}
 
-	return ProcessBlockContent(client, block, _db, redis, publishable, queue, _status, startingAt)
+	return processBlock(ctx, client, block, _db, redis, publishable, queue, _status, startingAt)
+
+}
+
+// processBlock - Processes fetched block, marking span in context
+// failed, when processing fails
+func processBlock(ctx context.Context, client *ethclient.Client, block *types.Block, _db db.Store, redis *d.RedisInfo, publishable bool, queue *q.BlockProcessorQueue, _status *d.StatusHolder, startingAt time.Time) bool {
+
+	trace.SpanFromContext(ctx).SetAttributes(label.String("block.hash", block.Hash().Hex()), label.Int("txs", block.Transactions().Len()))
+
+	if !ProcessBlockContent(ctx, client, block, _db, redis, publishable, queue, _status, startingAt) {
+		tracing.Fail(ctx, errors.New("Failed to process block"))
+		return false
+	}
+
+	return true
 
 }
 
 // FetchTransactionByHash - Fetching specific transaction related data, tries to publish data if required
 // & lets listener go routine know about all tx, event data it collected while processing this tx,
 // which will be attempted to be stored in database
-func FetchTransactionByHash(client *ethclient.Client, block *types.Block, tx *types.Transaction, _db *gorm.DB, redis *d.RedisInfo, publishable bool, _status *d.StatusHolder, returnValChan chan *db.PackedTransaction) {
+func FetchTransactionByHash(ctx context.Context, client *ethclient.Client, block *types.Block, tx *types.Transaction, _db db.Store, redis *d.RedisInfo, publishable bool, _status *d.StatusHolder, returnValChan chan *db.PackedTransaction) {
 
-	receipt, err := client.TransactionReceipt(context.Background(), tx.Hash())
+	ctx, span := tracing.Start(ctx, "FetchTransactionByHash", label.Uint64("block.number", block.NumberU64()), label.String("tx.hash", tx.Hash().Hex()))
+	defer span.End()
+
+	receipt, err := client.TransactionReceipt(ctx, tx.Hash())
 	if err != nil {
-		log.Printf("❗️ Failed to fetch tx receipt [ block : %d ] : %s\n", block.NumberU64(), err.Error())
+		metrics.RPCFailed("eth_getTransactionReceipt")
+		tracing.Fail(ctx, err)
+
+		log.WithError(err).WithFields(log.Fields{"block": block.NumberU64(), "hash": block.Hash().Hex(), "tx": tx.Hash().Hex()}).Error("Failed to fetch tx receipt")
 
 		// Passing nil, to denote, failed to fetch all tx data
 		// from blockchain node
@@ -69,9 +110,12 @@ func FetchTransactionByHash(client *ethclient.Client, block *types.Block, tx *ty
 		return
 	}
 
-	sender, err := client.TransactionSender(context.Background(), tx, block.Hash(), receipt.TransactionIndex)
+	sender, err := client.TransactionSender(ctx, tx, block.Hash(), receipt.TransactionIndex)
 	if err != nil {
-		log.Printf("❗️ Failed to fetch tx sender [ block : %d ] : %s\n", block.NumberU64(), err.Error())
+		metrics.RPCFailed("eth_getTransactionByBlockHashAndIndex")
+		tracing.Fail(ctx, err)
+
+		log.WithError(err).WithFields(log.Fields{"block": block.NumberU64(), "hash": block.Hash().Hex(), "tx": tx.Hash().Hex()}).Error("Failed to fetch tx sender")
 
 		// Passing nil, to denote, failed to fetch all tx data
 		// from blockchain node
@@ -3,7 +3,6 @@ package block
 import (
 	"context"
 	"fmt"
-	"log"
 	"runtime"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -11,19 +10,20 @@ import (
 	"github.com/gammazero/workerpool"
 	cfg "github.com/itzmeanjan/ette/app/config"
 	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
 	q "github.com/itzmeanjan/ette/app/queue"
-	"gorm.io/gorm"
+	log "github.com/sirupsen/logrus"
 )
 
 // SubscribeToNewBlocks - Listen for event when new block header is
 // available, then fetch block content ( including all transactions )
 // in different worker
-func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB, status *d.StatusHolder, redis *d.RedisInfo, queue *q.BlockProcessorQueue) {
+func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db db.Store, status *d.StatusHolder, redis *d.RedisInfo, queue *q.BlockProcessorQueue) {
 	headerChan := make(chan *types.Header)
 
 	subs, err := connection.Websocket.SubscribeNewHead(context.Background(), headerChan)
 	if err != nil {
-		log.Fatalf("❗️ Failed to subscribe to block headers : %s\n", err.Error())
+		log.WithError(err).Fatal("Failed to subscribe to block headers")
 	}
 	// Scheduling unsubscribe, to be executed when end of this execution scope is reached
 	defer subs.Unsubscribe()
@@ -43,7 +43,7 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 		select {
 		case err := <-subs.Err():
 
-			log.Fatalf("❗️ Listener stopped : %s\n", err.Error())
+			log.WithError(err).Fatal("Listener stopped")
 
 		case header := <-headerChan:
 
@@ -51,7 +51,7 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 			// should be greater than max block number obtained from DB
 			if first && !(header.Number.Uint64() > status.MaxBlockNumberAtStartUp()) {
 
-				log.Fatalf("❗️ Bad block received : expected > `%d`\n", status.MaxBlockNumberAtStartUp())
+				log.WithFields(log.Fields{"block": header.Number.Uint64(), "hash": header.Hash().Hex(), "expected_above": status.MaxBlockNumberAtStartUp()}).Fatal("Bad block received")
 
 			}
 
@@ -65,7 +65,7 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 			// It can be improved.
 			if !first && header.Number.Uint64() > status.GetLatestBlockNumber()+1 {
 
-				log.Fatalf("❗️ Bad block received %d, expected %d\n", header.Number.Uint64(), status.GetLatestBlockNumber())
+				log.WithFields(log.Fields{"block": header.Number.Uint64(), "hash": header.Hash().Hex(), "expected": status.GetLatestBlockNumber() + 1}).Fatal("Bad block received")
 
 			}
 
@@ -74,11 +74,11 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 			// reorganization, we'll attempt to process this new block
 			if !first && !(header.Number.Uint64() == status.GetLatestBlockNumber()+1) {
 
-				log.Printf("🔅 Received block %d again, expected %d\n", header.Number.Uint64(), status.GetLatestBlockNumber()+1)
+				log.WithFields(log.Fields{"block": header.Number.Uint64(), "hash": header.Hash().Hex(), "expected": status.GetLatestBlockNumber() + 1}).Warn("Received block again")
 
 			} else {
 
-				log.Printf("🔆 Received block %d\n", header.Number.Uint64())
+				log.WithFields(log.Fields{"block": header.Number.Uint64(), "hash": header.Hash().Hex()}).Info("Received block")
 
 			}
 
@@ -97,7 +97,7 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 
 				// If historical data query features are enabled
 				// only then we need to sync to latest state of block chain
-				if cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "3" {
+				if cfg.Current().HistoricalModeEnabled() {
 
 					// Starting syncer in another thread, where it'll keep fetching
 					// blocks from highest block number it fetched last time to current network block number
@@ -119,6 +119,11 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 						to = status.MaxBlockNumberAtStartUp() - cfg.GetBlockConfirmations()
 					}
 
+					// Blocks below lowest retained one are not to be fetched
+					if retainedFrom := db.RetainedFrom(header.Number.Uint64()); to < retainedFrom {
+						to = retainedFrom
+					}
+
 					go SyncBlocksByRange(connection.RPC, _db, redis, queue, from, to, status)
 
 				}
@@ -144,13 +149,13 @@ func SubscribeToNewBlocks(connection *d.BlockChainNodeConnection, _db *gorm.DB,
 				// no need to check what's present in unfinalized block number queue
 				// because no finality feature is provided for blocks on websocket based
 				// real-time subscription mechanism
-				if cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "3" {
+				if cfg.Current().HistoricalModeEnabled() {
 
 					// Next block which can be attempted to be checked
 					// while finally considering it confirmed & put into DB
 					if nxt, ok := _queue.ConfirmedNext(); ok {
 
-						log.Printf("🔅 Processing finalised block %d [ Latest Block : %d ]\n", nxt, status.GetLatestBlockNumber())
+						log.WithFields(log.Fields{"block": nxt, "latest": status.GetLatestBlockNumber()}).Debug("Processing finalised block")
 
 						// Taking `oldest` variable's copy in local scope of closure, so that during
 						// iteration over queue elements, none of them get missed, becuase we're
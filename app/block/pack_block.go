@@ -3,6 +3,7 @@ package block
 import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
 )
 
@@ -17,7 +18,7 @@ func BuildPackedBlock(block *types.Block, txs []*db.PackedTransaction) *db.Packe
 		Number:              block.NumberU64(),
 		Time:                block.Time(),
 		ParentHash:          block.ParentHash().Hex(),
-		Difficulty:          block.Difficulty().String(),
+		Difficulty:          d.Numeric(block.Difficulty().String()),
 		GasUsed:             block.GasUsed(),
 		GasLimit:            block.GasLimit(),
 		Nonce:               hexutil.EncodeUint64(block.Nonce()),
@@ -28,9 +29,20 @@ func BuildPackedBlock(block *types.Block, txs []*db.PackedTransaction) *db.Packe
 		TransactionRootHash: block.TxHash().Hex(),
 		ReceiptRootHash:     block.ReceiptHash().Hex(),
 		ExtraData:           block.Extra(),
+		BaseFee:             baseFee(block),
 	}
 	packedBlock.Transactions = txs
 
 	return packedBlock
 
 }
+
+// baseFee - Base fee per gas of block, empty for blocks mined before
+// London hard fork i.e. EIP-1559
+func baseFee(block *types.Block) d.Numeric {
+	if block.BaseFee() == nil {
+		return ""
+	}
+
+	return d.Numeric(block.BaseFee().String())
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	c "github.com/itzmeanjan/ette/app/common"
+	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
 )
 
@@ -19,14 +20,15 @@ func BuildPackedTx(tx *types.Transaction, sender common.Address, receipt *types.
 			Hash:      tx.Hash().Hex(),
 			From:      sender.Hex(),
 			Contract:  receipt.ContractAddress.Hex(),
-			Value:     tx.Value().String(),
+			Value:     d.Numeric(tx.Value().String()),
 			Data:      tx.Data(),
 			Gas:       tx.Gas(),
-			GasPrice:  tx.GasPrice().String(),
-			Cost:      tx.Cost().String(),
+			GasPrice:  d.Numeric(tx.GasPrice().String()),
+			Cost:      d.Numeric(tx.Cost().String()),
 			Nonce:     tx.Nonce(),
 			State:     receipt.Status,
 			BlockHash: receipt.BlockHash.Hex(),
+			TxIndex:   receipt.TransactionIndex,
 		}
 
 	} else {
@@ -35,14 +37,15 @@ func BuildPackedTx(tx *types.Transaction, sender common.Address, receipt *types.
 			Hash:      tx.Hash().Hex(),
 			From:      sender.Hex(),
 			To:        tx.To().Hex(),
-			Value:     tx.Value().String(),
+			Value:     d.Numeric(tx.Value().String()),
 			Data:      tx.Data(),
 			Gas:       tx.Gas(),
-			GasPrice:  tx.GasPrice().String(),
-			Cost:      tx.Cost().String(),
+			GasPrice:  d.Numeric(tx.GasPrice().String()),
+			Cost:      d.Numeric(tx.Cost().String()),
 			Nonce:     tx.Nonce(),
 			State:     receipt.Status,
 			BlockHash: receipt.BlockHash.Hex(),
+			TxIndex:   receipt.TransactionIndex,
 		}
 
 	}
@@ -2,19 +2,24 @@ package block
 
 import (
 	"context"
-	"log"
 
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/tracing"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/label"
 )
 
 // PublishBlock - Attempts to publish block data to Redis pubsub channel
-func PublishBlock(block *db.PackedBlock, redis *d.RedisInfo) bool {
+func PublishBlock(ctx context.Context, block *db.PackedBlock, redis *d.RedisInfo) bool {
 
 	if block == nil {
 		return false
 	}
 
+	ctx, span := tracing.Start(ctx, "PublishBlock", label.Uint64("block.number", block.Block.Number), label.String("block.hash", block.Block.Hash))
+	defer span.End()
+
 	_block := &d.Block{
 		Hash:                block.Block.Hash,
 		Number:              block.Block.Number,
@@ -33,15 +38,16 @@ func PublishBlock(block *db.PackedBlock, redis *d.RedisInfo) bool {
 		ExtraData:           block.Block.ExtraData,
 	}
 
-	if err := redis.Client.Publish(context.Background(), redis.BlockPublishTopic, _block).Err(); err != nil {
+	if err := redis.Client.Publish(ctx, redis.BlockPublishTopic, _block).Err(); err != nil {
 
-		log.Printf("❗️ Failed to publish block %d : %s\n", block.Block.Number, err.Error())
+		tracing.Fail(ctx, err)
+		log.WithError(err).WithFields(log.Fields{"block": block.Block.Number, "hash": block.Block.Hash}).Error("Failed to publish block")
 		return false
 
 	}
 
-	log.Printf("📎 Published block %d\n", block.Block.Number)
+	log.WithFields(log.Fields{"block": block.Block.Number, "hash": block.Block.Hash}).Debug("Published block")
 
-	return PublishTxs(block.Block.Number, block.Transactions, redis)
+	return PublishTxs(ctx, block.Block.Number, block.Transactions, redis)
 
 }
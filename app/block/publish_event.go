@@ -2,25 +2,30 @@ package block
 
 import (
 	"context"
-	"log"
 
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/tracing"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/label"
 )
 
 // PublishEvents - Iterate over all events & try to publish them on
 // redis pubsub channel
-func PublishEvents(blockNumber uint64, events []*db.Events, redis *d.RedisInfo) bool {
+func PublishEvents(ctx context.Context, blockNumber uint64, events []*db.Events, redis *d.RedisInfo) bool {
 
 	if events == nil {
 		return false
 	}
 
+	ctx, span := tracing.Start(ctx, "PublishEvents", label.Uint64("block.number", blockNumber), label.Int("events", len(events)))
+	defer span.End()
+
 	var status bool
 
 	for _, e := range events {
 
-		status = PublishEvent(blockNumber, e, redis)
+		status = PublishEvent(ctx, blockNumber, e, redis)
 		if !status {
 			break
 		}
@@ -34,7 +39,7 @@ func PublishEvents(blockNumber uint64, events []*db.Events, redis *d.RedisInfo)
 // PublishEvent - Publishing event/ log entry to redis pub-sub topic, to be captured by subscribers
 // and sent to client application, who are interested in this piece of data
 // after applying filter
-func PublishEvent(blockNumber uint64, event *db.Events, redis *d.RedisInfo) bool {
+func PublishEvent(ctx context.Context, blockNumber uint64, event *db.Events, redis *d.RedisInfo) bool {
 
 	if event == nil {
 		return false
@@ -49,9 +54,10 @@ func PublishEvent(blockNumber uint64, event *db.Events, redis *d.RedisInfo) bool
 		BlockHash:       event.BlockHash,
 	}
 
-	if err := redis.Client.Publish(context.Background(), redis.EventPublishTopic, data).Err(); err != nil {
+	if err := redis.Client.Publish(ctx, redis.EventPublishTopic, data).Err(); err != nil {
 
-		log.Printf("❗️ Failed to publish event from block %d : %s\n", blockNumber, err.Error())
+		tracing.Fail(ctx, err)
+		log.WithError(err).WithFields(log.Fields{"block": blockNumber, "hash": event.BlockHash, "tx": event.TransactionHash, "index": event.Index}).Error("Failed to publish event")
 		return false
 
 	}
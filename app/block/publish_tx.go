@@ -2,26 +2,31 @@ package block
 
 import (
 	"context"
-	"log"
 
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/tracing"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/label"
 )
 
 // PublishTxs - Publishes all transactions in a block to redis pubsub
 // channel
-func PublishTxs(blockNumber uint64, txs []*db.PackedTransaction, redis *d.RedisInfo) bool {
+func PublishTxs(ctx context.Context, blockNumber uint64, txs []*db.PackedTransaction, redis *d.RedisInfo) bool {
 
 	if txs == nil {
 		return false
 	}
 
+	ctx, span := tracing.Start(ctx, "PublishTxs", label.Uint64("block.number", blockNumber), label.Int("txs", len(txs)))
+	defer span.End()
+
 	var eventCount uint64
 	var status bool
 
 	for _, t := range txs {
 
-		status = PublishTx(blockNumber, t, redis)
+		status = PublishTx(ctx, blockNumber, t, redis)
 		if !status {
 			break
 		}
@@ -35,8 +40,7 @@ func PublishTxs(blockNumber uint64, txs []*db.PackedTransaction, redis *d.RedisI
 		return status
 	}
 
-	log.Printf("📎 Published %d transactions of block %d\n", len(txs), blockNumber)
-	log.Printf("📎 Published %d events of block %d\n", eventCount, blockNumber)
+	log.WithFields(log.Fields{"block": blockNumber, "txs": len(txs), "events": eventCount}).Debug("Published transactions & events")
 
 	return status
 
@@ -44,7 +48,7 @@ func PublishTxs(blockNumber uint64, txs []*db.PackedTransaction, redis *d.RedisI
 
 // PublishTx - Publishes tx & events in tx, related data to respective
 // Redis pubsub channel
-func PublishTx(blockNumber uint64, tx *db.PackedTransaction, redis *d.RedisInfo) bool {
+func PublishTx(ctx context.Context, blockNumber uint64, tx *db.PackedTransaction, redis *d.RedisInfo) bool {
 
 	if tx == nil {
 		return false
@@ -84,13 +88,14 @@ func PublishTx(blockNumber uint64, tx *db.PackedTransaction, redis *d.RedisInfo)
 		}
 	}
 
-	if err := redis.Client.Publish(context.Background(), redis.TxPublishTopic, pTx).Err(); err != nil {
+	if err := redis.Client.Publish(ctx, redis.TxPublishTopic, pTx).Err(); err != nil {
 
-		log.Printf("❗️ Failed to publish transaction from block %d : %s\n", blockNumber, err.Error())
+		tracing.Fail(ctx, err)
+		log.WithError(err).WithFields(log.Fields{"block": blockNumber, "hash": tx.Tx.BlockHash, "tx": tx.Tx.Hash}).Error("Failed to publish transaction")
 		return false
 
 	}
 
-	return PublishEvents(blockNumber, tx.Events, redis)
+	return PublishEvents(ctx, blockNumber, tx.Events, redis)
 
 }
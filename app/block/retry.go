@@ -1,7 +1,6 @@
 package block
 
 import (
-	"log"
 	"runtime"
 	"time"
 
@@ -9,8 +8,9 @@ import (
 	"github.com/gammazero/workerpool"
 	cfg "github.com/itzmeanjan/ette/app/config"
 	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
 	q "github.com/itzmeanjan/ette/app/queue"
-	"gorm.io/gorm"
+	log "github.com/sirupsen/logrus"
 )
 
 // RetryQueueManager - Pop oldest block number from Redis backed retry
@@ -19,7 +19,7 @@ import (
 // Sleeps for 1000 milliseconds
 //
 // Keeps repeating
-func RetryQueueManager(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
+func RetryQueueManager(client *ethclient.Client, _db db.Store, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
 	sleep := func() {
 		time.Sleep(time.Duration(512) * time.Millisecond)
 	}
@@ -38,7 +38,14 @@ func RetryQueueManager(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInf
 		}
 
 		stat := queue.Stat()
-		log.Printf("ℹ️ Retrying block : %d [ Unconfirmed : ( Progress : %d, Waiting : %d ) | Confirmed : ( Progress : %d, Waiting : %d ) | Total : %d ]\n", block, stat.UnconfirmedProgress, stat.UnconfirmedWaiting, stat.ConfirmedProgress, stat.ConfirmedWaiting, stat.Total)
+		log.WithFields(log.Fields{
+			"block":                block,
+			"unconfirmed_progress": stat.UnconfirmedProgress,
+			"unconfirmed_waiting":  stat.UnconfirmedWaiting,
+			"confirmed_progress":   stat.ConfirmedProgress,
+			"confirmed_waiting":    stat.ConfirmedWaiting,
+			"total":                stat.Total,
+		}).Info("Retrying block")
 
 		// Submitting block processor job into pool
 		// which will be picked up & processed
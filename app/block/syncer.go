@@ -1,19 +1,17 @@
 package block
 
 import (
-	"log"
 	"runtime"
 	"sort"
 	"time"
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/gammazero/workerpool"
-	"github.com/gookit/color"
 	cfg "github.com/itzmeanjan/ette/app/config"
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
 	q "github.com/itzmeanjan/ette/app/queue"
-	"gorm.io/gorm"
+	log "github.com/sirupsen/logrus"
 )
 
 // FindMissingBlocksInRange - Given ascending ordered block numbers read from DB
@@ -45,9 +43,9 @@ func FindMissingBlocksInRange(found []uint64, from uint64, to uint64) []uint64 {
 // while running n workers concurrently, where n = number of cores this machine has
 //
 // Waits for all of them to complete
-func Syncer(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder, jd func(*workerpool.WorkerPool, *d.Job, *q.BlockProcessorQueue)) {
+func Syncer(client *ethclient.Client, _db db.Store, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder, jd func(*workerpool.WorkerPool, *d.Job, *q.BlockProcessorQueue)) {
 	if !(fromBlock <= toBlock) {
-		log.Print(color.Red.Sprintf("[!] Bad block range for syncer"))
+		log.WithFields(log.Fields{"from": fromBlock, "to": toBlock}).Error("Bad block range for syncer")
 		return
 	}
 
@@ -58,7 +56,6 @@ func Syncer(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q
 	job := func(num uint64) {
 		jd(wp, &d.Job{
 			Client: client,
-			DB:     _db,
 			Redis:  redis,
 			Block:  num,
 			Status: status,
@@ -77,7 +74,7 @@ func Syncer(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q
 			toShouldbe = toBlock
 		}
 
-		blocks := db.GetAllBlockNumbersInRange(_db, i, toShouldbe)
+		blocks := _db.GetAllBlockNumbersInRange(i, toShouldbe)
 
 		// No blocks present in DB, in queried range
 		if len(blocks) == 0 {
@@ -112,7 +109,7 @@ func Syncer(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q
 //
 // Range can be either ascending or descending, depending upon that proper arguments to be
 // passed to `Syncer` function during invokation
-func SyncBlocksByRange(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder) {
+func SyncBlocksByRange(client *ethclient.Client, _db db.Store, redis *d.RedisInfo, queue *q.BlockProcessorQueue, fromBlock uint64, toBlock uint64, status *d.StatusHolder) {
 
 	// Job to be submitted and executed by each worker
 	//
@@ -125,7 +122,7 @@ func SyncBlocksByRange(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInf
 				return
 			}
 
-			if !FetchBlockByNumber(j.Client, j.Block, j.DB, j.Redis, false, queue, j.Status) {
+			if !FetchBlockByNumber(j.Client, j.Block, _db, j.Redis, false, queue, j.Status) {
 				queue.UnconfirmedFailed(j.Block)
 				return
 			}
@@ -135,7 +132,7 @@ func SyncBlocksByRange(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInf
 		})
 	}
 
-	log.Printf("✅ Starting block syncer\n")
+	log.WithFields(log.Fields{"from": fromBlock, "to": toBlock}).Info("Starting block syncer")
 
 	if fromBlock < toBlock {
 		Syncer(client, _db, redis, queue, fromBlock, toBlock, status, job)
@@ -143,7 +140,7 @@ func SyncBlocksByRange(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInf
 		Syncer(client, _db, redis, queue, toBlock, fromBlock, status, job)
 	}
 
-	log.Printf("✅ Stopping block syncer\n")
+	log.Info("Stopping block syncer")
 
 	// Once completed first iteration of processing blocks upto last time where it left
 	// off, we're going to start worker to look at DB & decide which blocks are missing
@@ -157,21 +154,25 @@ func SyncBlocksByRange(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInf
 
 // SyncMissingBlocksInDB - Checks with database for what blocks are present & what are not, fetches missing
 // blocks & related data iteratively
-func SyncMissingBlocksInDB(client *ethclient.Client, _db *gorm.DB, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
+func SyncMissingBlocksInDB(client *ethclient.Client, _db db.Store, redis *d.RedisInfo, queue *q.BlockProcessorQueue, status *d.StatusHolder) {
 
 	for {
 
-		log.Printf("✅ Starting missing block finder\n")
+		log.Info("Starting missing block finder")
 
-		currentBlockNumber := db.GetCurrentBlockNumber(_db)
+		currentBlockNumber := _db.GetCurrentBlockNumber()
+
+		// Blocks below this one are not retained, so
+		// they're not considered to be missing
+		retainedFrom := db.RetainedFrom(status.GetLatestBlockNumber())
 
 		// Safely reading shared variable
 		blockCount := status.BlockCountInDB()
 
-		// If all blocks present in between 0 to latest block in network
+		// If all blocks present in between lowest retained block to latest block in network
 		// `ette` sleeps for 1 minute & again get to work
-		if currentBlockNumber+1 == blockCount {
-			log.Printf("✅ No missing blocks found\n")
+		if retainedFrom > currentBlockNumber || currentBlockNumber+1 <= blockCount+retainedFrom {
+			log.Info("No missing blocks found")
 
 			<-time.After(time.Duration(1) * time.Minute)
 			continue
@@ -185,7 +186,7 @@ func SyncMissingBlocksInDB(client *ethclient.Client, _db *gorm.DB, redis *d.Redi
 			wp.Submit(func() {
 
 				// Worker fetches block by number from local storage
-				block := db.GetBlock(j.DB, j.Block)
+				block := _db.GetBlock(j.Block)
 				if !(block == nil) {
 					return
 				}
@@ -194,7 +195,7 @@ func SyncMissingBlocksInDB(client *ethclient.Client, _db *gorm.DB, redis *d.Redi
 					return
 				}
 
-				if !FetchBlockByNumber(j.Client, j.Block, j.DB, j.Redis, false, queue, j.Status) {
+				if !FetchBlockByNumber(j.Client, j.Block, _db, j.Redis, false, queue, j.Status) {
 					queue.UnconfirmedFailed(j.Block)
 					return
 				}
@@ -205,9 +206,9 @@ func SyncMissingBlocksInDB(client *ethclient.Client, _db *gorm.DB, redis *d.Redi
 
 		}
 
-		Syncer(client, _db, redis, queue, 0, currentBlockNumber, status, job)
+		Syncer(client, _db, redis, queue, retainedFrom, currentBlockNumber, status, job)
 
-		log.Printf("✅ Stopping missing block finder\n")
+		log.Info("Stopping missing block finder")
 		<-time.After(time.Duration(1) * time.Minute)
 
 	}
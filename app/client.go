@@ -2,12 +2,12 @@ package app
 
 import (
 	"context"
-	"log"
 
 	"github.com/go-redis/redis/v8"
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	cfg "github.com/itzmeanjan/ette/app/config"
+	log "github.com/sirupsen/logrus"
 )
 
 // Connect to blockchain node, either using HTTP or Websocket connection
@@ -17,13 +17,13 @@ func getClient(isRPC bool) *ethclient.Client {
 	var err error
 
 	if isRPC {
-		client, err = ethclient.Dial(cfg.Get("RPCUrl"))
+		client, err = ethclient.Dial(cfg.Current().RPCUrl)
 	} else {
-		client, err = ethclient.Dial(cfg.Get("WebsocketUrl"))
+		client, err = ethclient.Dial(cfg.Current().WebsocketUrl)
 	}
 
 	if err != nil {
-		log.Fatalf("[!] Failed to connect to blockchain : %s\n", err.Error())
+		log.WithError(err).Fatal("Failed to connect to blockchain")
 	}
 
 	return client
@@ -35,12 +35,12 @@ func getRedisClient() *redis.Client {
 	var options *redis.Options
 
 	// If password is given in config file
-	if cfg.Get("RedisPassword") != "" {
+	if cfg.Current().RedisPassword != "" {
 
 		options = &redis.Options{
-			Network:  cfg.Get("RedisConnection"),
-			Addr:     cfg.Get("RedisAddress"),
-			Password: cfg.Get("RedisPassword"),
+			Network:  cfg.Current().RedisConnection,
+			Addr:     cfg.Current().RedisAddress,
+			Password: cfg.Current().RedisPassword,
 			DB:       0,
 		}
 
@@ -49,8 +49,8 @@ func getRedisClient() *redis.Client {
 		//
 		// Though this is not recommended
 		options = &redis.Options{
-			Network: cfg.Get("RedisConnection"),
-			Addr:    cfg.Get("RedisAddress"),
+			Network: cfg.Current().RedisConnection,
+			Addr:    cfg.Current().RedisAddress,
 			DB:      0,
 		}
 
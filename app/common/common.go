@@ -1,10 +1,15 @@
 package common
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/itzmeanjan/ette/app/data"
 )
 
 // StringifyEventTopics - Given array of event topic signatures,
@@ -61,6 +66,21 @@ func ParseNumber(number string) (uint64, error) {
 
 }
 
+// ParseValue - Given a non-negative integer as decimal string i.e. wei
+// amount, which may not fit in 64 bits, attempts to parse it
+func ParseValue(value string) (*big.Int, error) {
+
+	_value, ok := new(big.Int).SetString(value, 10)
+	if !ok || _value.Sign() < 0 {
+
+		return nil, errors.New("Failed to parse value")
+
+	}
+
+	return _value, nil
+
+}
+
 // RangeChecker - Checks whether given number range is at max
 // `limit` far away
 func RangeChecker(from string, to string, limit uint64) (uint64, uint64, error) {
@@ -82,3 +102,143 @@ func RangeChecker(from string, to string, limit uint64) (uint64, uint64, error)
 	return _from, _to, nil
 
 }
+
+// PagedRangeChecker - Checks whether given number range is well ordered, while
+// its width is limited only when results aren't paginated, because otherwise
+// it's page size, which limits how much is returned in a single go
+func PagedRangeChecker(from string, to string, limit uint64, paginated bool) (uint64, uint64, error) {
+
+	if !paginated {
+		return RangeChecker(from, to, limit)
+	}
+
+	_from, err := ParseNumber(from)
+	if err != nil {
+		return 0, 0, errors.New("Failed to parse integer")
+	}
+
+	_to, err := ParseNumber(to)
+	if err != nil {
+		return 0, 0, errors.New("Failed to parse integer")
+	}
+
+	if _from > _to {
+		return 0, 0, errors.New("Bad range")
+	}
+
+	return _from, _to, nil
+
+}
+
+// ParsePage - Given page size & opaque cursor, both optional, attempts to
+// build page, which is nil when none of them are provided i.e. results
+// aren't to be paginated
+//
+// When only cursor is provided, page size is set to `max`
+func ParsePage(limit string, cursor string, max uint64) (*data.Page, error) {
+
+	if limit == "" && cursor == "" {
+		return nil, nil
+	}
+
+	page := &data.Page{Limit: max}
+
+	if limit != "" {
+
+		_limit, err := ParseNumber(limit)
+		if err != nil || _limit == 0 || _limit > max {
+			return nil, fmt.Errorf("Page size must be in [1, %d]", max)
+		}
+
+		page.Limit = _limit
+
+	}
+
+	if cursor != "" {
+
+		_cursor, err := data.ParseCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		page.After = _cursor
+
+	}
+
+	return page, nil
+
+}
+
+// ParseEventTopics - Given topics as JSON array, as in `eth_getLogs`, where entry
+// at each position is either null, single topic or array of topics, any of which
+// is to be matched, returns set of topics for each position
+func ParseEventTopics(topics []byte) ([][]string, error) {
+
+	var positions []json.RawMessage
+	if err := json.Unmarshal(topics, &positions); err != nil {
+		return nil, errors.New("Failed to parse topics")
+	}
+
+	sets := make([][]string, 0, len(positions))
+
+	for _, v := range positions {
+
+		var set []string
+
+		var topic *string
+		if err := json.Unmarshal(v, &topic); err != nil {
+			if err := json.Unmarshal(v, &set); err != nil {
+				return nil, errors.New("Failed to parse topics")
+			}
+		} else if topic != nil {
+			set = []string{*topic}
+		}
+
+		sets = append(sets, set)
+
+	}
+
+	return sets, nil
+
+}
+
+// ParseEventFilter - Given contract addresses & topic sets for each position,
+// validates them, building filter to be used for querying events
+func ParseEventFilter(contracts []string, topics [][]string) (*data.EventFilter, error) {
+
+	if len(topics) > 4 {
+		return nil, errors.New("Too many topics")
+	}
+
+	filter := &data.EventFilter{
+		Contracts: make([]string, 0, len(contracts)),
+		Topics:    make([][]string, len(topics)),
+	}
+
+	for _, v := range contracts {
+
+		if !(strings.HasPrefix(v, "0x") && len(v) == 42) {
+			return nil, errors.New("Bad contract address")
+		}
+
+		filter.Contracts = append(filter.Contracts, common.HexToAddress(v).Hex())
+
+	}
+
+	for k, set := range topics {
+
+		for _, v := range set {
+
+			if !(strings.HasPrefix(v, "0x") && len(v) == 66) {
+				return nil, errors.New("Bad event topic")
+			}
+
+			filter.Topics[k] = append(filter.Topics[k], common.HexToHash(v).Hex())
+
+		}
+
+	}
+
+	return filter, nil
+
+}
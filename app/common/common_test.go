@@ -0,0 +1,175 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/itzmeanjan/ette/app/data"
+)
+
+func TestParsePage(t *testing.T) {
+	cursor := &data.Cursor{BlockNumber: 7, Index: 1, Hash: "0xab"}
+
+	tests := []struct {
+		name   string
+		limit  string
+		cursor string
+		want   *data.Page
+		err    bool
+	}{
+		{"not paginated", "", "", nil, false},
+		{"limit only", "10", "", &data.Page{Limit: 10}, false},
+		{"limit at max", "50", "", &data.Page{Limit: 50}, false},
+		{"cursor only", "", cursor.String(), &data.Page{Limit: 50, After: cursor}, false},
+		{"limit & cursor", "5", cursor.String(), &data.Page{Limit: 5, After: cursor}, false},
+		{"zero limit", "0", "", nil, true},
+		{"limit beyond max", "51", "", nil, true},
+		{"bad limit", "ten", "", nil, true},
+		{"bad cursor", "5", "%%%", nil, true},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			page, err := ParsePage(v.limit, v.cursor, 50)
+
+			if v.err {
+				if err == nil {
+					t.Fatalf("expected error, found page %+v", page)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected page, found error: %s", err.Error())
+			}
+
+			if v.want == nil {
+				if page != nil {
+					t.Fatalf("expected no page, found %+v", page)
+				}
+
+				return
+			}
+
+			if page == nil || page.Limit != v.want.Limit {
+				t.Fatalf("expected page %+v, found %+v", v.want, page)
+			}
+
+			if (page.After == nil) != (v.want.After == nil) || (page.After != nil && *page.After != *v.want.After) {
+				t.Fatalf("expected cursor %+v, found %+v", v.want.After, page.After)
+			}
+		})
+	}
+}
+
+func TestParseEventTopics(t *testing.T) {
+	a := "0x" + strings.Repeat("a", 64)
+	b := "0x" + strings.Repeat("b", 64)
+
+	tests := []struct {
+		name   string
+		topics string
+		want   [][]string
+		err    bool
+	}{
+		{"empty", `[]`, [][]string{}, false},
+		{"single topic", `["` + a + `"]`, [][]string{{a}}, false},
+		{"null position", `[null, "` + b + `"]`, [][]string{nil, {b}}, false},
+		{"or set", `[["` + a + `", "` + b + `"]]`, [][]string{{a, b}}, false},
+		{"empty set", `[[]]`, [][]string{{}}, false},
+		{"mixed", `["` + a + `", null, ["` + b + `"]]`, [][]string{{a}, nil, {b}}, false},
+		{"not array", `"` + a + `"`, nil, true},
+		{"bad entry", `[1]`, nil, true},
+		{"bad set entry", `[[1]]`, nil, true},
+		{"not json", `[`, nil, true},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			topics, err := ParseEventTopics([]byte(v.topics))
+
+			if v.err {
+				if err == nil {
+					t.Fatalf("expected error, found %v", topics)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected topics, found error: %s", err.Error())
+			}
+
+			if len(topics) != len(v.want) {
+				t.Fatalf("expected %v, found %v", v.want, topics)
+			}
+
+			for k := range topics {
+				if (topics[k] == nil) != (v.want[k] == nil) || strings.Join(topics[k], ",") != strings.Join(v.want[k], ",") {
+					t.Fatalf("expected %v, found %v", v.want, topics)
+				}
+			}
+		})
+	}
+}
+
+func TestParseEventFilter(t *testing.T) {
+	contract := "0x" + strings.Repeat("ab", 20)
+	topic := "0x" + strings.Repeat("CD", 32)
+
+	tests := []struct {
+		name      string
+		contracts []string
+		topics    [][]string
+		want      *data.EventFilter
+		err       bool
+	}{
+		{"empty", nil, nil, &data.EventFilter{Contracts: []string{}, Topics: [][]string{}}, false},
+		{
+			"checksummed contract, lower case topic",
+			[]string{contract},
+			[][]string{nil, {topic}},
+			&data.EventFilter{Contracts: []string{"0xABaBaBaBABabABabAbAbABAbABabababaBaBABaB"}, Topics: [][]string{nil, {strings.ToLower(topic)}}},
+			false,
+		},
+		{"four positions", nil, [][]string{nil, nil, nil, {topic}}, &data.EventFilter{Contracts: []string{}, Topics: [][]string{nil, nil, nil, {strings.ToLower(topic)}}}, false},
+		{"too many positions", nil, [][]string{nil, nil, nil, nil, {topic}}, nil, true},
+		{"contract without prefix", []string{strings.Repeat("ab", 21)}, nil, nil, true},
+		{"short contract", []string{"0xab"}, nil, nil, true},
+		{"short topic", nil, [][]string{{"0xcd"}}, nil, true},
+		{"topic without prefix", nil, [][]string{{strings.Repeat("cd", 33)}}, nil, true},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			filter, err := ParseEventFilter(v.contracts, v.topics)
+
+			if v.err {
+				if err == nil {
+					t.Fatalf("expected error, found %+v", filter)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected filter, found error: %s", err.Error())
+			}
+
+			if strings.Join(filter.Contracts, ",") != strings.Join(v.want.Contracts, ",") {
+				t.Fatalf("expected contracts %v, found %v", v.want.Contracts, filter.Contracts)
+			}
+
+			if len(filter.Topics) != len(v.want.Topics) {
+				t.Fatalf("expected topics %v, found %v", v.want.Topics, filter.Topics)
+			}
+
+			for k := range filter.Topics {
+				if strings.Join(filter.Topics[k], ",") != strings.Join(v.want.Topics[k], ",") {
+					t.Fatalf("expected topics %v, found %v", v.want.Topics, filter.Topics)
+				}
+			}
+		})
+	}
+}
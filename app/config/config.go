@@ -1,115 +1,557 @@
 package config
 
 import (
-	"log"
+	"errors"
+	"fmt"
+	"io"
+	"os"
 	"path/filepath"
-	"strconv"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
 
-// Read - Reading .env file content, during application start up
-func Read(file string) error {
-	viper.SetConfigFile(file)
+// EnvPrefix - Every configuration key can be overridden using environment
+// variable, named as `ETTE_<KEY IN UPPER CASE>` i.e. `ETTE_DB_PASSWORD`
+const EnvPrefix = "ETTE"
 
-	return viper.ReadInConfig()
+// Config - Typed & validated view of `ette` configuration, populated from `.env`
+// file & `ETTE_*` prefixed environment variables, where later one takes precedence
+//
+// Fields marked with `secret` tag are redacted when being printed i.e. node URLs,
+// which usually carry provider API key, while ones marked with `reloadable` tag
+// can be changed without restarting `ette`
+type Config struct {
+	RPCUrl                string `mapstructure:"RPCUrl" secret:"true"`
+	WebsocketUrl          string `mapstructure:"WebsocketUrl" secret:"true"`
+	Port                  uint64 `mapstructure:"PORT"`
+	Database              string `mapstructure:"Database"`
+	SQLitePath            string `mapstructure:"SQLitePath"`
+	DBUser                string `mapstructure:"DB_USER"`
+	DBPassword            string `mapstructure:"DB_PASSWORD" secret:"true"`
+	DBHost                string `mapstructure:"DB_HOST"`
+	DBPort                uint64 `mapstructure:"DB_PORT"`
+	DBName                string `mapstructure:"DB_NAME"`
+	RedisConnection       string `mapstructure:"RedisConnection"`
+	RedisAddress          string `mapstructure:"RedisAddress"`
+	RedisPassword         string `mapstructure:"RedisPassword" secret:"true"`
+	Domain                string `mapstructure:"Domain" reloadable:"true"`
+	Production            string `mapstructure:"Production"`
+	EtteMode              uint64 `mapstructure:"EtteMode"`
+	EtteGraphQLPlayGround string `mapstructure:"EtteGraphQLPlayGround" reloadable:"true"`
+	ConcurrencyFactor     uint64 `mapstructure:"ConcurrencyFactor"`
+	BlockConfirmations    uint64 `mapstructure:"BlockConfirmations" reloadable:"true"`
+	BlockRange            uint64 `mapstructure:"BlockRange" reloadable:"true"`
+	TimeRange             uint64 `mapstructure:"TimeRange" reloadable:"true"`
+	MaxPageSize           uint64 `mapstructure:"MaxPageSize" reloadable:"true"`
+	ExportBlockRange      uint64 `mapstructure:"ExportBlockRange" reloadable:"true"`
+	ExportTimeRange       uint64 `mapstructure:"ExportTimeRange" reloadable:"true"`
+	SnapshotFile          string `mapstructure:"SnapshotFile"`
+	Admin                 string `mapstructure:"Admin" reloadable:"true"`
+	Partitioning          string `mapstructure:"Partitioning"`
+	PartitionSize         uint64 `mapstructure:"PartitionSize"`
+	RetainBlocks          uint64 `mapstructure:"RetainBlocks" reloadable:"true"`
+	ReadReplicas          string `mapstructure:"ReadReplicas" secret:"true"`
+	ResponseCache         string `mapstructure:"ResponseCache"`
+	ResponseCacheTTL      uint64 `mapstructure:"ResponseCacheTTL" reloadable:"true"`
+	GRPCPort              uint64 `mapstructure:"GRPCPort"`
+	MaxBlockLag           uint64 `mapstructure:"MaxBlockLag" reloadable:"true"`
+	LogLevel              string `mapstructure:"LogLevel" reloadable:"true"`
+	LogFormat             string `mapstructure:"LogFormat"`
+	TraceExporter         string `mapstructure:"TraceExporter"`
+	OTLPEndpoint          string `mapstructure:"OTLPEndpoint"`
+	OTLPInsecure          string `mapstructure:"OTLPInsecure"`
 }
 
-// Get - Get config value by key
-func Get(key string) string {
-	return viper.GetString(key)
+// Values to be used when nothing is provided for respective key
+var defaults = map[string]interface{}{
+	"PORT":                  7000,
+	"Database":              "postgres",
+	"SQLitePath":            "ette.db",
+	"DB_PORT":               5432,
+	"RedisConnection":       "tcp",
+	"Production":            "no",
+	"EtteGraphQLPlayGround": "no",
+	"ConcurrencyFactor":     1,
+	"BlockConfirmations":    0,
+	"BlockRange":            100,
+	"TimeRange":             3600,
+	"MaxPageSize":           1000,
+	"ExportBlockRange":      10000,
+	"ExportTimeRange":       86400,
+	"SnapshotFile":          "snapshot.bin",
+	"Partitioning":          "no",
+	"PartitionSize":         1000000,
+	"RetainBlocks":          0,
+	"ReadReplicas":          "",
+	"ResponseCache":         "no",
+	"ResponseCacheTTL":      3600,
+	"GRPCPort":              0,
+	"MaxBlockLag":           10,
+	"LogLevel":              "info",
+	"LogFormat":             "logfmt",
+	"TraceExporter":         "none",
+	"OTLPEndpoint":          "localhost:4317",
+	"OTLPInsecure":          "no",
 }
 
-// GetConcurrencyFactor - Reads concurrency factor specified in `.env` file, during deployment
-// and returns that number as unsigned integer
-func GetConcurrencyFactor() uint64 {
+// Currently active configuration, which is set only after successful validation
+var current atomic.Value
 
-	factor := Get("ConcurrencyFactor")
-	if factor == "" {
-		return 1
-	}
+// Serialises concurrent reload requests i.e. SIGHUP & admin endpoint
+var reloadLock sync.Mutex
 
-	parsedFactor, err := strconv.ParseUint(factor, 10, 64)
-	if err != nil {
-		log.Printf("[!] Failed to parse concurrency factor : %s\n", err.Error())
-		return 1
+// Keys - Returns all configuration keys `ette` understands, in order
+// they're declared in `Config`
+func Keys() []string {
+	_type := reflect.TypeOf(Config{})
+	keys := make([]string, 0, _type.NumField())
+
+	for i := 0; i < _type.NumField(); i++ {
+		keys = append(keys, _type.Field(i).Tag.Get("mapstructure"))
 	}
 
-	return parsedFactor
+	return keys
 }
 
-// GetBlockConfirmations - Number of block confirmations required
-// before considering that block to be finalized, and can be persisted
-// in a permanent data store
-func GetBlockConfirmations() uint64 {
+// EnvName - Name of environment variable which can be used for
+// overriding value of given configuration key
+func EnvName(key string) string {
+	return fmt.Sprintf("%s_%s", EnvPrefix, strings.ToUpper(key))
+}
+
+// Load - Reads configuration from given `.env` file, applies environment variable
+// overrides on top of it & validates, returning all problems found, at once
+//
+// Absence of configuration file is not an error, because in containerised deployments
+// everything can be supplied using environment variables
+func Load(file string) (*Config, error) {
+	v := viper.New()
+	v.SetConfigFile(file)
+	v.SetConfigType("env")
+
+	if err := v.ReadInConfig(); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	known := make(map[string]bool)
+	for _, key := range Keys() {
+		known[strings.ToLower(key)] = true
+	}
+
+	problems := make([]string, 0)
+
+	// Keys present in config file, but not understood by `ette`,
+	// most probably typos, which would have been silently ignored otherwise
+	for _, key := range v.AllKeys() {
+		if !known[key] {
+			problems = append(problems, fmt.Sprintf("unknown key `%s`", key))
+		}
+	}
 
-	confirmationCount := Get("BlockConfirmations")
-	if confirmationCount == "" {
-		return 0
+	for _, key := range Keys() {
+		if err := v.BindEnv(key, EnvName(key)); err != nil {
+			return nil, err
+		}
 	}
 
-	parsedConfirmationCount, err := strconv.ParseUint(confirmationCount, 10, 64)
+	for key, value := range defaults {
+		v.SetDefault(key, value)
+	}
+
+	// Decoding keeps going past values of wrong type, collecting all of them,
+	// so that those are reported along with validation problems
+	var _config Config
+	if err := v.Unmarshal(&_config); err != nil {
+		var decodeErr *mapstructure.Error
+		if !errors.As(err, &decodeErr) {
+			return nil, err
+		}
+
+		problems = append(problems, decodeErr.Errors...)
+	}
+
+	problems = append(problems, _config.validate()...)
+	if len(problems) != 0 {
+		return nil, fmt.Errorf("invalid configuration :\n\t- %s", strings.Join(problems, "\n\t- "))
+	}
+
+	_absFile, err := filepath.Abs(_config.SnapshotFile)
 	if err != nil {
-		log.Printf("[!] Failed to parse block confirmations : %s\n", err.Error())
-		return 0
+		return nil, err
 	}
+	_config.SnapshotFile = _absFile
 
-	return parsedConfirmationCount
+	return &_config, nil
+}
+
+// Read - Reading .env file content, during application start up
+// & making it currently active configuration, if valid
+func Read(file string) error {
+	_config, err := Load(file)
+	if err != nil {
+		return err
+	}
 
+	current.Store(_config)
+	return nil
 }
 
-// GetBlockNumberRange - Returns how many blocks can be queried at a time
-// when performing range based queries from client side
-func GetBlockNumberRange() uint64 {
+// Reload - Re-reads configuration from given `.env` file & environment variables,
+// atomically replacing currently active one, where only reloadable keys are taken
+// from fresh configuration
+//
+// Given `apply` is invoked, while holding reload lock, once fresh configuration is
+// found to be valid, so that other state can be reloaded along with it. Only when
+// it succeeds, fresh configuration becomes active one, so reload is never half done
+//
+// Returns list of applied changes & list of changes ignored, because
+// `ette` needs to be restarted for them to take effect
+func Reload(file string, apply func() error) ([]string, []string, error) {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
 
-	blockRange := Get("BlockRange")
-	if blockRange == "" {
-		return 100
+	fresh, err := Load(file)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	parsedBlockRange, err := strconv.ParseUint(blockRange, 10, 64)
-	if err != nil {
-		log.Printf("[!] Failed to parse block range : %s\n", err.Error())
-		return 100
+	old := Current()
+	merged := *old
+
+	applied := make([]string, 0)
+	ignored := make([]string, 0)
+
+	_old := reflect.ValueOf(old).Elem()
+	_fresh := reflect.ValueOf(fresh).Elem()
+	_merged := reflect.ValueOf(&merged).Elem()
+	_type := _old.Type()
+
+	for i := 0; i < _type.NumField(); i++ {
+		if reflect.DeepEqual(_old.Field(i).Interface(), _fresh.Field(i).Interface()) {
+			continue
+		}
+
+		field := _type.Field(i)
+
+		var change string
+		if field.Tag.Get("secret") == "true" {
+			change = fmt.Sprintf("%s : changed", field.Tag.Get("mapstructure"))
+		} else {
+			change = fmt.Sprintf("%s : %v -> %v", field.Tag.Get("mapstructure"), _old.Field(i).Interface(), _fresh.Field(i).Interface())
+		}
+
+		if field.Tag.Get("reloadable") != "true" {
+			ignored = append(ignored, change)
+			continue
+		}
+
+		_merged.Field(i).Set(_fresh.Field(i))
+		applied = append(applied, change)
 	}
 
-	return parsedBlockRange
+	if apply != nil {
+		if err := apply(); err != nil {
+			return nil, nil, err
+		}
+	}
 
+	current.Store(&merged)
+	return applied, ignored, nil
 }
 
-// GetTimeRange - Returns what's the max time span that can be used while performing query
-// from client side, in terms of second
-func GetTimeRange() uint64 {
+// Current - Returns currently active configuration, must be
+// invoked only after successful `Read`
+func Current() *Config {
+	_config, ok := current.Load().(*Config)
+	if !ok {
+		panic(errors.New("configuration not yet read"))
+	}
 
-	timeRange := Get("TimeRange")
-	if timeRange == "" {
-		return 3600
+	return _config
+}
+
+// validate - Checks all values, returning list of problems
+// found, empty list denotes valid configuration
+func (c *Config) validate() []string {
+	problems := make([]string, 0)
+
+	required := map[string]string{
+		"RPCUrl":       c.RPCUrl,
+		"WebsocketUrl": c.WebsocketUrl,
+		"RedisAddress": c.RedisAddress,
 	}
 
-	parsedTimeRange, err := strconv.ParseUint(timeRange, 10, 64)
-	if err != nil {
-		log.Printf("[!] Failed to parse time range : %s\n", err.Error())
-		return 3600
+	// Connection details are required only when
+	// backing database engine is postgres
+	switch c.Database {
+	case "postgres":
+		required["DB_USER"] = c.DBUser
+		required["DB_HOST"] = c.DBHost
+		required["DB_NAME"] = c.DBName
+	case "sqlite":
+		required["SQLitePath"] = c.SQLitePath
+	default:
+		problems = append(problems, fmt.Sprintf("`Database` must be either `postgres` or `sqlite`, found `%s`", c.Database))
+	}
+
+	for _, key := range Keys() {
+		if value, ok := required[key]; ok && value == "" {
+			problems = append(problems, fmt.Sprintf("`%s` is required", key))
+		}
+	}
+
+	if c.Port == 0 || c.Port > 65535 {
+		problems = append(problems, fmt.Sprintf("`PORT` must be in [1, 65535], found %d", c.Port))
+	}
+
+	// gRPC server is started only when port is set
+	if c.GRPCPort > 65535 || (c.GRPCPort != 0 && c.GRPCPort == c.Port) {
+		problems = append(problems, fmt.Sprintf("`GRPCPort` must be in [1, 65535] & different from `PORT`, found %d", c.GRPCPort))
+	}
+
+	if c.DBPort == 0 || c.DBPort > 65535 {
+		problems = append(problems, fmt.Sprintf("`DB_PORT` must be in [1, 65535], found %d", c.DBPort))
+	}
+
+	if !(c.RedisConnection == "tcp" || c.RedisConnection == "unix") {
+		problems = append(problems, fmt.Sprintf("`RedisConnection` must be either `tcp` or `unix`, found `%s`", c.RedisConnection))
+	}
+
+	if c.EtteMode < 1 || c.EtteMode > 5 {
+		problems = append(problems, fmt.Sprintf("`EtteMode` must be in [1, 5], found %d", c.EtteMode))
+	}
+
+	for key, value := range map[string]string{"Production": c.Production, "EtteGraphQLPlayGround": c.EtteGraphQLPlayGround, "Partitioning": c.Partitioning, "ResponseCache": c.ResponseCache, "OTLPInsecure": c.OTLPInsecure} {
+		if !(strings.ToLower(value) == "yes" || strings.ToLower(value) == "no") {
+			problems = append(problems, fmt.Sprintf("`%s` must be either `yes` or `no`, found `%s`", key, value))
+		}
+	}
+
+	if c.ConcurrencyFactor == 0 {
+		problems = append(problems, "`ConcurrencyFactor` must be > 0")
+	}
+
+	if c.BlockRange == 0 {
+		problems = append(problems, "`BlockRange` must be > 0")
+	}
+
+	if c.TimeRange == 0 {
+		problems = append(problems, "`TimeRange` must be > 0")
+	}
+
+	if c.MaxPageSize == 0 {
+		problems = append(problems, "`MaxPageSize` must be > 0")
+	}
+
+	if c.ExportBlockRange == 0 {
+		problems = append(problems, "`ExportBlockRange` must be > 0")
+	}
+
+	if c.ExportTimeRange == 0 {
+		problems = append(problems, "`ExportTimeRange` must be > 0")
+	}
+
+	if c.SnapshotFile == "" {
+		problems = append(problems, "`SnapshotFile` can't be empty")
+	}
+
+	if c.Admin != "" && !common.IsHexAddress(c.Admin) {
+		problems = append(problems, fmt.Sprintf("`Admin` must be a hex encoded address, found `%s`", c.Admin))
 	}
 
-	return parsedTimeRange
+	if c.PartitionSize == 0 {
+		problems = append(problems, "`PartitionSize` must be > 0")
+	}
+
+	// Partitioning is supported only by postgres, while pruning is
+	// done by dropping whole partitions
+	if c.PartitioningEnabled() && c.Database != "postgres" {
+		problems = append(problems, "`Partitioning` can be enabled only when `Database` is `postgres`")
+	}
 
+	if c.RetainBlocks != 0 && !c.PartitioningEnabled() {
+		problems = append(problems, "`RetainBlocks` can be set only when `Partitioning` is enabled")
+	}
+
+	if c.ResponseCacheTTL == 0 {
+		problems = append(problems, "`ResponseCacheTTL` must be > 0")
+	}
+
+	if c.ReadReplicas != "" && c.Database != "postgres" {
+		problems = append(problems, "`ReadReplicas` can be set only when `Database` is `postgres`")
+	}
+
+	for _, v := range c.GetReadReplicas() {
+		if !(strings.HasPrefix(v, "postgres://") || strings.HasPrefix(v, "postgresql://")) {
+			problems = append(problems, "`ReadReplicas` must be comma separated `postgresql://` connection URLs")
+			break
+		}
+	}
+
+	if !(c.LogLevel == "debug" || c.LogLevel == "info" || c.LogLevel == "warn" || c.LogLevel == "error") {
+		problems = append(problems, fmt.Sprintf("`LogLevel` must be one of `debug`, `info`, `warn` or `error`, found `%s`", c.LogLevel))
+	}
+
+	if !(c.LogFormat == "logfmt" || c.LogFormat == "json") {
+		problems = append(problems, fmt.Sprintf("`LogFormat` must be either `logfmt` or `json`, found `%s`", c.LogFormat))
+	}
+
+	if !(c.TraceExporter == "none" || c.TraceExporter == "stdout" || c.TraceExporter == "otlp") {
+		problems = append(problems, fmt.Sprintf("`TraceExporter` must be one of `none`, `stdout` or `otlp`, found `%s`", c.TraceExporter))
+	}
+
+	if c.TraceExporter == "otlp" && c.OTLPEndpoint == "" {
+		problems = append(problems, "`OTLPEndpoint` is required when `TraceExporter` is `otlp`")
+	}
+
+	return problems
 }
 
-// GetSnapshotFile - Reading snapshot file name from
-// config file, if not provided, `snapshot.bin` is used as default file name
-func GetSnapshotFile() string {
+// IsProduction - Checks whether `ette` is running in production mode or not
+func (c *Config) IsProduction() bool {
+	return strings.ToLower(c.Production) == "yes"
+}
+
+// IsGraphQLPlayGroundEnabled - Checks whether browser based GraphQL
+// playground to be served or not
+func (c *Config) IsGraphQLPlayGroundEnabled() bool {
+	return strings.ToLower(c.EtteGraphQLPlayGround) == "yes"
+}
+
+// HistoricalModeEnabled - Historical data query & persistence
+// is enabled when `EtteMode` is either 1 or 3
+func (c *Config) HistoricalModeEnabled() bool {
+	return c.EtteMode == 1 || c.EtteMode == 3
+}
+
+// RealtimeModeEnabled - Real-time subscription is enabled
+// when `EtteMode` is either 2 or 3
+func (c *Config) RealtimeModeEnabled() bool {
+	return c.EtteMode == 2 || c.EtteMode == 3
+}
+
+// PartitioningEnabled - Checks whether blocks, transactions & events
+// tables are to be range partitioned by block number or not
+func (c *Config) PartitioningEnabled() bool {
+	return strings.ToLower(c.Partitioning) == "yes"
+}
+
+// ResponseCacheEnabled - Checks whether query results of finalised
+// blocks are to be cached in redis or not
+func (c *Config) ResponseCacheEnabled() bool {
+	return strings.ToLower(c.ResponseCache) == "yes"
+}
+
+// GRPCEnabled - gRPC server is started, along with http one, only
+// when port to listen on is set
+func (c *Config) GRPCEnabled() bool {
+	return c.GRPCPort != 0
+}
+
+// TracingEnabled - Checks whether spans are to be exported or not
+func (c *Config) TracingEnabled() bool {
+	return c.TraceExporter != "none"
+}
+
+// IsOTLPInsecure - Checks whether spans are to be exported to
+// OTLP collector without TLS
+func (c *Config) IsOTLPInsecure() bool {
+	return strings.ToLower(c.OTLPInsecure) == "yes"
+}
+
+// GetReadReplicas - Connection URLs of read only replicas of primary
+// postgres database, where read heavy queries can be sent
+func (c *Config) GetReadReplicas() []string {
+	replicas := make([]string, 0)
 
-	_file := Get("SnapshotFile")
-	if _file == "" {
-		_file = "snapshot.bin"
+	for _, v := range strings.Split(c.ReadReplicas, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			replicas = append(replicas, v)
+		}
 	}
 
-	_absFile, err := filepath.Abs(_file)
-	if err != nil {
-		log.Fatalf("[!] Failed to find real path of `%s` : %s\n", _file, err.Error())
+	return replicas
+}
+
+// Write - Writes effective configuration in `.env` format, where
+// values of secret fields are redacted, if non-empty
+func (c *Config) Write(w io.Writer) error {
+	_value := reflect.ValueOf(*c)
+	_type := _value.Type()
+
+	for i := 0; i < _type.NumField(); i++ {
+		field := _type.Field(i)
+		value := fmt.Sprintf("%v", _value.Field(i).Interface())
+
+		if field.Tag.Get("secret") == "true" && value != "" {
+			value = "********"
+		}
+
+		if _, err := fmt.Fprintf(w, "%s=%s\n", field.Tag.Get("mapstructure"), value); err != nil {
+			return err
+		}
 	}
 
-	return _absFile
+	return nil
+}
 
+// GetConcurrencyFactor - Reads concurrency factor specified in `.env` file, during deployment
+// and returns that number as unsigned integer
+func GetConcurrencyFactor() uint64 {
+	return Current().ConcurrencyFactor
+}
+
+// GetBlockConfirmations - Number of block confirmations required
+// before considering that block to be finalized, and can be persisted
+// in a permanent data store
+func GetBlockConfirmations() uint64 {
+	return Current().BlockConfirmations
+}
+
+// GetBlockNumberRange - Returns how many blocks can be queried at a time
+// when performing range based queries from client side
+func GetBlockNumberRange() uint64 {
+	return Current().BlockRange
+}
+
+// GetTimeRange - Returns what's the max time span that can be used while performing query
+// from client side, in terms of second
+func GetTimeRange() uint64 {
+	return Current().TimeRange
+}
+
+// GetMaxPageSize - Returns how many results can be asked for in a single page,
+// when performing paginated queries from client side, where range isn't limited
+func GetMaxPageSize() uint64 {
+	return Current().MaxPageSize
+}
+
+// GetExportBlockRange - Returns how many blocks can be queried at a time,
+// when results are streamed to client as NDJSON/ CSV
+func GetExportBlockRange() uint64 {
+	return Current().ExportBlockRange
+}
+
+// GetExportTimeRange - Returns what's the max time span, in terms of second,
+// that can be queried at a time, when results are streamed to client as NDJSON/ CSV
+func GetExportTimeRange() uint64 {
+	return Current().ExportTimeRange
+}
+
+// GetMaxBlockLag - How many blocks `ette` can lag behind blockchain
+// node, while still being considered ready to serve clients
+func GetMaxBlockLag() uint64 {
+	return Current().MaxBlockLag
+}
+
+// GetSnapshotFile - Reading snapshot file name from
+// config file, if not provided, `snapshot.bin` is used as default file name
+func GetSnapshotFile() string {
+	return Current().SnapshotFile
 }
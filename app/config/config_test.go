@@ -0,0 +1,168 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// validConfig - Configuration passing validation, to be modified by tests
+func validConfig() *Config {
+	return &Config{
+		RPCUrl:                "https://node.example/key",
+		WebsocketUrl:          "wss://node.example/key",
+		Port:                  7000,
+		Database:              "postgres",
+		DBUser:                "ette",
+		DBHost:                "localhost",
+		DBPort:                5432,
+		DBName:                "ette",
+		RedisConnection:       "tcp",
+		RedisAddress:          "localhost:6379",
+		Production:            "no",
+		EtteMode:              3,
+		EtteGraphQLPlayGround: "no",
+		ConcurrencyFactor:     1,
+		BlockRange:            100,
+		TimeRange:             3600,
+		MaxPageSize:           1000,
+		ExportBlockRange:      10000,
+		ExportTimeRange:       86400,
+		SnapshotFile:          "snapshot.bin",
+		Partitioning:          "no",
+		PartitionSize:         1000000,
+		ResponseCache:         "no",
+		ResponseCacheTTL:      3600,
+		LogLevel:              "info",
+		LogFormat:             "logfmt",
+		TraceExporter:         "none",
+		OTLPInsecure:          "no",
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(*Config)
+		problems []string
+	}{
+		{"valid", func(*Config) {}, nil},
+		{"sqlite", func(c *Config) { c.Database = "sqlite"; c.SQLitePath = "ette.db"; c.DBUser = "" }, nil},
+		{"missing node URLs", func(c *Config) { c.RPCUrl = ""; c.WebsocketUrl = "" }, []string{"`RPCUrl` is required", "`WebsocketUrl` is required"}},
+		{"missing postgres user", func(c *Config) { c.DBUser = "" }, []string{"`DB_USER` is required"}},
+		{"unknown database", func(c *Config) { c.Database = "mysql" }, []string{"`Database` must be either `postgres` or `sqlite`, found `mysql`"}},
+		{"bad port", func(c *Config) { c.Port = 70000 }, []string{"`PORT` must be in [1, 65535], found 70000"}},
+		{"gRPC on same port", func(c *Config) { c.GRPCPort = c.Port }, []string{"`GRPCPort` must be in [1, 65535] & different from `PORT`, found 7000"}},
+		{"bad mode", func(c *Config) { c.EtteMode = 0 }, []string{"`EtteMode` must be in [1, 5], found 0"}},
+		{"bad flag", func(c *Config) { c.Production = "true" }, []string{"`Production` must be either `yes` or `no`, found `true`"}},
+		{"zero ranges", func(c *Config) { c.BlockRange = 0; c.ExportTimeRange = 0 }, []string{"`BlockRange` must be > 0", "`ExportTimeRange` must be > 0"}},
+		{"bad admin", func(c *Config) { c.Admin = "0x1" }, []string{"`Admin` must be a hex encoded address, found `0x1`"}},
+		{"partitioning on sqlite", func(c *Config) { c.Database = "sqlite"; c.SQLitePath = "ette.db"; c.Partitioning = "yes" }, []string{"`Partitioning` can be enabled only when `Database` is `postgres`"}},
+		{"retention without partitioning", func(c *Config) { c.RetainBlocks = 10 }, []string{"`RetainBlocks` can be set only when `Partitioning` is enabled"}},
+		{"bad replica", func(c *Config) { c.ReadReplicas = "mysql://replica" }, []string{"`ReadReplicas` must be comma separated `postgresql://` connection URLs"}},
+		{"otlp without endpoint", func(c *Config) { c.TraceExporter = "otlp" }, []string{"`OTLPEndpoint` is required when `TraceExporter` is `otlp`"}},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			c := validConfig()
+			v.modify(c)
+
+			problems := c.validate()
+			if strings.Join(problems, "\n") != strings.Join(v.problems, "\n") {
+				t.Fatalf("expected problems %q, found %q", v.problems, problems)
+			}
+		})
+	}
+}
+
+// writeConfig - Writes `.env` file with given lines into test's temporary
+// directory, returning its path
+func writeConfig(t *testing.T, lines ...string) string {
+	file := filepath.Join(t.TempDir(), ".env")
+
+	if err := os.WriteFile(file, []byte(strings.Join(lines, "\n")+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return file
+}
+
+// baseLines - Lines of `.env` file, making up valid configuration
+var baseLines = []string{
+	"RPCUrl=https://node.example/key",
+	"WebsocketUrl=wss://node.example/key",
+	"DB_USER=ette",
+	"DB_HOST=localhost",
+	"DB_NAME=ette",
+	"RedisAddress=localhost:6379",
+	"EtteMode=3",
+}
+
+func TestLoad(t *testing.T) {
+	_config, err := Load(writeConfig(t, baseLines...))
+	if err != nil {
+		t.Fatalf("expected valid configuration, found error: %s", err.Error())
+	}
+
+	if _config.Port != 7000 || _config.BlockRange != 100 || !filepath.IsAbs(_config.SnapshotFile) {
+		t.Fatalf("expected defaults to be applied, found %+v", _config)
+	}
+
+	// Decoding errors, unknown keys & validation problems are reported together
+	_, err = Load(writeConfig(t, append([]string{"PORT=abc", "BlockRnage=10", "BlockRange=0"}, baseLines...)...))
+	if err == nil {
+		t.Fatal("expected invalid configuration")
+	}
+
+	for _, v := range []string{"unknown key `blockrnage`", "PORT", "`BlockRange` must be > 0"} {
+		if !strings.Contains(err.Error(), v) {
+			t.Fatalf("expected `%s` to be reported, found %s", v, err.Error())
+		}
+	}
+}
+
+func TestReload(t *testing.T) {
+	if err := Read(writeConfig(t, baseLines...)); err != nil {
+		t.Fatal(err)
+	}
+
+	fresh := writeConfig(t, append([]string{"BlockRange=200", "PORT=8000", "RPCUrl=https://node.example/other"}, baseLines[1:]...)...)
+
+	// Failing to apply dependent state leaves configuration untouched
+	if _, _, err := Reload(fresh, func() error { return errors.New("bad plans") }); err == nil {
+		t.Fatal("expected reload to fail")
+	}
+
+	if Current().BlockRange != 100 {
+		t.Fatalf("expected configuration to be left untouched, found `BlockRange` %d", Current().BlockRange)
+	}
+
+	applied, ignored, err := Reload(fresh, func() error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(applied, "\n") != "BlockRange : 100 -> 200" {
+		t.Fatalf("expected only `BlockRange` to be applied, found %q", applied)
+	}
+
+	if strings.Join(ignored, "\n") != "RPCUrl : changed\nPORT : 7000 -> 8000" {
+		t.Fatalf("expected `RPCUrl` & `PORT` to be ignored, without revealing secret, found %q", ignored)
+	}
+
+	if Current().BlockRange != 200 || Current().Port != 7000 || Current().RPCUrl != "https://node.example/key" {
+		t.Fatalf("expected only reloadable keys to be changed, found %+v", Current())
+	}
+
+	// Invalid configuration isn't applied at all
+	if _, _, err := Reload(writeConfig(t, append([]string{"BlockRange=0"}, baseLines...)...), nil); err == nil {
+		t.Fatal("expected reload to fail")
+	}
+
+	if Current().BlockRange != 200 {
+		t.Fatalf("expected configuration to be left untouched, found `BlockRange` %d", Current().BlockRange)
+	}
+}
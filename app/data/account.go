@@ -0,0 +1,37 @@
+package data
+
+import (
+	"encoding/json"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Account - Summary of address's activity on chain, as seen by `ette`,
+// to be supplied when queried using address
+//
+// Address is considered to be a contract, if it's been deployed in
+// some tx or it has emitted events, only then count of events emitted
+// is included
+type Account struct {
+	Address   string  `json:"address"`
+	FirstSeen uint64  `json:"firstSeen"`
+	LastSeen  uint64  `json:"lastSeen"`
+	Sent      uint64  `json:"sent"`
+	Received  uint64  `json:"received"`
+	Deployed  uint64  `json:"deployed"`
+	Nonce     *uint64 `json:"nonce"`
+	Contract  bool    `json:"contract"`
+	CreatedAt *uint64 `json:"createdAt,omitempty"`
+	Emitted   *uint64 `json:"emitted,omitempty"`
+}
+
+// ToJSON - Encoding into JSON, to be invoked when delivering account summary to client
+func (a *Account) ToJSON() []byte {
+	data, err := json.Marshal(a)
+	if err != nil {
+		log.WithError(err).Error("Failed to encode account data to JSON")
+		return nil
+	}
+
+	return data
+}
@@ -0,0 +1,17 @@
+package data
+
+import "github.com/ethereum/go-ethereum/common"
+
+// SubscriptionPlanPayload - Payload to be sent in POST request, by admin,
+// when subscribing address to plan, where `plan` is id of subscription plan
+type SubscriptionPlanPayload struct {
+	Address common.Address `json:"address" binding:"required"`
+	Plan    uint32         `json:"plan" binding:"required"`
+}
+
+// BanPayload - Payload to be sent in POST request, by admin, when either
+// banning address, for `reason`, or lifting ban, when reason is ignored
+type BanPayload struct {
+	Address common.Address `json:"address" binding:"required"`
+	Reason  string         `json:"reason"`
+}
@@ -37,7 +37,7 @@ func (a *AuthPayload) IsAdmin(signer []byte) bool {
 		return false
 	}
 
-	return common.BytesToAddress(signer) == common.HexToAddress(cfg.Get("Admin"))
+	return common.BytesToAddress(signer) == common.HexToAddress(cfg.Current().Admin)
 }
 
 // HasExpired - Checking if message was signed with in
@@ -4,7 +4,9 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"log"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // Block - Block related info to be delivered to client in this format
@@ -13,7 +15,7 @@ type Block struct {
 	Number              uint64  `json:"number" gorm:"column:number"`
 	Time                uint64  `json:"time" gorm:"column:time"`
 	ParentHash          string  `json:"parentHash" gorm:"column:parenthash"`
-	Difficulty          string  `json:"difficulty" gorm:"column:difficulty"`
+	Difficulty          Numeric `json:"difficulty" gorm:"column:difficulty"`
 	GasUsed             uint64  `json:"gasUsed" gorm:"column:gasused"`
 	GasLimit            uint64  `json:"gasLimit" gorm:"column:gaslimit"`
 	Nonce               string  `json:"nonce" gorm:"column:nonce"`
@@ -24,6 +26,10 @@ type Block struct {
 	TransactionRootHash string  `json:"txRootHash" gorm:"column:txroothash"`
 	ReceiptRootHash     string  `json:"receiptRootHash" gorm:"column:receiptroothash"`
 	ExtraData           []byte  `json:"extraData" gorm:"column:extradata"`
+
+	// Base fee per gas, empty for blocks mined before London hard fork,
+	// only delivered over JSON-RPC
+	BaseFee Numeric `json:"-" gorm:"column:basefee"`
 }
 
 // MarshalBinary - Implementing binary marshalling function, to be invoked
@@ -59,11 +65,44 @@ func (b *Block) MarshalJSON() ([]byte, error) {
 
 }
 
+// CSVHeader - Column names, when delivering blocks in CSV format
+func (b *Block) CSVHeader() []string {
+	return []string{"hash", "number", "time", "parentHash", "difficulty", "gasUsed", "gasLimit", "nonce", "miner", "size", "stateRootHash", "uncleHash", "txRootHash", "receiptRootHash", "extraData"}
+}
+
+// CSVRecord - Encodes into CSV record, having columns in order of `CSVHeader`
+func (b *Block) CSVRecord() []string {
+
+	extraData := ""
+	if _h := hex.EncodeToString(b.ExtraData); _h != "" {
+		extraData = fmt.Sprintf("0x%s", _h)
+	}
+
+	return []string{
+		b.Hash,
+		strconv.FormatUint(b.Number, 10),
+		strconv.FormatUint(b.Time, 10),
+		b.ParentHash,
+		string(b.Difficulty),
+		strconv.FormatUint(b.GasUsed, 10),
+		strconv.FormatUint(b.GasLimit, 10),
+		b.Nonce,
+		b.Miner,
+		strconv.FormatFloat(b.Size, 'f', -1, 64),
+		b.StateRootHash,
+		b.UncleHash,
+		b.TransactionRootHash,
+		b.ReceiptRootHash,
+		extraData,
+	}
+
+}
+
 // ToJSON - Encodes into JSON, to be supplied when queried for block data
 func (b *Block) ToJSON() []byte {
 	data, err := json.Marshal(b)
 	if err != nil {
-		log.Printf("[!] Failed to encode block data to JSON : %s\n", err.Error())
+		log.WithError(err).Error("Failed to encode block data to JSON")
 		return nil
 	}
 
@@ -74,13 +113,14 @@ func (b *Block) ToJSON() []byte {
 // also to be supplied to client in JSON encoded form
 type Blocks struct {
 	Blocks []*Block `json:"blocks"`
+	Next   string   `json:"next,omitempty"`
 }
 
 // ToJSON - Encoding into JSON, to be invoked when delivering query result to client
 func (b *Blocks) ToJSON() []byte {
 	data, err := json.Marshal(b)
 	if err != nil {
-		log.Printf("[!] Failed to encode block data to JSON : %s\n", err.Error())
+		log.WithError(err).Error("Failed to encode block data to JSON")
 		return nil
 	}
 
@@ -0,0 +1,47 @@
+package data
+
+import (
+	"encoding/json"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ChainStatIntervals - Intervals chain activity is rolled up over,
+// along with their width in seconds
+var ChainStatIntervals = map[string]uint64{
+	"hour": 3600,
+	"day":  86400,
+}
+
+// ChainStat - Chain activity during one interval, starting at `Time`, where
+// averages are truncated to integer & average base fee is left out, when
+// none of the blocks carried one i.e. mined before London hard fork
+type ChainStat struct {
+	Time            uint64  `json:"time"`
+	Blocks          uint64  `json:"blocks"`
+	Transactions    uint64  `json:"transactions"`
+	GasUsed         uint64  `json:"gasUsed"`
+	AverageGasPrice Numeric `json:"averageGasPrice"`
+	AverageBaseFee  Numeric `json:"averageBaseFee,omitempty"`
+	ActiveSenders   uint64  `json:"activeSenders"`
+	Contracts       uint64  `json:"contracts"`
+	Events          uint64  `json:"events"`
+}
+
+// ChainStats - Time series of chain activity, ordered by time, where
+// intervals without any block are left out
+type ChainStats struct {
+	Interval string       `json:"interval"`
+	Stats    []*ChainStat `json:"stats"`
+}
+
+// ToJSON - Encoding into JSON, to be invoked when delivering query result to client
+func (c *ChainStats) ToJSON() []byte {
+	data, err := json.Marshal(c)
+	if err != nil {
+		log.WithError(err).Error("Failed to encode chain stats to JSON")
+		return nil
+	}
+
+	return data
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-redis/redis/v8"
-	"gorm.io/gorm"
 )
 
 // SyncState - Whether `ette` is synced with blockchain or not
@@ -16,12 +15,13 @@ type SyncState struct {
 	BlockCountAtStartUp     uint64
 	MaxBlockNumberAtStartUp uint64
 	NewBlocksInserted       uint64
+	BlocksPruned            uint64
 	LatestBlockNumber       uint64
 }
 
 // BlockCountInDB - Blocks currently present in database
 func (s *SyncState) BlockCountInDB() uint64 {
-	return s.BlockCountAtStartUp + s.NewBlocksInserted
+	return s.BlockCountAtStartUp + s.NewBlocksInserted - s.BlocksPruned
 }
 
 // StatusHolder - Keeps track of progress being made by `ette` over time,
@@ -64,6 +64,28 @@ func (s *StatusHolder) IncrementBlocksInserted() {
 
 }
 
+// BlocksInserted - Safely reads number of blocks inserted into DB
+// after `ette` started processing blocks
+func (s *StatusHolder) BlocksInserted() uint64 {
+
+	s.Mutex.RLock()
+	defer s.Mutex.RUnlock()
+
+	return s.State.NewBlocksInserted
+
+}
+
+// AddBlocksPruned - Adds number of blocks dropped from DB, because
+// they're not to be retained anymore
+func (s *StatusHolder) AddBlocksPruned(count uint64) {
+
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	s.State.BlocksPruned += count
+
+}
+
 // IncrementBlocksProcessed - Increments number of blocks processed by `ette
 // after it started
 func (s *StatusHolder) IncrementBlocksProcessed() {
@@ -151,7 +173,6 @@ func (r ResultStatus) Total() uint64 {
 // Job - For running a block fetching job, these are all the information which are required
 type Job struct {
 	Client *ethclient.Client
-	DB     *gorm.DB
 	Redis  *RedisInfo
 	Block  uint64
 	Status *StatusHolder
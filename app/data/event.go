@@ -4,10 +4,11 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"log"
+	"strconv"
 	"strings"
 
 	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
 )
 
 // Event - Single event entity holder, extracted from db
@@ -18,6 +19,7 @@ type Event struct {
 	Data            []byte         `gorm:"column:data"`
 	TransactionHash string         `gorm:"column:txhash"`
 	BlockHash       string         `gorm:"column:blockhash"`
+	BlockNumber     uint64         `gorm:"column:blocknumber"`
 }
 
 // MarshalBinary - Implementing binary marshalling function, to be invoked
@@ -44,12 +46,34 @@ func (e *Event) MarshalJSON() ([]byte, error) {
 
 }
 
+// CSVHeader - Column names, when delivering events in CSV format, where
+// each topic gets its own column
+func (e *Event) CSVHeader() []string {
+	return []string{"origin", "index", "topic0", "topic1", "topic2", "topic3", "data", "txHash", "blockHash"}
+}
+
+// CSVRecord - Encodes into CSV record, having columns in order of `CSVHeader`,
+// where topics not present are left empty
+func (e *Event) CSVRecord() []string {
+
+	data := ""
+	if _h := hex.EncodeToString(e.Data); _h != "" && _h != strings.Repeat("0", 64) {
+		data = fmt.Sprintf("0x%s", _h)
+	}
+
+	topics := make([]string, 4)
+	copy(topics, e.Topics)
+
+	return append(append([]string{e.Origin, strconv.FormatUint(uint64(e.Index), 10)}, topics...), data, e.TransactionHash, e.BlockHash)
+
+}
+
 // ToJSON - Encoding into JSON
 func (e *Event) ToJSON() []byte {
 
 	data, err := json.Marshal(e)
 	if err != nil {
-		log.Printf("[!] Failed to encode event to JSON : %s\n", err.Error())
+		log.WithError(err).Error("Failed to encode event to JSON")
 		return nil
 	}
 
@@ -60,6 +84,7 @@ func (e *Event) ToJSON() []byte {
 // Events - A collection of event holder, to be delivered to client in this form
 type Events struct {
 	Events []*Event `json:"events"`
+	Next   string   `json:"next,omitempty"`
 }
 
 // ToJSON - Encoding to JSON
@@ -67,10 +92,52 @@ func (e *Events) ToJSON() []byte {
 
 	data, err := json.Marshal(e)
 	if err != nil {
-		log.Printf("[!] Failed to encode events to JSON : %s\n", err.Error())
+		log.WithError(err).Error("Failed to encode events to JSON")
 		return nil
 	}
 
 	return data
 
 }
+
+// EventFilter - Criteria to be satisfied by events being queried, as in `eth_getLogs`,
+// where event needs to be emitted by any of `Contracts` & topic at each position
+// needs to be any of respective set of `Topics`
+//
+// Empty/ nil contracts or topic set at some position matches anything, while
+// addresses & topics are kept in same hex form, they're persisted in
+type EventFilter struct {
+	Contracts []string
+	Topics    [][]string
+}
+
+// Matches - Checks whether event satisfies filter, to be used for events
+// which aren't read from database i.e. published on pubsub topic
+func (e *EventFilter) Matches(event *Event) bool {
+	if len(e.Contracts) != 0 && !containsFold(e.Contracts, event.Origin) {
+		return false
+	}
+
+	for k, v := range e.Topics {
+		if len(v) == 0 {
+			continue
+		}
+
+		if !(k < len(event.Topics)) || !containsFold(v, event.Topics[k]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containsFold - Checks whether value is present in set, ignoring case
+func containsFold(set []string, value string) bool {
+	for _, v := range set {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,121 @@
+package data
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+)
+
+// Numeric - Arbitrary precision non-negative integer i.e. wei amount, kept in
+// decimal string form, which is stored as `numeric(78,0)` in postgres
+//
+// postgres driver hands over numeric values in scientific notation i.e. `1e21`,
+// which is why it's normalised back to plain decimal form, while scanning
+type Numeric string
+
+// Scan - Reads numeric value from database, into plain decimal form
+func (n *Numeric) Scan(src interface{}) error {
+	switch v := src.(type) {
+
+	case nil:
+		*n = ""
+		return nil
+
+	case int64:
+		*n = Numeric(strconv.FormatInt(v, 10))
+		return nil
+
+	case []byte:
+		return n.parse(string(v))
+
+	case string:
+		return n.parse(v)
+
+	}
+
+	return fmt.Errorf("can't scan %T into numeric", src)
+}
+
+// parse - Parses numeric value, possibly in scientific notation, i.e. `12e3`
+func (n *Numeric) parse(value string) error {
+	mantissa, exponent := value, "0"
+
+	if idx := strings.IndexAny(value, "eE"); idx != -1 {
+		mantissa, exponent = value[:idx], value[idx+1:]
+	}
+
+	_mantissa, ok := new(big.Int).SetString(mantissa, 10)
+	if !ok {
+		return fmt.Errorf("bad numeric value `%s`", value)
+	}
+
+	_exponent, err := strconv.ParseInt(exponent, 10, 64)
+	if err != nil {
+		return fmt.Errorf("bad numeric value `%s`", value)
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(abs(_exponent)), nil)
+
+	if _exponent < 0 {
+		_mantissa.Quo(_mantissa, scale)
+	} else {
+		_mantissa.Mul(_mantissa, scale)
+	}
+
+	*n = Numeric(_mantissa.String())
+	return nil
+}
+
+// Value - Empty value is written as NULL
+func (n Numeric) Value() (driver.Value, error) {
+	if n == "" {
+		return nil, nil
+	}
+
+	return string(n), nil
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
+
+// TransactionFilter - Optional criteria to be satisfied by tx(s) being queried
+// in block number/ time range, ones left unset are not considered
+//
+// When `ContractCreation` is set, only tx(s) deploying contract are considered
+type TransactionFilter struct {
+	From             *common.Address
+	To               *common.Address
+	MinValue         *big.Int
+	MaxValue         *big.Int
+	ContractCreation bool
+}
+
+// TransactionAggregate - Aggregated view of tx(s), matching some filter,
+// where average is truncated to integer
+type TransactionAggregate struct {
+	Count        uint64  `json:"count"`
+	TotalValue   Numeric `json:"totalValue"`
+	AverageValue Numeric `json:"averageValue"`
+}
+
+// ToJSON - Encoding into JSON, to be invoked when delivering query result to client
+func (t *TransactionAggregate) ToJSON() []byte {
+	data, err := json.Marshal(t)
+	if err != nil {
+		log.WithError(err).Error("Failed to encode tx aggregate to JSON")
+		return nil
+	}
+
+	return data
+}
@@ -0,0 +1,60 @@
+package data
+
+import "testing"
+
+func TestNumericScan(t *testing.T) {
+	tests := []struct {
+		name string
+		src  interface{}
+		want Numeric
+		err  bool
+	}{
+		{"null", nil, "", false},
+		{"integer", int64(42), "42", false},
+		{"plain string", "1000000000000000000", "1000000000000000000", false},
+		{"plain bytes", []byte("1000000000000000000"), "1000000000000000000", false},
+		{"exponent", "12e3", "12000", false},
+		{"upper case exponent", []byte("5E20"), "500000000000000000000", false},
+		{"beyond uint64", "115792089237316195423570985008687907853269984665640564039457e18", "115792089237316195423570985008687907853269984665640564039457000000000000000000", false},
+		{"positive exponent sign", "7e+2", "700", false},
+		{"negative exponent", "15000e-3", "15", false},
+		{"zero", "0e10", "0", false},
+		{"not a number", "abc", "", true},
+		{"missing exponent", "1e", "", true},
+		{"bad exponent", "1ex", "", true},
+		{"unsupported type", 1.5, "", true},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			var n Numeric
+			err := n.Scan(v.src)
+
+			if v.err {
+				if err == nil {
+					t.Fatalf("expected error, found %s", n)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected %s, found error: %s", v.want, err.Error())
+			}
+
+			if n != v.want {
+				t.Fatalf("expected %s, found %s", v.want, n)
+			}
+		})
+	}
+}
+
+func TestNumericValue(t *testing.T) {
+	if v, err := Numeric("").Value(); err != nil || v != nil {
+		t.Fatalf("expected NULL, found %v", v)
+	}
+
+	if v, err := Numeric("12").Value(); err != nil || v != "12" {
+		t.Fatalf("expected 12, found %v", v)
+	}
+}
@@ -0,0 +1,79 @@
+package data
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Cursor - Position of block/ tx/ event in ordered result set, next page
+// starts right after it
+//
+// Results are ordered by block number, then by tx/ log index in block,
+// while tx hash breaks ties among tx(s) of same block, with unknown index
+type Cursor struct {
+	BlockNumber uint64
+	Index       uint64
+	Hash        string
+}
+
+// String - Opaque form of cursor, handed out to clients
+func (c *Cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d:%s", c.BlockNumber, c.Index, c.Hash)))
+}
+
+// ParseCursor - Given opaque cursor, handed out to client, attempts to
+// decode it
+func ParseCursor(cursor string) (*Cursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.New("Failed to decode cursor")
+	}
+
+	parts := strings.Split(string(decoded), ":")
+	if len(parts) != 3 {
+		return nil, errors.New("Failed to decode cursor")
+	}
+
+	number, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, errors.New("Failed to decode cursor")
+	}
+
+	index, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, errors.New("Failed to decode cursor")
+	}
+
+	return &Cursor{BlockNumber: number, Index: index, Hash: parts[2]}, nil
+}
+
+// Page - Asks for at max `Limit` results, starting right after cursor,
+// when given, otherwise from very beginning of result set
+type Page struct {
+	Limit uint64
+	After *Cursor
+}
+
+// Full - Checks whether page got filled up with `count` results, which
+// is when there might be more results to be fetched
+func (p *Page) Full(count int) bool {
+	return p != nil && count > 0 && uint64(count) == p.Limit
+}
+
+// Cursor - Position of block in ordered result set
+func (b *Block) Cursor() *Cursor {
+	return &Cursor{BlockNumber: b.Number}
+}
+
+// Cursor - Position of tx in ordered result set
+func (t *Transaction) Cursor() *Cursor {
+	return &Cursor{BlockNumber: t.BlockNumber, Index: uint64(t.Index), Hash: t.Hash}
+}
+
+// Cursor - Position of event in ordered result set
+func (e *Event) Cursor() *Cursor {
+	return &Cursor{BlockNumber: e.BlockNumber, Index: uint64(e.Index)}
+}
@@ -0,0 +1,68 @@
+package data
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestParseCursor(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+		want   *Cursor
+	}{
+		{"block", (&Cursor{BlockNumber: 12}).String(), &Cursor{BlockNumber: 12}},
+		{"tx", (&Cursor{BlockNumber: 12, Index: 3, Hash: "0xab"}).String(), &Cursor{BlockNumber: 12, Index: 3, Hash: "0xab"}},
+		{"not base64", "%%%", nil},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte("1:2:")), nil},
+		{"too few parts", base64.RawURLEncoding.EncodeToString([]byte("1:2")), nil},
+		{"too many parts", base64.RawURLEncoding.EncodeToString([]byte("1:2:0x:3")), nil},
+		{"bad number", base64.RawURLEncoding.EncodeToString([]byte("a:2:")), nil},
+		{"negative index", base64.RawURLEncoding.EncodeToString([]byte("1:-2:")), nil},
+		{"empty", "", nil},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			cursor, err := ParseCursor(v.cursor)
+
+			if v.want == nil {
+				if err == nil {
+					t.Fatalf("expected error, found cursor %+v", cursor)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected cursor, found error: %s", err.Error())
+			}
+
+			if *cursor != *v.want {
+				t.Fatalf("expected cursor %+v, found %+v", v.want, cursor)
+			}
+		})
+	}
+}
+
+func TestPageFull(t *testing.T) {
+	tests := []struct {
+		name  string
+		page  *Page
+		count int
+		full  bool
+	}{
+		{"not paginated", nil, 10, false},
+		{"empty", &Page{Limit: 10}, 0, false},
+		{"partial", &Page{Limit: 10}, 9, false},
+		{"full", &Page{Limit: 10}, 10, true},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			if full := v.page.Full(v.count); full != v.full {
+				t.Fatalf("expected full %v, found %v", v.full, full)
+			}
+		})
+	}
+}
@@ -35,3 +35,39 @@ func (s *SendReceiveCounter) IncrementSend(by uint64) {
 func (s *SendReceiveCounter) IncrementReceive(by uint64) {
 	atomic.AddUint64(&s.Receive, by)
 }
+
+// CacheStats - Keeps track of how many times query results were served
+// from cache & how many times they had to be fetched from database
+type CacheStats struct {
+	Hits          uint64
+	Misses        uint64
+	Invalidations uint64
+}
+
+// Hit - To be invoked when query result is found in cache
+func (c *CacheStats) Hit() {
+	atomic.AddUint64(&c.Hits, 1)
+}
+
+// Miss - To be invoked when query result is not found in cache
+func (c *CacheStats) Miss() {
+	atomic.AddUint64(&c.Misses, 1)
+}
+
+// Invalidated - To be invoked when cached query results are
+// dropped, because blocks they were covering got reorganised
+func (c *CacheStats) Invalidated(by uint64) {
+	atomic.AddUint64(&c.Invalidations, by)
+}
+
+// HitRatio - Fraction of queries served from cache
+func (c *CacheStats) HitRatio() float64 {
+	hits := atomic.LoadUint64(&c.Hits)
+	total := hits + atomic.LoadUint64(&c.Misses)
+
+	if total == 0 {
+		return 0
+	}
+
+	return float64(hits) / float64(total)
+}
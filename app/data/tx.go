@@ -4,24 +4,30 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"log"
+	"strconv"
 	"strings"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // Transaction - Transaction holder struct, to be supplied when queried using tx hash
 type Transaction struct {
-	Hash      string `json:"hash" gorm:"column:hash"`
-	From      string `json:"from" gorm:"column:from"`
-	To        string `json:"to" gorm:"column:to"`
-	Contract  string `json:"contract" gorm:"column:contract"`
-	Value     string `json:"value" gorm:"column:value"`
-	Data      []byte `json:"data" gorm:"column:data"`
-	Gas       uint64 `json:"gas" gorm:"column:gas"`
-	GasPrice  string `json:"gasPrice" gorm:"column:gasprice"`
-	Cost      string `json:"cost" gorm:"column:cost"`
-	Nonce     uint64 `json:"nonce" gorm:"column:nonce"`
-	State     uint64 `json:"state" gorm:"column:state"`
-	BlockHash string `json:"blockHash" gorm:"column:blockhash"`
+	Hash      string  `json:"hash" gorm:"column:hash"`
+	From      string  `json:"from" gorm:"column:from"`
+	To        string  `json:"to" gorm:"column:to"`
+	Contract  string  `json:"contract" gorm:"column:contract"`
+	Value     Numeric `json:"value" gorm:"column:value"`
+	Data      []byte  `json:"data" gorm:"column:data"`
+	Gas       uint64  `json:"gas" gorm:"column:gas"`
+	GasPrice  Numeric `json:"gasPrice" gorm:"column:gasprice"`
+	Cost      Numeric `json:"cost" gorm:"column:cost"`
+	Nonce     uint64  `json:"nonce" gorm:"column:nonce"`
+	State     uint64  `json:"state" gorm:"column:state"`
+	BlockHash string  `json:"blockHash" gorm:"column:blockhash"`
+
+	// Position of tx in chain, used for paginating results
+	BlockNumber uint64 `json:"-" gorm:"column:blocknumber"`
+	Index       uint   `json:"-" gorm:"column:txindex"`
 }
 
 // MarshalBinary - Implementing binary marshalling function, to be invoked
@@ -52,12 +58,43 @@ func (t *Transaction) MarshalJSON() ([]byte, error) {
 
 }
 
+// CSVHeader - Column names, when delivering tx(s) in CSV format
+func (t *Transaction) CSVHeader() []string {
+	return []string{"hash", "from", "to", "contract", "value", "data", "gas", "gasPrice", "cost", "nonce", "state", "blockHash"}
+}
+
+// CSVRecord - Encodes into CSV record, having columns in order of `CSVHeader`,
+// where `to` is empty for contract creation tx & `contract` for others
+func (t *Transaction) CSVRecord() []string {
+
+	data := ""
+	if _h := hex.EncodeToString(t.Data); _h != "" {
+		data = fmt.Sprintf("0x%s", _h)
+	}
+
+	return []string{
+		t.Hash,
+		t.From,
+		t.To,
+		t.Contract,
+		string(t.Value),
+		data,
+		strconv.FormatUint(t.Gas, 10),
+		string(t.GasPrice),
+		string(t.Cost),
+		strconv.FormatUint(t.Nonce, 10),
+		strconv.FormatUint(t.State, 10),
+		t.BlockHash,
+	}
+
+}
+
 // ToJSON - JSON encoder, to be invoked before delivering tx query data to client
 func (t *Transaction) ToJSON() []byte {
 
 	data, err := json.Marshal(t)
 	if err != nil {
-		log.Printf("[!] Failed to encode transaction data to JSON : %s\n", err.Error())
+		log.WithError(err).Error("Failed to encode transaction data to JSON")
 		return nil
 	}
 
@@ -68,6 +105,7 @@ func (t *Transaction) ToJSON() []byte {
 // Transactions - Multiple transactions holder struct
 type Transactions struct {
 	Transactions []*Transaction `json:"transactions"`
+	Next         string         `json:"next,omitempty"`
 }
 
 // ToJSON - Encoding into JSON, to be invoked when delivering to client
@@ -75,7 +113,7 @@ func (t *Transactions) ToJSON() []byte {
 
 	data, err := json.Marshal(t)
 	if err != nil {
-		log.Printf("[!] Failed to encode transactions data to JSON : %s\n", err.Error())
+		log.WithError(err).Error("Failed to encode transactions data to JSON")
 		return nil
 	}
 
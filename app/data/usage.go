@@ -0,0 +1,27 @@
+package data
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Usage - Deliveries made to user since `since` i.e. start of current day ( UTC ),
+// same ones counted when rate limiting, along with how many are `allowed` per day,
+// as per plan user is subscribed to, to be supplied to admin
+type Usage struct {
+	Address    common.Address   `json:"address"`
+	Since      time.Time        `json:"since"`
+	Allowed    uint64           `json:"allowed"`
+	Deliveries uint64           `json:"deliveries"`
+	Bytes      uint64           `json:"bytes"`
+	Endpoints  []*EndpointUsage `json:"endpoints"`
+}
+
+// EndpointUsage - Deliveries made to user & data sent, in bytes, for
+// queries served by one endpoint
+type EndpointUsage struct {
+	EndPoint   string `json:"endpoint" gorm:"column:endpoint"`
+	Deliveries uint64 `json:"deliveries" gorm:"column:deliveries"`
+	Bytes      uint64 `json:"bytes" gorm:"column:bytes"`
+}
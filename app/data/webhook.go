@@ -0,0 +1,18 @@
+package data
+
+import "github.com/ethereum/go-ethereum/common"
+
+// WebhookPayload - Payload to be sent in POST request, when registering webhook
+// against API key, where `name` is subscription name, same as used over websocket
+// & `url` is endpoint, where matching real-time data is to be POST-ed
+type WebhookPayload struct {
+	APIKey common.Hash `json:"apiKey"`
+	Name   string      `json:"name"`
+	URL    string      `json:"url"`
+}
+
+// WebhookID - Payload to be sent in POST request, when either
+// enabling/ disabling or deleting webhook
+type WebhookID struct {
+	ID string `json:"id"`
+}
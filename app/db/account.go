@@ -0,0 +1,212 @@
+package db
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	d "github.com/itzmeanjan/ette/app/data"
+	"gorm.io/gorm"
+)
+
+// accountActivity - Builds query for finding out per account activity i.e.
+// tx(s) sent, received, contracts deployed & events emitted, along with block
+// numbers where it was seen first & last, among tx(s) & events satisfying
+// given conditions
+//
+// Tx conditions are used thrice, so respective arguments need to be
+// repeated, as done by `activityArgs`
+func accountActivity(txCond string, eventCond string) string {
+	return fmt.Sprintf(`select address, min(blocknumber) as firstseen, max(blocknumber) as lastseen, sum(sent) as sent, sum(received) as received, sum(deployed) as deployed, sum(emitted) as emitted, max(nonce) as nonce, min(createdat) as createdat from (
+		select "from" as address, blocknumber, 1 as sent, 0 as received, case when contract <> '' then 1 else 0 end as deployed, 0 as emitted, nonce, null as createdat from transactions where %[1]s
+		union all
+		select "to", blocknumber, 0, 1, 0, 0, null, null from transactions where (%[1]s) and "to" <> ''
+		union all
+		select contract, blocknumber, 0, 0, 0, 0, null, blocknumber from transactions where (%[1]s) and contract <> ''
+		union all
+		select origin, blocknumber, 0, 0, 0, 1, null, null from events where %[2]s
+	) as c where true group by address`, txCond, eventCond)
+}
+
+// activityArgs - Arguments to be passed along with query built using `accountActivity`
+func activityArgs(txArgs []interface{}, eventArgs []interface{}) []interface{} {
+	args := make([]interface{}, 0, 3*len(txArgs)+len(eventArgs))
+
+	for i := 0; i < 3; i++ {
+		args = append(args, txArgs...)
+	}
+
+	return append(args, eventArgs...)
+}
+
+// applyAccounts - Adds activity found in tx(s) & events of given block to
+// aggregates of respective accounts, creating them when seen for first time
+//
+// Rows are touched in order of address, so that concurrent block writers
+// don't end up dead locking each other
+func applyAccounts(dbWTx *gorm.DB, number uint64) error {
+	least, greatest := "least", "greatest"
+	if dbWTx.Dialector.Name() == sqliteDialect {
+		least, greatest = "min", "max"
+	}
+
+	query := fmt.Sprintf(`insert into accounts (address, firstseen, lastseen, sent, received, deployed, emitted, nonce, createdat)
+		%[1]s order by address
+		on conflict (address) do update set
+			firstseen = %[2]s(accounts.firstseen, excluded.firstseen),
+			lastseen = %[3]s(accounts.lastseen, excluded.lastseen),
+			sent = accounts.sent + excluded.sent,
+			received = accounts.received + excluded.received,
+			deployed = accounts.deployed + excluded.deployed,
+			emitted = accounts.emitted + excluded.emitted,
+			nonce = %[3]s(coalesce(accounts.nonce, excluded.nonce), coalesce(excluded.nonce, accounts.nonce)),
+			createdat = %[2]s(coalesce(accounts.createdat, excluded.createdat), coalesce(excluded.createdat, accounts.createdat))`,
+		accountActivity("blocknumber = ?", "blocknumber = ?"), least, greatest)
+
+	return dbWTx.Exec(query, activityArgs([]interface{}{number}, []interface{}{number})...).Error
+}
+
+// revertAccounts - Subtracts activity found in tx(s) & events satisfying given
+// conditions, from aggregates of respective accounts, which is to be done before
+// those entries get deleted/ moved to some other block
+//
+// Block numbers where accounts were seen can't be reverted this way, so
+// addresses of touched accounts are returned, to be refreshed using
+// `refreshAccounts`, once all changes are done
+func revertAccounts(dbWTx *gorm.DB, txCond string, txArgs []interface{}, eventCond string, eventArgs []interface{}) ([]string, error) {
+	var activity []*Accounts
+
+	if err := dbWTx.Raw(fmt.Sprintf("%s order by address", accountActivity(txCond, eventCond)), activityArgs(txArgs, eventArgs)...).Scan(&activity).Error; err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, 0, len(activity))
+
+	for _, v := range activity {
+		if err := dbWTx.Model(&Accounts{}).Where("address = ?", v.Address).Updates(map[string]interface{}{
+			"sent":     gorm.Expr("sent - ?", v.Sent),
+			"received": gorm.Expr("received - ?", v.Received),
+			"deployed": gorm.Expr("deployed - ?", v.Deployed),
+			"emitted":  gorm.Expr("emitted - ?", v.Emitted),
+		}).Error; err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, v.Address)
+	}
+
+	return addresses, nil
+}
+
+// refreshAccounts - Recomputes block numbers where given accounts were seen first
+// & last, their latest nonce & block where they were deployed, using what's
+// present in tx(s) & events tables
+//
+// Accounts not seen anywhere anymore are deleted
+func refreshAccounts(dbWTx *gorm.DB, addresses []string) error {
+	unique := make(map[string]bool)
+	for _, v := range addresses {
+		unique[v] = true
+	}
+
+	sorted := make([]string, 0, len(unique))
+	for k := range unique {
+		sorted = append(sorted, k)
+	}
+
+	sort.Strings(sorted)
+
+	for _, v := range sorted {
+
+		var seen struct {
+			FirstSeen *uint64 `gorm:"column:firstseen"`
+			LastSeen  *uint64 `gorm:"column:lastseen"`
+		}
+
+		// Each branch can be answered using index on respective
+		// address column, having block number as suffix
+		if err := dbWTx.Raw(`select min(firstseen) as firstseen, max(lastseen) as lastseen from (
+			select min(blocknumber) as firstseen, max(blocknumber) as lastseen from transactions where "from" = ?
+			union all
+			select min(blocknumber), max(blocknumber) from transactions where "to" = ?
+			union all
+			select min(blocknumber), max(blocknumber) from transactions where contract = ?
+			union all
+			select min(blocknumber), max(blocknumber) from events where origin = ?
+		) as s`, v, v, v, v).Scan(&seen).Error; err != nil {
+			return err
+		}
+
+		if seen.FirstSeen == nil || seen.LastSeen == nil {
+
+			if err := dbWTx.Where("address = ?", v).Delete(&Accounts{}).Error; err != nil {
+				return err
+			}
+
+			continue
+
+		}
+
+		// Nonce of account increases with each tx it sends, so
+		// nonce of latest tx sent is what we're looking for
+		var nonces []uint64
+		if err := dbWTx.Model(&Transactions{}).Where("\"from\" = ?", v).Order("blocknumber desc, txindex desc").Limit(1).Pluck("nonce", &nonces).Error; err != nil {
+			return err
+		}
+
+		var created []uint64
+		if err := dbWTx.Model(&Transactions{}).Where("contract = ?", v).Order("blocknumber asc").Limit(1).Pluck("blocknumber", &created).Error; err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{
+			"firstseen": *seen.FirstSeen,
+			"lastseen":  *seen.LastSeen,
+			"nonce":     nil,
+			"createdat": nil,
+		}
+
+		if len(nonces) != 0 {
+			updates["nonce"] = nonces[0]
+		}
+
+		if len(created) != 0 {
+			updates["createdat"] = created[0]
+		}
+
+		if err := dbWTx.Model(&Accounts{}).Where("address = ?", v).Updates(updates).Error; err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// GetAccount - Given address, returns summary of its activity, as maintained
+// in aggregates table, nil if it's never been seen
+func GetAccount(db *gorm.DB, address common.Address) *d.Account {
+	var account Accounts
+
+	if err := db.Model(&Accounts{}).Where("address = ?", address.Hex()).First(&account).Error; err != nil {
+		return nil
+	}
+
+	result := &d.Account{
+		Address:   account.Address,
+		FirstSeen: account.FirstSeen,
+		LastSeen:  account.LastSeen,
+		Sent:      account.Sent,
+		Received:  account.Received,
+		Deployed:  account.Deployed,
+		Nonce:     account.Nonce,
+		CreatedAt: account.CreatedAt,
+	}
+
+	if account.CreatedAt != nil || account.Emitted != 0 {
+		result.Contract = true
+		result.Emitted = &account.Emitted
+	}
+
+	return result
+}
@@ -0,0 +1,224 @@
+package db
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	d "github.com/itzmeanjan/ette/app/data"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserDetails - Everything admin needs to know about one user i.e. apps
+// created, subscription plan & whether banned or not
+type UserDetails struct {
+	Address       string           `json:"address"`
+	PlanID        uint32           `json:"planId,omitempty"`
+	Plan          string           `json:"plan,omitempty"`
+	DeliveryCount uint64           `json:"deliveryCount"`
+	Banned        *BannedAddresses `json:"banned,omitempty"`
+	Apps          []*Users         `json:"apps"`
+}
+
+// GetAllUsers - Returns all users, who've created at least one app, along with
+// their apps, subscription plan & ban status, ordered by address
+func GetAllUsers(_db *gorm.DB) []*UserDetails {
+	var apps []*Users
+
+	if err := _db.Model(&Users{}).Order("users.address asc, users.ts desc").Find(&apps).Error; err != nil {
+		return nil
+	}
+
+	var plans []*struct {
+		Address       string
+		ID            uint32
+		Name          string
+		DeliveryCount uint64 `gorm:"column:deliverycount"`
+	}
+
+	if err := _db.Model(&SubscriptionDetails{}).
+		Joins("join subscription_plans on subscription_plans.id = subscription_details.subscriptionplan").
+		Select("subscription_details.address, subscription_plans.id, subscription_plans.name, subscription_plans.deliverycount").
+		Scan(&plans).Error; err != nil {
+		return nil
+	}
+
+	banned := GetBannedAddresses(_db)
+	if banned == nil {
+		return nil
+	}
+
+	users := make([]*UserDetails, 0)
+	byAddress := make(map[string]*UserDetails)
+
+	for _, v := range apps {
+		user, ok := byAddress[v.Address]
+		if !ok {
+			user = &UserDetails{Address: v.Address, Apps: make([]*Users, 0)}
+
+			byAddress[v.Address] = user
+			users = append(users, user)
+		}
+
+		user.Apps = append(user.Apps, v)
+	}
+
+	for _, v := range plans {
+		if user, ok := byAddress[v.Address]; ok {
+			user.PlanID = v.ID
+			user.Plan = v.Name
+			user.DeliveryCount = v.DeliveryCount
+		}
+	}
+
+	for _, v := range banned {
+		if user, ok := byAddress[v.Address]; ok {
+			user.Banned = v
+		}
+	}
+
+	return users
+}
+
+// GetUserDetails - Given address, returns apps created by user, subscription
+// plan & ban status, while returning nil, when address is not known to `ette`
+// i.e. neither has created any app, nor has any plan assigned, nor is banned
+func GetUserDetails(_db *gorm.DB, address common.Address) *UserDetails {
+	user := &UserDetails{Address: address.Hex(), Apps: make([]*Users, 0)}
+
+	if apps := GetAppsByUserAddress(_db, address); apps != nil {
+		user.Apps = apps
+	}
+
+	if plan := CheckSubscriptionPlanDetailsByAddress(_db, address); plan != nil {
+		user.PlanID = plan.ID
+		user.Plan = plan.Name
+		user.DeliveryCount = plan.DeliveryCount
+	}
+
+	user.Banned = GetBannedAddress(_db, address)
+
+	if len(user.Apps) == 0 && user.PlanID == 0 && user.Banned == nil {
+		return nil
+	}
+
+	return user
+}
+
+// SetAPIKeyState - Given API key, enables/ disables it, on behalf of admin,
+// returning false when API key doesn't exist
+func SetAPIKeyState(_db *gorm.DB, apiKey string, enabled bool) bool {
+	result := _db.Model(&Users{}).Where("users.apikey = ?", apiKey).Update("enabled", enabled)
+	if result.Error != nil {
+		return false
+	}
+
+	return result.RowsAffected == 1
+}
+
+// SetSubscriptionPlanForAddress - Subscribes address to given plan, replacing
+// plan it's subscribed to, if any
+//
+// Plan can be assigned to address, which hasn't yet created any app, which is
+// kept as it's, when first app gets created
+func SetSubscriptionPlanForAddress(_db *gorm.DB, address common.Address, planID uint32) bool {
+	if err := _db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"subscriptionplan"}),
+	}).Create(&SubscriptionDetails{
+		Address:          address.Hex(),
+		SubscriptionPlan: planID,
+	}).Error; err != nil {
+		return false
+	}
+
+	return true
+}
+
+// GetUsageByAddress - Deliveries made to user on this day ( UTC ), same ones
+// which are counted when rate limiting, along with how many are allowed as per
+// plan user is subscribed to, broken down by endpoint
+func GetUsageByAddress(_db *gorm.DB, address common.Address) *d.Usage {
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	var endpoints []*d.EndpointUsage
+
+	if err := _db.Model(&DeliveryHistory{}).
+		Select("delivery_history.endpoint, count(*) as deliveries, coalesce(sum(delivery_history.datalength), 0) as bytes").
+		Where("delivery_history.client = ? and delivery_history.ts >= ? and delivery_history.ts < ?", address.Hex(), start, start.Add(24*time.Hour)).
+		Group("delivery_history.endpoint").
+		Order("delivery_history.endpoint asc").
+		Scan(&endpoints).Error; err != nil {
+		return nil
+	}
+
+	usage := &d.Usage{
+		Address:   address,
+		Since:     start,
+		Allowed:   GetAllowedDeliveryCountByAddress(_db, address),
+		Endpoints: make([]*d.EndpointUsage, 0, len(endpoints)),
+	}
+
+	for _, v := range endpoints {
+		usage.Deliveries += v.Deliveries
+		usage.Bytes += v.Bytes
+		usage.Endpoints = append(usage.Endpoints, v)
+	}
+
+	return usage
+}
+
+// BanAddress - Bans address, so that API keys created by it are no more
+// accepted & it can't log in, replacing reason, if already banned
+func BanAddress(_db *gorm.DB, address common.Address, reason string) bool {
+	if err := _db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason", "ts"}),
+	}).Create(&BannedAddresses{
+		Address:   address.Hex(),
+		Reason:    reason,
+		TimeStamp: time.Now().UTC(),
+	}).Error; err != nil {
+		return false
+	}
+
+	return true
+}
+
+// UnbanAddress - Lifts ban on address, returning false when it wasn't banned
+func UnbanAddress(_db *gorm.DB, address common.Address) bool {
+	result := _db.Where("banned_addresses.address = ?", address.Hex()).Delete(&BannedAddresses{})
+	if result.Error != nil {
+		return false
+	}
+
+	return result.RowsAffected == 1
+}
+
+// GetBannedAddress - Returns ban entry of address, nil if it's not banned
+func GetBannedAddress(_db *gorm.DB, address common.Address) *BannedAddresses {
+	var banned BannedAddresses
+
+	if err := _db.Model(&BannedAddresses{}).Where("banned_addresses.address = ?", address.Hex()).First(&banned).Error; err != nil {
+		return nil
+	}
+
+	return &banned
+}
+
+// IsBanned - Checks whether address has been banned by admin
+func IsBanned(_db *gorm.DB, address common.Address) bool {
+	return GetBannedAddress(_db, address) != nil
+}
+
+// GetBannedAddresses - Returns all banned addresses, recently banned first
+func GetBannedAddresses(_db *gorm.DB) []*BannedAddresses {
+	banned := make([]*BannedAddresses, 0)
+
+	if err := _db.Model(&BannedAddresses{}).Order("banned_addresses.ts desc").Find(&banned).Error; err != nil {
+		return nil
+	}
+
+	return banned
+}
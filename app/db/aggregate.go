@@ -0,0 +1,48 @@
+package db
+
+import "gorm.io/gorm"
+
+// revertAggregates - Reverts per account aggregates & chain stats rollups, for
+// blocks, tx(s) & events satisfying given conditions, which are about to be
+// deleted/ moved into other block, returning addresses of accounts touched,
+// to be passed to `applyAggregates`
+//
+// Accounts are always touched before rollups, so that concurrent block
+// writers acquire row locks in same order
+func revertAggregates(dbWTx *gorm.DB, blockCond string, blockArgs []interface{}, txCond string, txArgs []interface{}, eventCond string, eventArgs []interface{}) ([]string, error) {
+	addresses, err := revertAccounts(dbWTx, txCond, txArgs, eventCond, eventArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	deltas, err := collectChainStats(dbWTx, blockCond, blockArgs, txCond, txArgs, eventCond, eventArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyChainStats(dbWTx, deltas, true); err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+// applyAggregates - Once block is written, adds its activity to per account
+// aggregates & chain stats rollups, while refreshing accounts touched when
+// reverting entries it replaced
+func applyAggregates(dbWTx *gorm.DB, number uint64, reverted []string) error {
+	if err := refreshAccounts(dbWTx, reverted); err != nil {
+		return err
+	}
+
+	if err := applyAccounts(dbWTx, number); err != nil {
+		return err
+	}
+
+	deltas, err := collectChainStats(dbWTx, "number = ?", []interface{}{number}, "blocknumber = ?", []interface{}{number}, "blocknumber = ?", []interface{}{number})
+	if err != nil {
+		return err
+	}
+
+	return applyChainStats(dbWTx, deltas, false)
+}
@@ -2,10 +2,10 @@ package db
 
 import (
 	"errors"
-	"log"
 
 	d "github.com/itzmeanjan/ette/app/data"
 	q "github.com/itzmeanjan/ette/app/queue"
+	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
@@ -32,6 +32,13 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 
 		blockInserted := false
 
+		// Accounts whose activity got reverted, while replacing entries
+		// already present, to be refreshed once this block is written
+		//
+		// Per account aggregates & chain stats rollups are maintained
+		// incrementally, so that they can be served without scanning
+		reverted := make([]string, 0)
+
 		persistedBlock := GetBlock(dbWTx, block.Block.Number)
 		if persistedBlock == nil {
 
@@ -43,7 +50,14 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 
 		} else if !persistedBlock.SimilarTo(block.Block) {
 
-			log.Printf("[!] Block %d already present in DB, similar ❌\n", block.Block.Number)
+			log.WithFields(log.Fields{"block": block.Block.Number, "hash": block.Block.Hash, "persisted": persistedBlock.Hash}).Warn("Block already present in DB, replacing different one")
+
+			addresses, err := revertAggregates(dbWTx, "number = ?", []interface{}{block.Block.Number}, "blocknumber = ?", []interface{}{block.Block.Number}, "blocknumber = ?", []interface{}{block.Block.Number})
+			if err != nil {
+				return err
+			}
+
+			reverted = append(reverted, addresses...)
 
 			// cascaded deletion !
 			if err := DeleteBlock(dbWTx, block.Block.Number); err != nil {
@@ -58,13 +72,17 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 
 		} else {
 
-			log.Printf("[+] Block %d already present in DB, similar ✅\n", block.Block.Number)
+			log.WithFields(log.Fields{"block": block.Block.Number, "hash": block.Block.Hash}).Debug("Block already present in DB")
 			return nil
 
 		}
 
 		if block.Transactions == nil {
 
+			if err := applyAggregates(dbWTx, block.Block.Number, reverted); err != nil {
+				return err
+			}
+
 			// During 👆 flow, if we've really inserted a new block into database,
 			// count will get updated
 			if blockInserted && status != nil {
@@ -75,14 +93,41 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 
 		}
 
+		// When tables aren't partitioned, tx(s) are identified by hash only, so
+		// upserting them moves ones, already present in some other block, into
+		// this one, where their activity need to be reverted first
+		if !partitioned() && len(block.Transactions) != 0 {
+
+			hashes := make([]string, 0, len(block.Transactions))
+			for _, t := range block.Transactions {
+				hashes = append(hashes, t.Tx.Hash)
+			}
+
+			addresses, err := revertAggregates(dbWTx, "1 = 0", nil, "hash in ? and blocknumber <> ?", []interface{}{hashes, block.Block.Number}, "1 = 0", nil)
+			if err != nil {
+				return err
+			}
+
+			reverted = append(reverted, addresses...)
+
+		}
+
 		for _, t := range block.Transactions {
 
+			// Block number & time are denormalised onto tx(s) & events, so that
+			// they can be range partitioned & queried without joining
+			t.Tx.BlockNumber = block.Block.Number
+			t.Tx.BlockTime = block.Block.Time
+
 			if err := UpsertTransaction(dbWTx, t.Tx); err != nil {
 				return err
 			}
 
 			for _, e := range t.Events {
 
+				e.BlockNumber = block.Block.Number
+				e.BlockTime = block.Block.Time
+
 				if err := UpsertEvent(dbWTx, e); err != nil {
 					return err
 				}
@@ -91,6 +136,10 @@ func StoreBlock(dbWOTx *gorm.DB, block *PackedBlock, status *d.StatusHolder, que
 
 		}
 
+		if err := applyAggregates(dbWTx, block.Block.Number, reverted); err != nil {
+			return err
+		}
+
 		// During 👆 flow, if we've really inserted a new block into database,
 		// count will get updated
 		if blockInserted && status != nil && queue != nil {
@@ -147,6 +196,7 @@ func UpdateBlock(dbWTx *gorm.DB, block *Blocks) error {
 		"txroothash":      block.TransactionRootHash,
 		"receiptroothash": block.ReceiptRootHash,
 		"extradata":       block.ExtraData,
+		"basefee":         block.BaseFee,
 	}).Error
 
 }
@@ -0,0 +1,847 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-redis/redis/v8"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	q "github.com/itzmeanjan/ette/app/queue"
+	log "github.com/sirupsen/logrus"
+)
+
+// Cached query results are kept under keys with this prefix, while
+// sorted sets, indexing them by block number & time range they cover,
+// are used for finding out which ones to be dropped on reorganisation
+//
+// Each index is accompanied by another sorted set, scoring same members by
+// when their keys expire, so that members outliving keys can be trimmed
+const (
+	cachePrefix       = "ette:cache"
+	cacheNumberIndex  = "ette:cache:index:number"
+	cacheTimeIndex    = "ette:cache:index:time"
+	cacheExpirySuffix = ":expiry"
+	// At max these many expired members are trimmed from index, per put
+	cacheTrimBatch = 1000
+)
+
+// Mirrors of data types, without binary marshalling methods, which are meant
+// for publishing on pubsub topics & can't be decoded back, so that gob
+// encodes them field by field
+type (
+	cachedBlock       d.Block
+	cachedTransaction d.Transaction
+	cachedEvent       d.Event
+)
+
+// encodeResult - Encodes query result, given as pointer to variable of
+// result type, replacing data types with their mirrors
+func encodeResult(result interface{}) ([]byte, error) {
+	value := result
+
+	switch v := result.(type) {
+
+	case **d.Block:
+		value = (*cachedBlock)(*v)
+
+	case **d.Blocks:
+		blocks := make([]*cachedBlock, len((*v).Blocks))
+		for k, b := range (*v).Blocks {
+			blocks[k] = (*cachedBlock)(b)
+		}
+
+		value = blocks
+
+	case **d.Transactions:
+		txs := make([]*cachedTransaction, len((*v).Transactions))
+		for k, t := range (*v).Transactions {
+			txs[k] = (*cachedTransaction)(t)
+		}
+
+		value = txs
+
+	case **d.Event:
+		value = (*cachedEvent)(*v)
+
+	case **d.Events:
+		events := make([]*cachedEvent, len((*v).Events))
+		for k, e := range (*v).Events {
+			events[k] = (*cachedEvent)(e)
+		}
+
+		value = events
+
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(value); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// decodeResult - Decodes query result encoded using `encodeResult`, into
+// variable of result type, pointer to which is given
+func decodeResult(data []byte, result interface{}) error {
+	decoder := gob.NewDecoder(bytes.NewReader(data))
+
+	switch v := result.(type) {
+
+	case **d.Block:
+		var block cachedBlock
+		if err := decoder.Decode(&block); err != nil {
+			return err
+		}
+
+		*v = (*d.Block)(&block)
+
+	case **d.Blocks:
+		var blocks []*cachedBlock
+		if err := decoder.Decode(&blocks); err != nil {
+			return err
+		}
+
+		*v = &d.Blocks{Blocks: make([]*d.Block, len(blocks))}
+		for k, b := range blocks {
+			(*v).Blocks[k] = (*d.Block)(b)
+		}
+
+	case **d.Transactions:
+		var txs []*cachedTransaction
+		if err := decoder.Decode(&txs); err != nil {
+			return err
+		}
+
+		*v = &d.Transactions{Transactions: make([]*d.Transaction, len(txs))}
+		for k, t := range txs {
+			(*v).Transactions[k] = (*d.Transaction)(t)
+		}
+
+	case **d.Event:
+		var event cachedEvent
+		if err := decoder.Decode(&event); err != nil {
+			return err
+		}
+
+		*v = (*d.Event)(&event)
+
+	case **d.Events:
+		var events []*cachedEvent
+		if err := decoder.Decode(&events); err != nil {
+			return err
+		}
+
+		*v = &d.Events{Events: make([]*d.Event, len(events))}
+		for k, e := range events {
+			(*v).Events[k] = (*d.Event)(e)
+		}
+
+	default:
+		return decoder.Decode(result)
+
+	}
+
+	return nil
+}
+
+// cachedStore - Store decorator, serving query results from redis, when
+// they're only covering blocks at or below confirmed height, which don't
+// change, unless chain gets reorganised deeper than confirmation depth
+//
+// Results are cached only when all blocks they cover are present in
+// database, so that partially synced ranges are never cached
+type cachedStore struct {
+	Store
+	client *redis.Client
+	status *d.StatusHolder
+	stats  *d.CacheStats
+
+	lock      sync.Mutex
+	confirmed *Blocks
+}
+
+// WithCache - Wraps store with redis backed query result cache, when
+// it's enabled in configuration, otherwise returns store as it is
+func WithCache(store Store, client *redis.Client, status *d.StatusHolder) Store {
+	if !cfg.Current().ResponseCacheEnabled() {
+		return store
+	}
+
+	return &cachedStore{
+		Store:  store,
+		client: client,
+		status: status,
+		stats:  &d.CacheStats{},
+	}
+}
+
+// cacheKey - Builds cache key from query name & its normalised arguments
+func cacheKey(query string, args ...interface{}) string {
+	parts := make([]string, 0, len(args)+2)
+	parts = append(parts, cachePrefix, query)
+
+	for _, v := range args {
+		parts = append(parts, fmt.Sprint(v))
+	}
+
+	return strings.Join(parts, ":")
+}
+
+// filterKey - Normalised form of tx filter, to be used as part of cache key
+func filterKey(filter *d.TransactionFilter) string {
+	if filter == nil {
+		return "-"
+	}
+
+	parts := []string{"-", "-", "-", "-"}
+
+	if filter.From != nil {
+		parts[0] = filter.From.Hex()
+	}
+
+	if filter.To != nil {
+		parts[1] = filter.To.Hex()
+	}
+
+	if filter.MinValue != nil {
+		parts[2] = filter.MinValue.String()
+	}
+
+	if filter.MaxValue != nil {
+		parts[3] = filter.MaxValue.String()
+	}
+
+	if filter.ContractCreation {
+		parts = append(parts, "creation")
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// topicsKey - Normalised form of topic signatures, ordered by position,
+// to be used as part of cache key
+func topicsKey(topics map[uint8]string) string {
+	positions := make([]int, 0, len(topics))
+	for k := range topics {
+		positions = append(positions, int(k))
+	}
+
+	sort.Ints(positions)
+
+	parts := make([]string, 0, len(topics))
+	for _, v := range positions {
+		parts = append(parts, fmt.Sprintf("%d=%s", v, topics[uint8(v)]))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// eventFilterKey - Normalised form of event filter, to be used as part of cache key,
+// where contracts & topics of each position are sorted, because their order
+// doesn't change result
+//
+// Case is kept as it's, because topics are matched case sensitively in database
+func eventFilterKey(filter *d.EventFilter) string {
+	if filter == nil {
+		return "-"
+	}
+
+	sorted := func(set []string) string {
+		_set := make([]string, len(set))
+		copy(_set, set)
+
+		sort.Strings(_set)
+		return strings.Join(_set, ",")
+	}
+
+	parts := []string{sorted(filter.Contracts)}
+	for _, v := range filter.Topics {
+		parts = append(parts, sorted(v))
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// confirmedHeight - Highest block number, which is confirmed, as per
+// latest block seen by status holder
+func confirmedHeight(status *d.StatusHolder) (uint64, bool) {
+	latest := status.GetLatestBlockNumber()
+	confirmations := cfg.GetBlockConfirmations()
+
+	if latest == 0 || latest < confirmations {
+		return 0, false
+	}
+
+	return latest - confirmations, true
+}
+
+// isSynced - Checks whether all blocks up to confirmed height are present
+// in database, as per status holder
+func isSynced(status *d.StatusHolder) bool {
+	height, ok := confirmedHeight(status)
+	if !ok {
+		return false
+	}
+
+	from := RetainedFrom(status.GetLatestBlockNumber())
+	if from > height {
+		return false
+	}
+
+	return status.BlockCountInDB() >= height-from+1
+}
+
+// confirmedHeight - Highest block number, which is confirmed
+func (c *cachedStore) confirmedHeight() (uint64, bool) {
+	return confirmedHeight(c.status)
+}
+
+// isConfirmed - Checks whether block with given number is confirmed
+func (c *cachedStore) isConfirmed(number uint64) bool {
+	height, ok := c.confirmedHeight()
+	return ok && number <= height
+}
+
+// isConfirmedTime - Checks whether blocks mined till given time are all
+// confirmed, by comparing with mining time of confirmed block
+func (c *cachedStore) isConfirmedTime(time uint64) bool {
+	height, ok := c.confirmedHeight()
+	if !ok {
+		return false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.confirmed == nil || c.confirmed.Number != height {
+		block := c.Store.GetBlock(height)
+		if block == nil {
+			return false
+		}
+
+		c.confirmed = block
+	}
+
+	return time < c.confirmed.Time
+}
+
+// isComplete - Checks whether all blocks in given range are present in database
+func (c *cachedStore) isComplete(from uint64, to uint64) bool {
+	return uint64(len(c.Store.GetAllBlockNumbersInRange(from, to))) == to-from+1
+}
+
+// isSynced - Checks whether all blocks up to confirmed height are present
+// in database, to be used for time ranges, which can't be checked
+// for completeness by block numbers
+func (c *cachedStore) isSynced() bool {
+	return isSynced(c.status)
+}
+
+// get - Attempts to read cached query result into `result`, which
+// must be pointer to variable of result type
+func (c *cachedStore) get(key string, result interface{}) bool {
+	data, err := c.client.Get(context.Background(), key).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			log.WithError(err).Error("Failed to read from cache")
+		}
+
+		c.stats.Miss()
+		return false
+	}
+
+	if err := decodeResult(data, result); err != nil {
+		log.WithError(err).Error("Failed to decode cached query result")
+
+		c.stats.Miss()
+		return false
+	}
+
+	c.stats.Hit()
+	return true
+}
+
+// put - Caches query result, indexing it by range it covers, in
+// given index, so that it can be dropped on reorganisation
+func (c *cachedStore) put(key string, index string, from uint64, to uint64, result interface{}) {
+	data, err := encodeResult(result)
+	if err != nil {
+		log.WithError(err).Error("Failed to encode query result for caching")
+		return
+	}
+
+	ctx := context.Background()
+	ttl := time.Duration(cfg.Current().ResponseCacheTTL) * time.Second
+	member := fmt.Sprintf("%d|%s", from, key)
+
+	if _, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, data, ttl)
+		pipe.ZAdd(ctx, index, &redis.Z{Score: float64(to), Member: member})
+		pipe.ZAdd(ctx, index+cacheExpirySuffix, &redis.Z{Score: float64(time.Now().Add(ttl).Unix()), Member: member})
+		return nil
+	}); err != nil {
+		log.WithError(err).Error("Failed to cache query result")
+		return
+	}
+
+	c.trim(index)
+}
+
+// trim - Drops members of given index, whose keys have already expired,
+// so that index doesn't keep growing
+func (c *cachedStore) trim(index string) {
+	ctx := context.Background()
+
+	expired, err := c.client.ZRangeByScore(ctx, index+cacheExpirySuffix, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(time.Now().Unix(), 10),
+		Count: cacheTrimBatch,
+	}).Result()
+	if err != nil {
+		log.WithError(err).Error("Failed to find expired cached query results")
+		return
+	}
+
+	if len(expired) == 0 {
+		return
+	}
+
+	members := make([]interface{}, len(expired))
+	for k, v := range expired {
+		members[k] = v
+	}
+
+	if _, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, index, members...)
+		pipe.ZRem(ctx, index+cacheExpirySuffix, members...)
+		return nil
+	}); err != nil {
+		log.WithError(err).Error("Failed to trim cached query result index")
+	}
+}
+
+// through - Serves query result from cache, when present, otherwise
+// runs query & caches non-empty result, if all blocks it covers are
+// present in database
+func (c *cachedStore) through(key string, index string, from uint64, to uint64, result interface{}, query func(), complete func() bool) {
+	if c.get(key, result) {
+		return
+	}
+
+	query()
+
+	if reflect.ValueOf(result).Elem().IsZero() || !complete() {
+		return
+	}
+
+	c.put(key, index, from, to, result)
+}
+
+// byNumber - Runs query covering given block number range, using cache,
+// only when whole range is confirmed & query isn't paginated
+//
+// Pages of paginated queries aren't cached, because range they cover isn't
+// limited & checking whether it's completely synced costs as much as query itself
+func (c *cachedStore) byNumber(key string, from uint64, to uint64, page *d.Page, result interface{}, query func()) {
+	if page != nil || !c.isConfirmed(to) {
+		query()
+		return
+	}
+
+	c.through(key, cacheNumberIndex, from, to, result, query, func() bool {
+		return c.isComplete(from, to)
+	})
+}
+
+// byTime - Runs query covering given block time range, using cache,
+// only when whole range is confirmed & query isn't paginated
+func (c *cachedStore) byTime(key string, from uint64, to uint64, page *d.Page, result interface{}, query func()) {
+	if page != nil || !c.isConfirmedTime(to) {
+		query()
+		return
+	}
+
+	c.through(key, cacheTimeIndex, from, to, result, query, c.isSynced)
+}
+
+// invalidate - Drops all cached query results, indexed in given sorted
+// set, covering given point, returning how many of them were dropped
+func (c *cachedStore) invalidate(index string, at uint64) uint64 {
+	ctx := context.Background()
+
+	members, err := c.client.ZRangeByScore(ctx, index, &redis.ZRangeBy{
+		Min: strconv.FormatUint(at, 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		log.WithError(err).Error("Failed to find cached query results to be invalidated")
+		return 0
+	}
+
+	keys := make([]string, 0)
+	dropped := make([]interface{}, 0)
+
+	for _, v := range members {
+		parts := strings.SplitN(v, "|", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		from, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil || from > at {
+			continue
+		}
+
+		keys = append(keys, parts[1])
+		dropped = append(dropped, v)
+	}
+
+	if len(keys) == 0 {
+		return 0
+	}
+
+	if _, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		pipe.ZRem(ctx, index, dropped...)
+		pipe.ZRem(ctx, index+cacheExpirySuffix, dropped...)
+		return nil
+	}); err != nil {
+		log.WithError(err).Error("Failed to invalidate cached query results")
+		return 0
+	}
+
+	return uint64(len(keys))
+}
+
+// StoreBlock - Persists block & when it's replacing different one, at same
+// height, which might have been cached, all cached results covering it are dropped
+func (c *cachedStore) StoreBlock(block *PackedBlock, status *d.StatusHolder, queue *q.BlockProcessorQueue) error {
+	if block == nil || block.Block == nil || !c.isConfirmed(block.Block.Number) {
+		return c.Store.StoreBlock(block, status, queue)
+	}
+
+	persisted := c.Store.GetBlock(block.Block.Number)
+
+	if err := c.Store.StoreBlock(block, status, queue); err != nil {
+		return err
+	}
+
+	if persisted == nil || persisted.SimilarTo(block.Block) {
+		return nil
+	}
+
+	dropped := c.invalidate(cacheNumberIndex, block.Block.Number)
+	dropped += c.invalidate(cacheTimeIndex, persisted.Time)
+
+	if block.Block.Time != persisted.Time {
+		dropped += c.invalidate(cacheTimeIndex, block.Block.Time)
+	}
+
+	c.stats.Invalidated(dropped)
+
+	log.WithFields(log.Fields{"block": block.Block.Number, "hash": block.Block.Hash, "dropped": dropped}).Info("Block reorganised, dropped cached query results")
+
+	return nil
+}
+
+// CacheStats - Hit/ miss statistics of query result cache
+func (c *cachedStore) CacheStats() *d.CacheStats {
+	return c.stats
+}
+
+func (c *cachedStore) GetBlockByHash(hash common.Hash) *d.Block {
+	key := cacheKey("blockByHash", hash.Hex())
+
+	var result *d.Block
+	if c.get(key, &result) {
+		return result
+	}
+
+	result = c.Store.GetBlockByHash(hash)
+	if result != nil && c.isConfirmed(result.Number) {
+		c.put(key, cacheNumberIndex, result.Number, result.Number, &result)
+	}
+
+	return result
+}
+
+func (c *cachedStore) GetBlockByNumber(number uint64) *d.Block {
+	var result *d.Block
+	c.byNumber(cacheKey("blockByNumber", number), number, number, nil, &result, func() {
+		result = c.Store.GetBlockByNumber(number)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetBlocksByNumberRange(from uint64, to uint64, page *d.Page) *d.Blocks {
+	var result *d.Blocks
+	c.byNumber(cacheKey("blocksByNumberRange", from, to), from, to, page, &result, func() {
+		result = c.Store.GetBlocksByNumberRange(from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetBlocksByTimeRange(from uint64, to uint64, page *d.Page) *d.Blocks {
+	var result *d.Blocks
+	c.byTime(cacheKey("blocksByTimeRange", from, to), from, to, page, &result, func() {
+		result = c.Store.GetBlocksByTimeRange(from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionCountByBlockNumber(number uint64) int64 {
+	var result int64
+	c.byNumber(cacheKey("txCountByBlockNumber", number), number, number, nil, &result, func() {
+		result = c.Store.GetTransactionCountByBlockNumber(number)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionsByBlockNumber(number uint64, page *d.Page) *d.Transactions {
+	var result *d.Transactions
+	c.byNumber(cacheKey("txsByBlockNumber", number), number, number, page, &result, func() {
+		result = c.Store.GetTransactionsByBlockNumber(number, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionCountFromAccountByBlockNumberRange(account common.Address, from uint64, to uint64) int64 {
+	var result int64
+	c.byNumber(cacheKey("txCountFromAccountByNumberRange", account.Hex(), from, to), from, to, nil, &result, func() {
+		result = c.Store.GetTransactionCountFromAccountByBlockNumberRange(account, from, to)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionsFromAccountByBlockNumberRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	var result *d.Transactions
+	c.byNumber(cacheKey("txsFromAccountByNumberRange", account.Hex(), from, to), from, to, page, &result, func() {
+		result = c.Store.GetTransactionsFromAccountByBlockNumberRange(account, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionCountFromAccountByBlockTimeRange(account common.Address, from uint64, to uint64) int64 {
+	var result int64
+	c.byTime(cacheKey("txCountFromAccountByTimeRange", account.Hex(), from, to), from, to, nil, &result, func() {
+		result = c.Store.GetTransactionCountFromAccountByBlockTimeRange(account, from, to)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionsFromAccountByBlockTimeRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	var result *d.Transactions
+	c.byTime(cacheKey("txsFromAccountByTimeRange", account.Hex(), from, to), from, to, page, &result, func() {
+		result = c.Store.GetTransactionsFromAccountByBlockTimeRange(account, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionCountToAccountByBlockNumberRange(account common.Address, from uint64, to uint64) int64 {
+	var result int64
+	c.byNumber(cacheKey("txCountToAccountByNumberRange", account.Hex(), from, to), from, to, nil, &result, func() {
+		result = c.Store.GetTransactionCountToAccountByBlockNumberRange(account, from, to)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionsToAccountByBlockNumberRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	var result *d.Transactions
+	c.byNumber(cacheKey("txsToAccountByNumberRange", account.Hex(), from, to), from, to, page, &result, func() {
+		result = c.Store.GetTransactionsToAccountByBlockNumberRange(account, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionCountToAccountByBlockTimeRange(account common.Address, from uint64, to uint64) int64 {
+	var result int64
+	c.byTime(cacheKey("txCountToAccountByTimeRange", account.Hex(), from, to), from, to, nil, &result, func() {
+		result = c.Store.GetTransactionCountToAccountByBlockTimeRange(account, from, to)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionsToAccountByBlockTimeRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	var result *d.Transactions
+	c.byTime(cacheKey("txsToAccountByTimeRange", account.Hex(), from, to), from, to, page, &result, func() {
+		result = c.Store.GetTransactionsToAccountByBlockTimeRange(account, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionCountBetweenAccountsByBlockNumberRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64) int64 {
+	var result int64
+	c.byNumber(cacheKey("txCountBetweenAccountsByNumberRange", fromAccount.Hex(), toAccount.Hex(), from, to), from, to, nil, &result, func() {
+		result = c.Store.GetTransactionCountBetweenAccountsByBlockNumberRange(fromAccount, toAccount, from, to)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionsBetweenAccountsByBlockNumberRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	var result *d.Transactions
+	c.byNumber(cacheKey("txsBetweenAccountsByNumberRange", fromAccount.Hex(), toAccount.Hex(), from, to), from, to, page, &result, func() {
+		result = c.Store.GetTransactionsBetweenAccountsByBlockNumberRange(fromAccount, toAccount, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionCountBetweenAccountsByBlockTimeRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64) int64 {
+	var result int64
+	c.byTime(cacheKey("txCountBetweenAccountsByTimeRange", fromAccount.Hex(), toAccount.Hex(), from, to), from, to, nil, &result, func() {
+		result = c.Store.GetTransactionCountBetweenAccountsByBlockTimeRange(fromAccount, toAccount, from, to)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionsBetweenAccountsByBlockTimeRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	var result *d.Transactions
+	c.byTime(cacheKey("txsBetweenAccountsByTimeRange", fromAccount.Hex(), toAccount.Hex(), from, to), from, to, page, &result, func() {
+		result = c.Store.GetTransactionsBetweenAccountsByBlockTimeRange(fromAccount, toAccount, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetContractCreationTransactionsFromAccountByBlockNumberRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	var result *d.Transactions
+	c.byNumber(cacheKey("contractCreationTxsByNumberRange", account.Hex(), from, to), from, to, page, &result, func() {
+		result = c.Store.GetContractCreationTransactionsFromAccountByBlockNumberRange(account, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetContractCreationTransactionsFromAccountByBlockTimeRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	var result *d.Transactions
+	c.byTime(cacheKey("contractCreationTxsByTimeRange", account.Hex(), from, to), from, to, page, &result, func() {
+		result = c.Store.GetContractCreationTransactionsFromAccountByBlockTimeRange(account, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionsByBlockNumberRangeWithFilter(filter *d.TransactionFilter, from uint64, to uint64, page *d.Page) *d.Transactions {
+	var result *d.Transactions
+	c.byNumber(cacheKey("txsByNumberRangeWithFilter", filterKey(filter), from, to), from, to, page, &result, func() {
+		result = c.Store.GetTransactionsByBlockNumberRangeWithFilter(filter, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionsByBlockTimeRangeWithFilter(filter *d.TransactionFilter, from uint64, to uint64, page *d.Page) *d.Transactions {
+	var result *d.Transactions
+	c.byTime(cacheKey("txsByTimeRangeWithFilter", filterKey(filter), from, to), from, to, page, &result, func() {
+		result = c.Store.GetTransactionsByBlockTimeRangeWithFilter(filter, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionAggregateByBlockNumberRange(filter *d.TransactionFilter, from uint64, to uint64) *d.TransactionAggregate {
+	var result *d.TransactionAggregate
+	c.byNumber(cacheKey("txAggregateByNumberRange", filterKey(filter), from, to), from, to, nil, &result, func() {
+		result = c.Store.GetTransactionAggregateByBlockNumberRange(filter, from, to)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetTransactionAggregateByBlockTimeRange(filter *d.TransactionFilter, from uint64, to uint64) *d.TransactionAggregate {
+	var result *d.TransactionAggregate
+	c.byTime(cacheKey("txAggregateByTimeRange", filterKey(filter), from, to), from, to, nil, &result, func() {
+		result = c.Store.GetTransactionAggregateByBlockTimeRange(filter, from, to)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetEventsFromContractByBlockNumberRange(contract common.Address, from uint64, to uint64, page *d.Page) *d.Events {
+	var result *d.Events
+	c.byNumber(cacheKey("eventsFromContractByNumberRange", contract.Hex(), from, to), from, to, page, &result, func() {
+		result = c.Store.GetEventsFromContractByBlockNumberRange(contract, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetEventsFromContractByBlockTimeRange(contract common.Address, from uint64, to uint64, page *d.Page) *d.Events {
+	var result *d.Events
+	c.byTime(cacheKey("eventsFromContractByTimeRange", contract.Hex(), from, to), from, to, page, &result, func() {
+		result = c.Store.GetEventsFromContractByBlockTimeRange(contract, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetEventsFromContractWithTopicsByBlockNumberRange(contract common.Address, from uint64, to uint64, topics map[uint8]string, page *d.Page) *d.Events {
+	var result *d.Events
+	c.byNumber(cacheKey("eventsFromContractWithTopicsByNumberRange", contract.Hex(), topicsKey(topics), from, to), from, to, page, &result, func() {
+		result = c.Store.GetEventsFromContractWithTopicsByBlockNumberRange(contract, from, to, topics, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetEventsFromContractWithTopicsByBlockTimeRange(contract common.Address, from uint64, to uint64, topics map[uint8]string, page *d.Page) *d.Events {
+	var result *d.Events
+	c.byTime(cacheKey("eventsFromContractWithTopicsByTimeRange", contract.Hex(), topicsKey(topics), from, to), from, to, page, &result, func() {
+		result = c.Store.GetEventsFromContractWithTopicsByBlockTimeRange(contract, from, to, topics, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetEventsByBlockNumberRangeWithFilter(filter *d.EventFilter, from uint64, to uint64, page *d.Page) *d.Events {
+	var result *d.Events
+	c.byNumber(cacheKey("eventsByNumberRangeWithFilter", eventFilterKey(filter), from, to), from, to, page, &result, func() {
+		result = c.Store.GetEventsByBlockNumberRangeWithFilter(filter, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetEventsByBlockTimeRangeWithFilter(filter *d.EventFilter, from uint64, to uint64, page *d.Page) *d.Events {
+	var result *d.Events
+	c.byTime(cacheKey("eventsByTimeRangeWithFilter", eventFilterKey(filter), from, to), from, to, page, &result, func() {
+		result = c.Store.GetEventsByBlockTimeRangeWithFilter(filter, from, to, page)
+	})
+
+	return result
+}
+
+func (c *cachedStore) GetEventByBlockNumberAndLogIndex(number uint64, index uint) *d.Event {
+	var result *d.Event
+	c.byNumber(cacheKey("eventByBlockNumberAndLogIndex", number, index), number, number, nil, &result, func() {
+		result = c.Store.GetEventByBlockNumberAndLogIndex(number, index)
+	})
+
+	return result
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"testing"
+
+	d "github.com/itzmeanjan/ette/app/data"
+)
+
+func TestTopicsKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		a      map[uint8]string
+		b      map[uint8]string
+		shared bool
+	}{
+		{"same topics", map[uint8]string{0: "0xab", 2: "0xcd"}, map[uint8]string{2: "0xcd", 0: "0xab"}, true},
+		{"different position", map[uint8]string{0: "0xab"}, map[uint8]string{1: "0xab"}, false},
+		{"different case", map[uint8]string{0: "0xAB"}, map[uint8]string{0: "0xab"}, false},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			if shared := topicsKey(v.a) == topicsKey(v.b); shared != v.shared {
+				t.Fatalf("expected key shared %v, found %v", v.shared, shared)
+			}
+		})
+	}
+}
+
+func TestEventFilterKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		a      *d.EventFilter
+		b      *d.EventFilter
+		shared bool
+	}{
+		{"nil filters", nil, nil, true},
+		{"contract order", &d.EventFilter{Contracts: []string{"0x1", "0x2"}}, &d.EventFilter{Contracts: []string{"0x2", "0x1"}}, true},
+		{"topic order", &d.EventFilter{Topics: [][]string{{"0xa", "0xb"}}}, &d.EventFilter{Topics: [][]string{{"0xb", "0xa"}}}, true},
+		{"topic position", &d.EventFilter{Topics: [][]string{{}, {"0xa"}}}, &d.EventFilter{Topics: [][]string{{"0xa"}}}, false},
+		{"topic case", &d.EventFilter{Topics: [][]string{{"0xAA"}}}, &d.EventFilter{Topics: [][]string{{"0xaa"}}}, false},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			if shared := eventFilterKey(v.a) == eventFilterKey(v.b); shared != v.shared {
+				t.Fatalf("expected key shared %v, found %v", v.shared, shared)
+			}
+		})
+	}
+
+	filter := &d.EventFilter{Contracts: []string{"0x2", "0x1"}}
+	eventFilterKey(filter)
+	if filter.Contracts[0] != "0x2" {
+		t.Fatal("expected filter to be left untouched")
+	}
+}
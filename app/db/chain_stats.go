@@ -0,0 +1,562 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	d "github.com/itzmeanjan/ette/app/data"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// How many sender addresses are touched in a single statement, keeping
+// well below sqlite's limit on number of bound variables
+const chainStatsSenderBatch = 200
+
+// How often per sender tx counts of final intervals are pruned
+const chainStatsPruneInterval = 10 * time.Minute
+
+// chainStatsKey - Identifies one rollup i.e. interval & its start time
+type chainStatsKey struct {
+	period string
+	start  uint64
+}
+
+// chainStatsDelta - Chain activity, to be added to/ subtracted from one rollup
+type chainStatsDelta struct {
+	blocks        uint64
+	txCount       uint64
+	gasUsed       uint64
+	gasPriceSum   *big.Int
+	baseFeeSum    *big.Int
+	baseFeeBlocks uint64
+	contracts     uint64
+	events        uint64
+	senders       map[string]uint64
+}
+
+// chainStatsDeltas - Chain activity, grouped by rollups it belongs to
+type chainStatsDeltas map[chainStatsKey]*chainStatsDelta
+
+// at - Delta of rollups of all intervals, covering given time
+func (c chainStatsDeltas) at(time uint64) []*chainStatsDelta {
+	deltas := make([]*chainStatsDelta, 0, len(d.ChainStatIntervals))
+
+	for period, width := range d.ChainStatIntervals {
+		key := chainStatsKey{period: period, start: time - time%width}
+
+		delta, ok := c[key]
+		if !ok {
+			delta = &chainStatsDelta{gasPriceSum: new(big.Int), baseFeeSum: new(big.Int), senders: make(map[string]uint64)}
+			c[key] = delta
+		}
+
+		deltas = append(deltas, delta)
+	}
+
+	return deltas
+}
+
+// numericToBig - Numeric value as big integer, where empty/ bad
+// values are considered to be absent
+func numericToBig(value d.Numeric) (*big.Int, bool) {
+	if value == "" {
+		return nil, false
+	}
+
+	return new(big.Int).SetString(string(value), 10)
+}
+
+// collectChainStats - Finds out chain activity in blocks, tx(s) & events satisfying
+// given conditions, grouped by rollups they belong to, using block time persisted
+// along with each of them
+//
+// To be used for finding out what a block adds to rollups, once it's written, and
+// what is to be subtracted, before it gets deleted/ its tx(s) get moved
+func collectChainStats(dbWTx *gorm.DB, blockCond string, blockArgs []interface{}, txCond string, txArgs []interface{}, eventCond string, eventArgs []interface{}) (chainStatsDeltas, error) {
+	deltas := make(chainStatsDeltas)
+
+	var blocks []*Blocks
+	if err := dbWTx.Model(&Blocks{}).Select("time, gasused, basefee").Where(blockCond, blockArgs...).Scan(&blocks).Error; err != nil {
+		return nil, err
+	}
+
+	for _, b := range blocks {
+		for _, delta := range deltas.at(b.Time) {
+			delta.blocks++
+			delta.gasUsed += b.GasUsed
+
+			if baseFee, ok := numericToBig(b.BaseFee); ok {
+				delta.baseFeeSum.Add(delta.baseFeeSum, baseFee)
+				delta.baseFeeBlocks++
+			}
+		}
+	}
+
+	var txs []*Transactions
+	if err := dbWTx.Model(&Transactions{}).Select("blocktime, \"from\", gasprice, contract").Where(txCond, txArgs...).Scan(&txs).Error; err != nil {
+		return nil, err
+	}
+
+	for _, t := range txs {
+		for _, delta := range deltas.at(t.BlockTime) {
+			delta.txCount++
+			delta.senders[t.From]++
+
+			if gasPrice, ok := numericToBig(t.GasPrice); ok {
+				delta.gasPriceSum.Add(delta.gasPriceSum, gasPrice)
+			}
+
+			if t.Contract != "" {
+				delta.contracts++
+			}
+		}
+	}
+
+	var events []*struct {
+		BlockTime uint64 `gorm:"column:blocktime"`
+		Count     uint64 `gorm:"column:count"`
+	}
+	if err := dbWTx.Model(&Events{}).Select("blocktime, count(*) as count").Where(eventCond, eventArgs...).Group("blocktime").Scan(&events).Error; err != nil {
+		return nil, err
+	}
+
+	for _, e := range events {
+		for _, delta := range deltas.at(e.BlockTime) {
+			delta.events += e.Count
+		}
+	}
+
+	return deltas, nil
+}
+
+// sortedChainStatsKeys - Rollups in order of interval & start time, the order
+// they're always visited in, so that concurrent block writers don't end up
+// dead locking each other
+func sortedChainStatsKeys(deltas chainStatsDeltas) []chainStatsKey {
+	keys := make([]chainStatsKey, 0, len(deltas))
+	for k := range deltas {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].period != keys[j].period {
+			return keys[i].period < keys[j].period
+		}
+
+		return keys[i].start < keys[j].start
+	})
+
+	return keys
+}
+
+// signedDelta - Value to be added to rollup column, which is negated
+// when activity is being subtracted
+func signedDelta(value uint64, revert bool) int64 {
+	if revert {
+		return -int64(value)
+	}
+
+	return int64(value)
+}
+
+// signedNumericDelta - Same as `signedDelta`, but for numeric columns
+func signedNumericDelta(value *big.Int, revert bool) d.Numeric {
+	if revert {
+		return d.Numeric(new(big.Int).Neg(value).String())
+	}
+
+	return d.Numeric(value.String())
+}
+
+// applyChainStats - Adds chain activity to/ subtracts from ( when `revert` is set )
+// respective rollups, creating them when required & deleting ones not covering
+// any block anymore
+func applyChainStats(dbWTx *gorm.DB, deltas chainStatsDeltas, revert bool) error {
+	for _, k := range sortedChainStatsKeys(deltas) {
+
+		var err error
+		if dbWTx.Dialector.Name() == sqliteDialect {
+			err = rewriteChainStats(dbWTx, k, deltas[k], revert)
+		} else {
+			err = upsertChainStats(dbWTx, k, deltas[k], revert)
+		}
+
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// upsertChainStats - Adds deltas to columns of rollup, in a single statement,
+// so that concurrent block writers touching same rollup don't overwrite
+// each other's changes, without locking the whole rollup up front
+func upsertChainStats(dbWTx *gorm.DB, k chainStatsKey, delta *chainStatsDelta, revert bool) error {
+	activeSenders, err := upsertChainStatsSenders(dbWTx, k, delta.senders, revert)
+	if err != nil {
+		return err
+	}
+
+	if err := dbWTx.Exec(`insert into chain_stats (period, start, blocks, txcount, gasused, gaspricesum, basefeesum, basefeeblocks, activesenders, contracts, events)
+		values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		on conflict (period, start) do update set
+			blocks = chain_stats.blocks + excluded.blocks,
+			txcount = chain_stats.txcount + excluded.txcount,
+			gasused = chain_stats.gasused + excluded.gasused,
+			gaspricesum = chain_stats.gaspricesum + excluded.gaspricesum,
+			basefeesum = chain_stats.basefeesum + excluded.basefeesum,
+			basefeeblocks = chain_stats.basefeeblocks + excluded.basefeeblocks,
+			activesenders = chain_stats.activesenders + excluded.activesenders,
+			contracts = chain_stats.contracts + excluded.contracts,
+			events = chain_stats.events + excluded.events`,
+		k.period, k.start,
+		signedDelta(delta.blocks, revert),
+		signedDelta(delta.txCount, revert),
+		signedDelta(delta.gasUsed, revert),
+		signedNumericDelta(delta.gasPriceSum, revert),
+		signedNumericDelta(delta.baseFeeSum, revert),
+		signedDelta(delta.baseFeeBlocks, revert),
+		activeSenders,
+		signedDelta(delta.contracts, revert),
+		signedDelta(delta.events, revert)).Error; err != nil {
+		return err
+	}
+
+	if !revert {
+		return nil
+	}
+
+	return dbWTx.Where("period = ? and start = ? and blocks <= 0", k.period, k.start).Delete(&ChainStats{}).Error
+}
+
+// upsertChainStatsSenders - Adds tx(s) sent to/ subtracts from ( when `revert` is set )
+// per sender counts of rollup, in place, returning by how much active sender count
+// of rollup changes, because of it
+//
+// Counts of intervals, which are already pruned, aren't present anymore, so
+// reverting tx(s) of those doesn't change active sender count
+func upsertChainStatsSenders(dbWTx *gorm.DB, key chainStatsKey, senders map[string]uint64, revert bool) (int64, error) {
+	addresses := make([]string, 0, len(senders))
+	for k := range senders {
+		addresses = append(addresses, k)
+	}
+
+	sort.Strings(addresses)
+
+	changed := int64(0)
+
+	for i := 0; i < len(addresses); i += chainStatsSenderBatch {
+
+		batch := addresses[i:]
+		if len(batch) > chainStatsSenderBatch {
+			batch = batch[:chainStatsSenderBatch]
+		}
+
+		values := make([]string, 0, len(batch))
+		args := make([]interface{}, 0, 4*len(batch)+2)
+
+		if revert {
+
+			for _, v := range batch {
+				values = append(values, "(?, ?::bigint)")
+				args = append(args, v, senders[v])
+			}
+
+			args = append(args, key.period, key.start)
+
+			if err := dbWTx.Exec(fmt.Sprintf(`update chain_stats_senders as s set txcount = s.txcount - v.txcount
+				from (values %s) as v(address, txcount)
+				where s.period = ? and s.start = ? and s.address = v.address`, strings.Join(values, ", ")), args...).Error; err != nil {
+				return 0, err
+			}
+
+			result := dbWTx.Where("period = ? and start = ? and address in ? and txcount <= 0", key.period, key.start, batch).Delete(&ChainStatsSenders{})
+			if result.Error != nil {
+				return 0, result.Error
+			}
+
+			changed -= result.RowsAffected
+			continue
+
+		}
+
+		for _, v := range batch {
+			values = append(values, "(?, ?, ?, ?)")
+			args = append(args, key.period, key.start, v, senders[v])
+		}
+
+		var counts []*ChainStatsSenders
+		if err := dbWTx.Raw(fmt.Sprintf(`insert into chain_stats_senders (period, start, address, txcount)
+			values %s
+			on conflict (period, start, address) do update set txcount = chain_stats_senders.txcount + excluded.txcount
+			returning address, txcount`, strings.Join(values, ", ")), args...).Scan(&counts).Error; err != nil {
+			return 0, err
+		}
+
+		// Sender became active during this interval, if its count is
+		// same as tx(s) being added now
+		for _, v := range counts {
+			if v.TxCount == senders[v.Address] {
+				changed++
+			}
+		}
+
+	}
+
+	return changed, nil
+}
+
+// rewriteChainStats - Reads rollup, adds deltas to/ subtracts from its columns
+// & writes it back, keeping numeric sums exact, because sqlite keeps those as
+// decimal strings, which can't be summed up in place
+//
+// It's safe, because sqlite lets only one writer transaction proceed at a time
+func rewriteChainStats(dbWTx *gorm.DB, k chainStatsKey, delta *chainStatsDelta, revert bool) error {
+	if err := dbWTx.Clauses(clause.OnConflict{DoNothing: true}).Create(&ChainStats{Period: k.period, Start: k.start, GasPriceSum: "0", BaseFeeSum: "0"}).Error; err != nil {
+		return err
+	}
+
+	var stats ChainStats
+	if err := dbWTx.Where("period = ? and start = ?", k.period, k.start).First(&stats).Error; err != nil {
+		return err
+	}
+
+	activeSenders, err := applyChainStatsSenders(dbWTx, k, delta.senders, revert)
+	if err != nil {
+		return err
+	}
+
+	gasPriceSum, _ := numericToBig(stats.GasPriceSum)
+	if gasPriceSum == nil {
+		gasPriceSum = new(big.Int)
+	}
+
+	baseFeeSum, _ := numericToBig(stats.BaseFeeSum)
+	if baseFeeSum == nil {
+		baseFeeSum = new(big.Int)
+	}
+
+	if revert {
+		stats.Blocks -= delta.blocks
+		stats.TxCount -= delta.txCount
+		stats.GasUsed -= delta.gasUsed
+		gasPriceSum.Sub(gasPriceSum, delta.gasPriceSum)
+		baseFeeSum.Sub(baseFeeSum, delta.baseFeeSum)
+		stats.BaseFeeBlocks -= delta.baseFeeBlocks
+		stats.ActiveSenders -= activeSenders
+		stats.Contracts -= delta.contracts
+		stats.Events -= delta.events
+	} else {
+		stats.Blocks += delta.blocks
+		stats.TxCount += delta.txCount
+		stats.GasUsed += delta.gasUsed
+		gasPriceSum.Add(gasPriceSum, delta.gasPriceSum)
+		baseFeeSum.Add(baseFeeSum, delta.baseFeeSum)
+		stats.BaseFeeBlocks += delta.baseFeeBlocks
+		stats.ActiveSenders += activeSenders
+		stats.Contracts += delta.contracts
+		stats.Events += delta.events
+	}
+
+	if stats.Blocks == 0 {
+		return dbWTx.Where("period = ? and start = ?", k.period, k.start).Delete(&ChainStats{}).Error
+	}
+
+	return dbWTx.Model(&ChainStats{}).Where("period = ? and start = ?", k.period, k.start).Updates(map[string]interface{}{
+		"blocks":        stats.Blocks,
+		"txcount":       stats.TxCount,
+		"gasused":       stats.GasUsed,
+		"gaspricesum":   d.Numeric(gasPriceSum.String()),
+		"basefeesum":    d.Numeric(baseFeeSum.String()),
+		"basefeeblocks": stats.BaseFeeBlocks,
+		"activesenders": stats.ActiveSenders,
+		"contracts":     stats.Contracts,
+		"events":        stats.Events,
+	}).Error
+}
+
+// applyChainStatsSenders - Adds tx(s) sent to/ subtracts from ( when `revert` is set )
+// per sender counts of rollup, returning how many senders became active/ inactive
+// during this interval, because of it
+func applyChainStatsSenders(dbWTx *gorm.DB, key chainStatsKey, senders map[string]uint64, revert bool) (uint64, error) {
+	addresses := make([]string, 0, len(senders))
+	for k := range senders {
+		addresses = append(addresses, k)
+	}
+
+	sort.Strings(addresses)
+
+	changed := uint64(0)
+
+	for i := 0; i < len(addresses); i += chainStatsSenderBatch {
+
+		batch := addresses[i:]
+		if len(batch) > chainStatsSenderBatch {
+			batch = batch[:chainStatsSenderBatch]
+		}
+
+		var existing []*ChainStatsSenders
+		if err := dbWTx.Where("period = ? and start = ? and address in ?", key.period, key.start, batch).Find(&existing).Error; err != nil {
+			return 0, err
+		}
+
+		counts := make(map[string]uint64)
+		for _, v := range existing {
+			counts[v.Address] = v.TxCount
+		}
+
+		upserts := make([]*ChainStatsSenders, 0, len(batch))
+		deletes := make([]string, 0)
+
+		for _, v := range batch {
+
+			count := counts[v]
+
+			if revert {
+				if count <= senders[v] {
+					if count != 0 {
+						deletes = append(deletes, v)
+						changed++
+					}
+
+					continue
+				}
+
+				count -= senders[v]
+			} else {
+				if count == 0 {
+					changed++
+				}
+
+				count += senders[v]
+			}
+
+			upserts = append(upserts, &ChainStatsSenders{Period: key.period, Start: key.start, Address: v, TxCount: count})
+
+		}
+
+		if len(deletes) != 0 {
+			if err := dbWTx.Where("period = ? and start = ? and address in ?", key.period, key.start, deletes).Delete(&ChainStatsSenders{}).Error; err != nil {
+				return 0, err
+			}
+		}
+
+		if len(upserts) != 0 {
+			if err := dbWTx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "period"}, {Name: "start"}, {Name: "address"}},
+				DoUpdates: clause.AssignmentColumns([]string{"txcount"}),
+			}).Create(upserts).Error; err != nil {
+				return 0, err
+			}
+		}
+
+	}
+
+	return changed, nil
+}
+
+// GetChainStats - Given interval & time range, returns rollups of chain
+// activity, starting within that range, in order of time
+func GetChainStats(db *gorm.DB, interval string, from uint64, to uint64) *d.ChainStats {
+	var rollups []*ChainStats
+
+	if err := db.Model(&ChainStats{}).Where("period = ? and start >= ? and start <= ?", interval, from, to).Order("start asc").Find(&rollups).Error; err != nil {
+		return nil
+	}
+
+	stats := make([]*d.ChainStat, 0, len(rollups))
+
+	for _, v := range rollups {
+
+		stat := &d.ChainStat{
+			Time:            v.Start,
+			Blocks:          v.Blocks,
+			Transactions:    v.TxCount,
+			GasUsed:         v.GasUsed,
+			AverageGasPrice: "0",
+			ActiveSenders:   v.ActiveSenders,
+			Contracts:       v.Contracts,
+			Events:          v.Events,
+		}
+
+		if gasPriceSum, ok := numericToBig(v.GasPriceSum); ok && v.TxCount != 0 {
+			stat.AverageGasPrice = d.Numeric(gasPriceSum.Quo(gasPriceSum, new(big.Int).SetUint64(v.TxCount)).String())
+		}
+
+		if baseFeeSum, ok := numericToBig(v.BaseFeeSum); ok && v.BaseFeeBlocks != 0 {
+			stat.AverageBaseFee = d.Numeric(baseFeeSum.Quo(baseFeeSum, new(big.Int).SetUint64(v.BaseFeeBlocks)).String())
+		}
+
+		stats = append(stats, stat)
+
+	}
+
+	return &d.ChainStats{Interval: interval, Stats: stats}
+}
+
+// PruneChainStatsSenders - Drops per sender tx counts of rollups, for intervals
+// which ended by given time, returning how many were dropped
+//
+// Those are only required for finding out whether sender is already active
+// during interval, when blocks of it get written/ reverted, so once interval
+// is final, only its active sender count is kept
+func PruneChainStatsSenders(db *gorm.DB, before uint64) (int64, error) {
+	pruned := int64(0)
+
+	for period, width := range d.ChainStatIntervals {
+
+		if before < width {
+			continue
+		}
+
+		result := db.Where("period = ? and start <= ?", period, before-width).Delete(&ChainStatsSenders{})
+		if result.Error != nil {
+			return pruned, result.Error
+		}
+
+		pruned += result.RowsAffected
+
+	}
+
+	return pruned, nil
+}
+
+// ManageChainStats - Keeps pruning per sender tx counts of rollups, for intervals
+// which ended before confirmed block got mined, until context gets cancelled
+//
+// It's done only when all blocks up to confirmed height are present, otherwise
+// those counts are still required for blocks of those intervals, yet to be synced
+func ManageChainStats(ctx context.Context, db *gorm.DB, status *d.StatusHolder) {
+	for {
+
+		if height, ok := confirmedHeight(status); ok && isSynced(status) {
+
+			if block := GetBlock(db, height); block != nil {
+
+				pruned, err := PruneChainStatsSenders(db, block.Time)
+				if err != nil {
+					log.WithError(err).Error("Failed to prune chain stats senders")
+				} else if pruned != 0 {
+					log.WithFields(log.Fields{"before": block.Time, "count": pruned}).Debug("Pruned chain stats senders")
+				}
+
+			}
+
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(chainStatsPruneInterval):
+		}
+
+	}
+}
@@ -1,28 +1,103 @@
 package db
 
 import (
+	"context"
 	"fmt"
-	"log"
 
 	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	q "github.com/itzmeanjan/ette/app/queue"
+	log "github.com/sirupsen/logrus"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
-// Connect - Connecting to postgresql database
-func Connect() *gorm.DB {
-	_db, err := gorm.Open(postgres.Open(fmt.Sprintf("postgresql://%s:%s@%s:%s/%s",
-		cfg.Get("DB_USER"), cfg.Get("DB_PASSWORD"), cfg.Get("DB_HOST"),
-		cfg.Get("DB_PORT"), cfg.Get("DB_NAME"))),
-		&gorm.Config{
-			Logger:                 logger.Default.LogMode(logger.Silent),
-			SkipDefaultTransaction: true, // all db writing to be wrapped inside transaction manually
-		})
+// Connect - Connecting to database engine chosen using `Database` in configuration,
+// applying pending schema migrations & returning store to be used for talking to it
+func Connect() Store {
+	_db, dialect := open()
+
+	applied, err := (&Migrator{db: _db, dialect: dialect}).Up()
+	if err != nil {
+		log.WithError(err).Fatal("Failed to apply schema migrations")
+	}
+
+	for _, v := range applied {
+		log.WithFields(log.Fields{"version": v.Version, "description": v.Description}).Info("Applied schema migration")
+	}
+
+	if dialect == sqliteDialect {
+		return &sqliteStore{gormStore{db: _db}}
+	}
+
+	partitions, err := setUpPartitioning(_db)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to set up partitioning")
+	}
+
+	return &postgresStore{gormStore: gormStore{db: _db, replicas: connectReplicas(_db)}, partitions: partitions}
+}
+
+// Opens connection to database engine chosen using configuration,
+// returning handle along with engine name
+func open() (*gorm.DB, string) {
+	if cfg.Current().Database == sqliteDialect {
+		return openSQLite(), sqliteDialect
+	}
+
+	return openPostgres(), postgresDialect
+}
+
+// postgresStore - Store backed by postgresql, where every operation
+// is served by database engine agnostic implementation, except
+// partition management, which is postgres specific
+type postgresStore struct {
+	gormStore
+	partitions *partitionManager
+}
+
+// StoreBlock - Persists block, creating partition for it first, if
+// tables are range partitioned & it's not yet present
+func (p *postgresStore) StoreBlock(block *PackedBlock, status *d.StatusHolder, queue *q.BlockProcessorQueue) error {
+	if p.partitions != nil && block != nil && block.Block != nil {
+		if err := p.partitions.ensureFor(block.Block.Number); err != nil {
+			return err
+		}
+	}
+
+	return p.gormStore.StoreBlock(block, status, queue)
+}
+
+// ManagePartitions - Keeps creating partitions ahead of chain head & dropping
+// ones beyond retention, when partitioning is enabled
+func (p *postgresStore) ManagePartitions(ctx context.Context, status *d.StatusHolder) {
+	if p.partitions == nil {
+		return
+	}
+
+	p.partitions.run(ctx, status)
+}
+
+// Connecting to postgresql database
+func openPostgres() *gorm.DB {
+	_config := cfg.Current()
+
+	_db, err := openPostgresURL(fmt.Sprintf("postgresql://%s:%s@%s:%d/%s",
+		_config.DBUser, _config.DBPassword, _config.DBHost,
+		_config.DBPort, _config.DBName))
 	if err != nil {
-		log.Fatalf("[!] Failed to connect to db : %s\n", err.Error())
+		log.WithError(err).Fatal("Failed to connect to db")
 	}
 
-	_db.AutoMigrate(&Blocks{}, &Transactions{}, &Events{}, &Users{}, &DeliveryHistory{}, &SubscriptionPlans{}, &SubscriptionDetails{})
 	return _db
 }
+
+// Connecting to postgresql database, primary or replica, using connection URL
+func openPostgresURL(url string) (*gorm.DB, error) {
+	return gorm.Open(postgres.Open(url),
+		&gorm.Config{
+			Logger:                 logger.Default.LogMode(logger.Silent),
+			SkipDefaultTransaction: true, // all db writing to be wrapped inside transaction manually
+		})
+}
@@ -1,9 +1,10 @@
 package db
 
 import (
-	"log"
 	"time"
 
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
@@ -12,11 +13,37 @@ import (
 // dataLength is length of data in bytes, sent to client application
 func PutDataDeliveryInfo(_db *gorm.DB, client string, endPoint string, dataLength uint64) {
 	if err := _db.Create(&DeliveryHistory{
+		ID:         uuid.New().String(),
 		Client:     client,
 		TimeStamp:  time.Now().UTC(),
 		EndPoint:   endPoint,
 		DataLength: dataLength,
 	}).Error; err != nil {
-		log.Printf("[!] Failed to persist data delivery info : %s\n", err.Error())
+		log.WithError(err).Error("Failed to persist data delivery info")
+	}
+}
+
+// PutDataDeliveryInfos - Persisting info of multiple data deliveries, made to same
+// client in one go i.e. calls of JSON-RPC batch, each counted against plan
+func PutDataDeliveryInfos(_db *gorm.DB, client string, endPoint string, dataLengths []uint64) {
+	if len(dataLengths) == 0 {
+		return
+	}
+
+	now := time.Now().UTC()
+	entries := make([]*DeliveryHistory, 0, len(dataLengths))
+
+	for _, v := range dataLengths {
+		entries = append(entries, &DeliveryHistory{
+			ID:         uuid.New().String(),
+			Client:     client,
+			TimeStamp:  now,
+			EndPoint:   endPoint,
+			DataLength: v,
+		})
+	}
+
+	if err := _db.CreateInBatches(entries, 100).Error; err != nil {
+		log.WithError(err).Error("Failed to persist data delivery info")
 	}
 }
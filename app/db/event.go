@@ -4,7 +4,6 @@ import (
 	"errors"
 
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 )
 
 // UpsertEvent - It may be the case previously this block was processed
@@ -20,7 +19,9 @@ func UpsertEvent(dbWTx *gorm.DB, event *Events) error {
 		return errors.New("empty event received while attempting to persist")
 	}
 
-	return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).Create(event).Error
+	event.SpreadTopics()
+
+	return upsert(dbWTx, event, "blockhash", "index", "blocknumber")
 
 }
 
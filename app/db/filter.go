@@ -0,0 +1,212 @@
+package db
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/itzmeanjan/ette/app/data"
+	"gorm.io/gorm"
+)
+
+// valueCondition - Builds condition for comparing numeric column with given value,
+// using `>=` or `<=`
+//
+// sqlite keeps numeric values as decimal strings, which are compared by
+// length first & then lexicographically, giving same result
+func valueCondition(db *gorm.DB, column string, operator string, value *big.Int) (string, []interface{}) {
+	_value := value.String()
+
+	if db.Dialector.Name() != sqliteDialect {
+		return fmt.Sprintf("%s %s ?", column, operator), []interface{}{_value}
+	}
+
+	lengthOperator := ">"
+	if operator == "<=" {
+		lengthOperator = "<"
+	}
+
+	return fmt.Sprintf("(length(%[1]s) %[2]s length(?) or (length(%[1]s) = length(?) and %[1]s %[3]s ?))", column, lengthOperator, operator),
+		[]interface{}{_value, _value, _value}
+}
+
+// filterTransactions - Applies all criteria set in filter, on tx(s) query
+func filterTransactions(db *gorm.DB, query *gorm.DB, filter *data.TransactionFilter) *gorm.DB {
+	if filter == nil {
+		return query
+	}
+
+	if filter.From != nil {
+		query = query.Where("transactions.\"from\" = ?", filter.From.Hex())
+	}
+
+	if filter.To != nil {
+		query = query.Where("transactions.\"to\" = ?", filter.To.Hex())
+	}
+
+	if filter.MinValue != nil {
+		condition, args := valueCondition(db, "transactions.value", ">=", filter.MinValue)
+		query = query.Where(condition, args...)
+	}
+
+	if filter.MaxValue != nil {
+		condition, args := valueCondition(db, "transactions.value", "<=", filter.MaxValue)
+		query = query.Where(condition, args...)
+	}
+
+	if filter.ContractCreation {
+		query = query.Where("transactions.contract <> ''")
+	}
+
+	return query
+}
+
+// GetTransactionsByBlockNumberRangeWithFilter - Given block number range & filter, returns
+// all tx(s) satisfying filter, in that block range
+func GetTransactionsByBlockNumberRangeWithFilter(db *gorm.DB, filter *data.TransactionFilter, from uint64, to uint64, page *data.Page) *data.Transactions {
+	var tx []*data.Transaction
+
+	query := paginateTransactions(filterTransactions(db, db.Model(&Transactions{}).Where("transactions.blocknumber >= ? and transactions.blocknumber <= ?", from, to), filter), page)
+
+	if err := query.Select("transactions.hash, transactions.\"from\", transactions.\"to\", transactions.contract, transactions.value, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.blocknumber, transactions.txindex").Find(&tx).Error; err != nil {
+		return nil
+	}
+
+	if len(tx) == 0 {
+		return nil
+	}
+
+	return transactionsPage(tx, page)
+}
+
+// GetTransactionsByBlockTimeRangeWithFilter - Given block time range & filter, returns
+// all tx(s) satisfying filter, in that time span
+func GetTransactionsByBlockTimeRangeWithFilter(db *gorm.DB, filter *data.TransactionFilter, from uint64, to uint64, page *data.Page) *data.Transactions {
+	var tx []*data.Transaction
+
+	query := paginateTransactions(filterTransactions(db, db.Model(&Transactions{}).Where("transactions.blocktime >= ? and transactions.blocktime <= ?", from, to), filter), page)
+
+	if err := query.Select("transactions.hash, transactions.\"from\", transactions.\"to\", transactions.contract, transactions.value, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.blocknumber, transactions.txindex").Find(&tx).Error; err != nil {
+		return nil
+	}
+
+	if len(tx) == 0 {
+		return nil
+	}
+
+	return transactionsPage(tx, page)
+}
+
+// aggregateTransactions - Counts, sums & averages value of all tx(s) matched by query
+//
+// sqlite can't sum up numeric values of this size without losing precision,
+// so they're summed up in application itself
+func aggregateTransactions(db *gorm.DB, query *gorm.DB) *data.TransactionAggregate {
+	if db.Dialector.Name() != sqliteDialect {
+		var aggregate data.TransactionAggregate
+
+		if err := query.Select("count(*) as count, coalesce(sum(transactions.value), 0) as total_value, coalesce(trunc(avg(transactions.value)), 0) as average_value").Scan(&aggregate).Error; err != nil {
+			return nil
+		}
+
+		return &aggregate
+	}
+
+	var values []data.Numeric
+	if err := query.Pluck("transactions.value", &values).Error; err != nil {
+		return nil
+	}
+
+	total := new(big.Int)
+	count := int64(0)
+
+	for _, v := range values {
+		_v, ok := new(big.Int).SetString(string(v), 10)
+		if !ok {
+			continue
+		}
+
+		total.Add(total, _v)
+		count++
+	}
+
+	average := new(big.Int)
+	if count != 0 {
+		average.Quo(total, big.NewInt(count))
+	}
+
+	return &data.TransactionAggregate{
+		Count:        uint64(len(values)),
+		TotalValue:   data.Numeric(total.String()),
+		AverageValue: data.Numeric(average.String()),
+	}
+}
+
+// GetTransactionAggregateByBlockNumberRange - Given block number range & filter, returns count, total
+// & average value of tx(s) satisfying filter, in that block range
+func GetTransactionAggregateByBlockNumberRange(db *gorm.DB, filter *data.TransactionFilter, from uint64, to uint64) *data.TransactionAggregate {
+	return aggregateTransactions(db, filterTransactions(db, db.Model(&Transactions{}).Where("transactions.blocknumber >= ? and transactions.blocknumber <= ?", from, to), filter))
+}
+
+// GetTransactionAggregateByBlockTimeRange - Given block time range & filter, returns count, total
+// & average value of tx(s) satisfying filter, in that time span
+func GetTransactionAggregateByBlockTimeRange(db *gorm.DB, filter *data.TransactionFilter, from uint64, to uint64) *data.TransactionAggregate {
+	return aggregateTransactions(db, filterTransactions(db, db.Model(&Transactions{}).Where("transactions.blocktime >= ? and transactions.blocktime <= ?", from, to), filter))
+}
+
+// filterEvents - Applies all criteria set in filter, on events query, where
+// each topic set is matched against its positional column
+func filterEvents(query *gorm.DB, filter *data.EventFilter) *gorm.DB {
+	if filter == nil {
+		return query
+	}
+
+	if len(filter.Contracts) != 0 {
+		query = query.Where("events.origin in ?", filter.Contracts)
+	}
+
+	for k, v := range filter.Topics {
+		if len(v) == 0 {
+			continue
+		}
+
+		query = query.Where(fmt.Sprintf("events.topic%d in ?", k), v)
+	}
+
+	return query
+}
+
+// GetEventsByBlockNumberRangeWithFilter - Given block number range & filter, returns
+// all events satisfying filter, emitted in that block range
+func GetEventsByBlockNumberRangeWithFilter(db *gorm.DB, filter *data.EventFilter, from uint64, to uint64, page *data.Page) *data.Events {
+	var events []*data.Event
+
+	query := paginateEvents(filterEvents(db.Model(&Events{}).Where("events.blocknumber >= ? and events.blocknumber <= ?", from, to), filter), page)
+
+	if err := query.Select("events.origin, events.\"index\", events.topics, events.data, events.txhash, events.blockhash, events.blocknumber").Find(&events).Error; err != nil {
+		return nil
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	return eventsPage(events, page)
+}
+
+// GetEventsByBlockTimeRangeWithFilter - Given block time range & filter, returns
+// all events satisfying filter, emitted in that time span
+func GetEventsByBlockTimeRangeWithFilter(db *gorm.DB, filter *data.EventFilter, from uint64, to uint64, page *data.Page) *data.Events {
+	var events []*data.Event
+
+	query := paginateEvents(filterEvents(db.Model(&Events{}).Where("events.blocktime >= ? and events.blocktime <= ?", from, to), filter), page)
+
+	if err := query.Select("events.origin, events.\"index\", events.topics, events.data, events.txhash, events.blockhash, events.blocknumber").Find(&events).Error; err != nil {
+		return nil
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	return eventsPage(events, page)
+}
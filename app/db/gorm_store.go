@@ -0,0 +1,505 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	d "github.com/itzmeanjan/ette/app/data"
+	q "github.com/itzmeanjan/ette/app/queue"
+	"gorm.io/gorm"
+)
+
+// gormStore - Database engine agnostic `Store` implementation, delegating
+// to package level query functions, which are written using portable SQL
+//
+// Engine specific implementations embed it & override only those
+// operations, which can't be expressed in portable manner
+//
+// Read only queries are sent to replicas, when configured, given they've
+// applied blocks being queried, otherwise primary is used
+type gormStore struct {
+	db       *gorm.DB
+	replicas *replicaSet
+}
+
+// readerForBlock - Replica which has applied block with given number, otherwise primary
+func (s *gormStore) readerForBlock(number uint64) *gorm.DB {
+	if _db := s.replicas.forBlock(number); _db != nil {
+		return _db
+	}
+
+	return s.db
+}
+
+// readerForTime - Replica which has applied block mined at given time, otherwise primary
+func (s *gormStore) readerForTime(time uint64) *gorm.DB {
+	if _db := s.replicas.forTime(time); _db != nil {
+		return _db
+	}
+
+	return s.db
+}
+
+// readerForLatest - Replica which has caught up with primary, otherwise primary
+func (s *gormStore) readerForLatest() *gorm.DB {
+	if _db := s.replicas.forLatest(); _db != nil {
+		return _db
+	}
+
+	return s.db
+}
+
+func (s *gormStore) StoreBlock(block *PackedBlock, status *d.StatusHolder, queue *q.BlockProcessorQueue) error {
+	return StoreBlock(s.db, block, status, queue)
+}
+
+func (s *gormStore) GetBlock(number uint64) *Blocks {
+	return GetBlock(s.db, number)
+}
+
+func (s *gormStore) GetAllBlockNumbersInRange(from uint64, to uint64) []uint64 {
+	return GetAllBlockNumbersInRange(s.db, from, to)
+}
+
+func (s *gormStore) GetCurrentOldestBlockNumber() uint64 {
+	return GetCurrentOldestBlockNumber(s.db)
+}
+
+func (s *gormStore) GetCurrentBlockNumber() uint64 {
+	return GetCurrentBlockNumber(s.db)
+}
+
+func (s *gormStore) GetBlockCount() uint64 {
+	return GetBlockCount(s.db)
+}
+
+func (s *gormStore) GetBlockByHash(hash common.Hash) *d.Block {
+	if _db := s.replicas.forAny(); _db != nil {
+		if result := GetBlockByHash(_db, hash); result != nil {
+			return result
+		}
+	}
+
+	return GetBlockByHash(s.db, hash)
+}
+
+func (s *gormStore) GetBlockByNumber(number uint64) *d.Block {
+	return GetBlockByNumber(s.readerForBlock(number), number)
+}
+
+func (s *gormStore) GetBlocksByNumberRange(from uint64, to uint64, page *d.Page) *d.Blocks {
+	return GetBlocksByNumberRange(s.readerForBlock(to), from, to, page)
+}
+
+func (s *gormStore) GetBlocksByTimeRange(from uint64, to uint64, page *d.Page) *d.Blocks {
+	return GetBlocksByTimeRange(s.readerForTime(to), from, to, page)
+}
+
+func (s *gormStore) GetBlockByTime(at uint64, after bool) (*d.Block, error) {
+	return GetBlockByTime(s.readerForTime(at), at, after)
+}
+
+func (s *gormStore) GetTransactionCountByBlockHash(hash common.Hash) int64 {
+	if _db := s.replicas.forAny(); _db != nil {
+		if result := GetTransactionCountByBlockHash(_db, hash); result != 0 {
+			return result
+		}
+	}
+
+	return GetTransactionCountByBlockHash(s.db, hash)
+}
+
+func (s *gormStore) GetTransactionsByBlockHash(hash common.Hash, page *d.Page) *d.Transactions {
+	if _db := s.replicas.forAny(); _db != nil {
+		if result := GetTransactionsByBlockHash(_db, hash, page); result != nil {
+			return result
+		}
+	}
+
+	return GetTransactionsByBlockHash(s.db, hash, page)
+}
+
+func (s *gormStore) GetTransactionCountByBlockNumber(number uint64) int64 {
+	return GetTransactionCountByBlockNumber(s.readerForBlock(number), number)
+}
+
+func (s *gormStore) GetTransactionsByBlockNumber(number uint64, page *d.Page) *d.Transactions {
+	return GetTransactionsByBlockNumber(s.readerForBlock(number), number, page)
+}
+
+func (s *gormStore) GetTransactionByHash(hash common.Hash) *d.Transaction {
+	if _db := s.replicas.forAny(); _db != nil {
+		if result := GetTransactionByHash(_db, hash); result != nil {
+			return result
+		}
+	}
+
+	return GetTransactionByHash(s.db, hash)
+}
+
+func (s *gormStore) GetTransactionCountFromAccountByBlockNumberRange(account common.Address, from uint64, to uint64) int64 {
+	return GetTransactionCountFromAccountByBlockNumberRange(s.readerForBlock(to), account, from, to)
+}
+
+func (s *gormStore) GetTransactionsFromAccountByBlockNumberRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	return GetTransactionsFromAccountByBlockNumberRange(s.readerForBlock(to), account, from, to, page)
+}
+
+func (s *gormStore) GetTransactionCountFromAccountByBlockTimeRange(account common.Address, from uint64, to uint64) int64 {
+	return GetTransactionCountFromAccountByBlockTimeRange(s.readerForTime(to), account, from, to)
+}
+
+func (s *gormStore) GetTransactionsFromAccountByBlockTimeRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	return GetTransactionsFromAccountByBlockTimeRange(s.readerForTime(to), account, from, to, page)
+}
+
+func (s *gormStore) GetTransactionCountToAccountByBlockNumberRange(account common.Address, from uint64, to uint64) int64 {
+	return GetTransactionCountToAccountByBlockNumberRange(s.readerForBlock(to), account, from, to)
+}
+
+func (s *gormStore) GetTransactionsToAccountByBlockNumberRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	return GetTransactionsToAccountByBlockNumberRange(s.readerForBlock(to), account, from, to, page)
+}
+
+func (s *gormStore) GetTransactionCountToAccountByBlockTimeRange(account common.Address, from uint64, to uint64) int64 {
+	return GetTransactionCountToAccountByBlockTimeRange(s.readerForTime(to), account, from, to)
+}
+
+func (s *gormStore) GetTransactionsToAccountByBlockTimeRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	return GetTransactionsToAccountByBlockTimeRange(s.readerForTime(to), account, from, to, page)
+}
+
+func (s *gormStore) GetTransactionCountBetweenAccountsByBlockNumberRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64) int64 {
+	return GetTransactionCountBetweenAccountsByBlockNumberRange(s.readerForBlock(to), fromAccount, toAccount, from, to)
+}
+
+func (s *gormStore) GetTransactionsBetweenAccountsByBlockNumberRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	return GetTransactionsBetweenAccountsByBlockNumberRange(s.readerForBlock(to), fromAccount, toAccount, from, to, page)
+}
+
+func (s *gormStore) GetTransactionCountBetweenAccountsByBlockTimeRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64) int64 {
+	return GetTransactionCountBetweenAccountsByBlockTimeRange(s.readerForTime(to), fromAccount, toAccount, from, to)
+}
+
+func (s *gormStore) GetTransactionsBetweenAccountsByBlockTimeRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	return GetTransactionsBetweenAccountsByBlockTimeRange(s.readerForTime(to), fromAccount, toAccount, from, to, page)
+}
+
+func (s *gormStore) GetContractCreationTransactionsFromAccountByBlockNumberRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	return GetContractCreationTransactionsFromAccountByBlockNumberRange(s.readerForBlock(to), account, from, to, page)
+}
+
+func (s *gormStore) GetContractCreationTransactionsFromAccountByBlockTimeRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions {
+	return GetContractCreationTransactionsFromAccountByBlockTimeRange(s.readerForTime(to), account, from, to, page)
+}
+
+func (s *gormStore) GetTransactionFromAccountWithNonce(account common.Address, nonce uint64) *d.Transaction {
+	if _db := s.replicas.forAny(); _db != nil {
+		if result := GetTransactionFromAccountWithNonce(_db, account, nonce); result != nil {
+			return result
+		}
+	}
+
+	return GetTransactionFromAccountWithNonce(s.db, account, nonce)
+}
+
+func (s *gormStore) GetTransactionsByBlockNumberRangeWithFilter(filter *d.TransactionFilter, from uint64, to uint64, page *d.Page) *d.Transactions {
+	return GetTransactionsByBlockNumberRangeWithFilter(s.readerForBlock(to), filter, from, to, page)
+}
+
+func (s *gormStore) GetTransactionsByBlockTimeRangeWithFilter(filter *d.TransactionFilter, from uint64, to uint64, page *d.Page) *d.Transactions {
+	return GetTransactionsByBlockTimeRangeWithFilter(s.readerForTime(to), filter, from, to, page)
+}
+
+func (s *gormStore) GetTransactionAggregateByBlockNumberRange(filter *d.TransactionFilter, from uint64, to uint64) *d.TransactionAggregate {
+	return GetTransactionAggregateByBlockNumberRange(s.readerForBlock(to), filter, from, to)
+}
+
+func (s *gormStore) GetTransactionAggregateByBlockTimeRange(filter *d.TransactionFilter, from uint64, to uint64) *d.TransactionAggregate {
+	return GetTransactionAggregateByBlockTimeRange(s.readerForTime(to), filter, from, to)
+}
+
+func (s *gormStore) GetEventsFromContractByBlockNumberRange(contract common.Address, from uint64, to uint64, page *d.Page) *d.Events {
+	return GetEventsFromContractByBlockNumberRange(s.readerForBlock(to), contract, from, to, page)
+}
+
+func (s *gormStore) GetEventsFromContractByBlockTimeRange(contract common.Address, from uint64, to uint64, page *d.Page) *d.Events {
+	return GetEventsFromContractByBlockTimeRange(s.readerForTime(to), contract, from, to, page)
+}
+
+func (s *gormStore) GetEventsByBlockHash(blockHash common.Hash, page *d.Page) *d.Events {
+	if _db := s.replicas.forAny(); _db != nil {
+		if result := GetEventsByBlockHash(_db, blockHash, page); result != nil {
+			return result
+		}
+	}
+
+	return GetEventsByBlockHash(s.db, blockHash, page)
+}
+
+func (s *gormStore) GetEventsByTransactionHash(txHash common.Hash, page *d.Page) *d.Events {
+	if _db := s.replicas.forAny(); _db != nil {
+		if result := GetEventsByTransactionHash(_db, txHash, page); result != nil {
+			return result
+		}
+	}
+
+	return GetEventsByTransactionHash(s.db, txHash, page)
+}
+
+func (s *gormStore) GetEventsFromContractWithTopicsByBlockNumberRange(contract common.Address, from uint64, to uint64, topics map[uint8]string, page *d.Page) *d.Events {
+	return GetEventsFromContractWithTopicsByBlockNumberRange(s.readerForBlock(to), contract, from, to, topics, page)
+}
+
+func (s *gormStore) GetEventsFromContractWithTopicsByBlockTimeRange(contract common.Address, from uint64, to uint64, topics map[uint8]string, page *d.Page) *d.Events {
+	return GetEventsFromContractWithTopicsByBlockTimeRange(s.readerForTime(to), contract, from, to, topics, page)
+}
+
+func (s *gormStore) GetTransactionIndexes(from uint64, to uint64, hashes []string) map[string]uint {
+	return GetTransactionIndexes(s.readerForBlock(to), from, to, hashes)
+}
+
+func (s *gormStore) GetEventsByBlockNumberRangeWithFilter(filter *d.EventFilter, from uint64, to uint64, page *d.Page) *d.Events {
+	return GetEventsByBlockNumberRangeWithFilter(s.readerForBlock(to), filter, from, to, page)
+}
+
+func (s *gormStore) GetEventsByBlockTimeRangeWithFilter(filter *d.EventFilter, from uint64, to uint64, page *d.Page) *d.Events {
+	return GetEventsByBlockTimeRangeWithFilter(s.readerForTime(to), filter, from, to, page)
+}
+
+func (s *gormStore) GetLastXEventsFromContract(contract common.Address, x int) *d.Events {
+	return GetLastXEventsFromContract(s.readerForLatest(), contract, x)
+}
+
+func (s *gormStore) GetEventByBlockHashAndLogIndex(hash common.Hash, index uint) *d.Event {
+	if _db := s.replicas.forAny(); _db != nil {
+		if result := GetEventByBlockHashAndLogIndex(_db, hash, index); result != nil {
+			return result
+		}
+	}
+
+	return GetEventByBlockHashAndLogIndex(s.db, hash, index)
+}
+
+func (s *gormStore) GetEventByBlockNumberAndLogIndex(number uint64, index uint) *d.Event {
+	return GetEventByBlockNumberAndLogIndex(s.readerForBlock(number), number, index)
+}
+
+func (s *gormStore) StreamBlocksByNumberRange(from uint64, to uint64, fn func(*d.Block) error) error {
+	return StreamBlocksByNumberRange(s.readerForBlock(to), from, to, fn)
+}
+
+func (s *gormStore) StreamBlocksByTimeRange(from uint64, to uint64, fn func(*d.Block) error) error {
+	return StreamBlocksByTimeRange(s.readerForTime(to), from, to, fn)
+}
+
+func (s *gormStore) StreamTransactionsByBlockNumberRangeWithFilter(filter *d.TransactionFilter, from uint64, to uint64, fn func(*d.Transaction) error) error {
+	return StreamTransactionsByBlockNumberRangeWithFilter(s.readerForBlock(to), filter, from, to, fn)
+}
+
+func (s *gormStore) StreamTransactionsByBlockTimeRangeWithFilter(filter *d.TransactionFilter, from uint64, to uint64, fn func(*d.Transaction) error) error {
+	return StreamTransactionsByBlockTimeRangeWithFilter(s.readerForTime(to), filter, from, to, fn)
+}
+
+func (s *gormStore) StreamEventsByBlockNumberRangeWithFilter(filter *d.EventFilter, from uint64, to uint64, fn func(*d.Event) error) error {
+	return StreamEventsByBlockNumberRangeWithFilter(s.readerForBlock(to), filter, from, to, fn)
+}
+
+func (s *gormStore) StreamEventsByBlockTimeRangeWithFilter(filter *d.EventFilter, from uint64, to uint64, fn func(*d.Event) error) error {
+	return StreamEventsByBlockTimeRangeWithFilter(s.readerForTime(to), filter, from, to, fn)
+}
+
+func (s *gormStore) GetAccount(address common.Address) *d.Account {
+	return GetAccount(s.readerForLatest(), address)
+}
+
+func (s *gormStore) GetChainStats(interval string, from uint64, to uint64) *d.ChainStats {
+	return GetChainStats(s.readerForTime(to), interval, from, to)
+}
+
+func (s *gormStore) GetAppsByUserAddress(address common.Address) []*Users {
+	return GetAppsByUserAddress(s.db, address)
+}
+
+func (s *gormStore) RegisterNewApp(address common.Address) bool {
+	return RegisterNewApp(s.db, address)
+}
+
+func (s *gormStore) ToggleAPIKeyState(apiKey string) bool {
+	return ToggleAPIKeyState(s.db, apiKey)
+}
+
+func (s *gormStore) GetUserFromAPIKey(apiKey string) *Users {
+	return GetUserFromAPIKey(s.db, apiKey)
+}
+
+func (s *gormStore) ValidateAPIKey(apiKey string) bool {
+	return ValidateAPIKey(s.db, apiKey)
+}
+
+func (s *gormStore) IsUnderRateLimit(userAddress string) bool {
+	return IsUnderRateLimit(s.db, userAddress)
+}
+
+func (s *gormStore) GetRemainingDeliveries(userAddress string) uint64 {
+	return GetRemainingDeliveries(s.db, userAddress)
+}
+
+func (s *gormStore) DropOldDeliveryHistories() {
+	DropOldDeliveryHistories(s.db)
+}
+
+func (s *gormStore) PutDataDeliveryInfo(client string, endPoint string, dataLength uint64) {
+	PutDataDeliveryInfo(s.db, client, endPoint, dataLength)
+}
+
+func (s *gormStore) PutDataDeliveryInfos(client string, endPoint string, dataLengths []uint64) {
+	PutDataDeliveryInfos(s.db, client, endPoint, dataLengths)
+}
+
+func (s *gormStore) RegisterWebhook(apiKey string, name string, url string) *Webhooks {
+	return RegisterWebhook(s.db, apiKey, name, url)
+}
+
+func (s *gormStore) GetWebhooksByUserAddress(address common.Address) []*Webhooks {
+	return GetWebhooksByUserAddress(s.db, address)
+}
+
+func (s *gormStore) ToggleWebhookState(address common.Address, id string) bool {
+	return ToggleWebhookState(s.db, address, id)
+}
+
+func (s *gormStore) DeleteWebhook(address common.Address, id string) bool {
+	return DeleteWebhook(s.db, address, id)
+}
+
+func (s *gormStore) GetEnabledWebhooks() []*Webhooks {
+	return GetEnabledWebhooks(s.db)
+}
+
+func (s *gormStore) PutWebhookDelivery(delivery *WebhookDeliveries) bool {
+	return PutWebhookDelivery(s.db, delivery)
+}
+
+func (s *gormStore) UpdateWebhookDelivery(delivery *WebhookDeliveries) bool {
+	return UpdateWebhookDelivery(s.db, delivery)
+}
+
+func (s *gormStore) GetWebhookDeliveries(address common.Address, id string, limit int) []*WebhookDeliveries {
+	return GetWebhookDeliveries(s.db, address, id, limit)
+}
+
+func (s *gormStore) DropOldWebhookDeliveries(before time.Time) {
+	DropOldWebhookDeliveries(s.db, before)
+}
+
+func (s *gormStore) PersistAllSubscriptionPlans(file string) {
+	PersistAllSubscriptionPlans(s.db, file)
+}
+
+func (s *gormStore) ReloadSubscriptionPlans(plans []*Plan) ([]string, error) {
+	return ReloadSubscriptionPlans(s.db, plans)
+}
+
+func (s *gormStore) GetAllSubscriptionPlans() []*SubscriptionPlans {
+	return GetAllSubscriptionPlans(s.db)
+}
+
+func (s *gormStore) CheckSubscriptionPlanByAddress(address common.Address) *SubscriptionDetails {
+	return CheckSubscriptionPlanByAddress(s.db, address)
+}
+
+func (s *gormStore) CheckSubscriptionPlanDetailsByAddress(address common.Address) *SubscriptionPlans {
+	return CheckSubscriptionPlanDetailsByAddress(s.db, address)
+}
+
+func (s *gormStore) GetAllowedDeliveryCountByAddress(address common.Address) uint64 {
+	return GetAllowedDeliveryCountByAddress(s.db, address)
+}
+
+func (s *gormStore) IsValidSubscriptionPlan(id uint32) bool {
+	return IsValidSubscriptionPlan(s.db, id)
+}
+
+func (s *gormStore) GetDefaultSubscriptionPlanID() uint32 {
+	return GetDefaultSubscriptionPlanID(s.db)
+}
+
+func (s *gormStore) AddSubscriptionPlanForAddress(address common.Address, planID uint32) bool {
+	return AddSubscriptionPlanForAddress(s.db, address, planID)
+}
+
+func (s *gormStore) GetAllUsers() []*UserDetails {
+	return GetAllUsers(s.db)
+}
+
+func (s *gormStore) GetUserDetails(address common.Address) *UserDetails {
+	return GetUserDetails(s.db, address)
+}
+
+func (s *gormStore) SetAPIKeyState(apiKey string, enabled bool) bool {
+	return SetAPIKeyState(s.db, apiKey, enabled)
+}
+
+func (s *gormStore) SetSubscriptionPlanForAddress(address common.Address, planID uint32) bool {
+	return SetSubscriptionPlanForAddress(s.db, address, planID)
+}
+
+func (s *gormStore) GetUsageByAddress(address common.Address) *d.Usage {
+	return GetUsageByAddress(s.db, address)
+}
+
+func (s *gormStore) BanAddress(address common.Address, reason string) bool {
+	return BanAddress(s.db, address, reason)
+}
+
+func (s *gormStore) UnbanAddress(address common.Address) bool {
+	return UnbanAddress(s.db, address)
+}
+
+func (s *gormStore) IsBanned(address common.Address) bool {
+	return IsBanned(s.db, address)
+}
+
+func (s *gormStore) GetBannedAddresses() []*BannedAddresses {
+	return GetBannedAddresses(s.db)
+}
+
+// ManagePartitions - Nothing to manage, because tables are partitioned
+// only when postgres is being used & it's enabled
+func (s *gormStore) ManagePartitions(ctx context.Context, status *d.StatusHolder) {}
+
+// ManageChainStats - Prunes per sender tx counts of rollups, which aren't
+// required anymore, until context gets cancelled
+func (s *gormStore) ManageChainStats(ctx context.Context, status *d.StatusHolder) {
+	ManageChainStats(ctx, s.db, status)
+}
+
+// CacheStats - Nothing to report, because query results are cached only
+// when store is wrapped using `WithCache`
+func (s *gormStore) CacheStats() *d.CacheStats {
+	return nil
+}
+
+func (s *gormStore) Ping(ctx context.Context) error {
+	sql, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+
+	return sql.PingContext(ctx)
+}
+
+func (s *gormStore) Close() error {
+	if err := s.replicas.close(); err != nil {
+		return err
+	}
+
+	sql, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+
+	return sql.Close()
+}
@@ -0,0 +1,257 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Key used for acquiring postgres advisory lock, so that when multiple `ette`
+// instances start together, only one of them applies migrations at a time
+const migrationLockKey = 0x65747465
+
+// Migrator - Applies/ reverts versioned schema migrations, while keeping track
+// of applied ones in `schema_migrations` table
+type Migrator struct {
+	db      *gorm.DB
+	dialect string
+}
+
+// MigrationStatus - Whether one known migration has been applied or not
+type MigrationStatus struct {
+	Version     uint64
+	Description string
+	Applied     bool
+	AppliedAt   time.Time
+}
+
+// NewMigrator - Connects to database chosen using configuration,
+// returning migrator to be used for managing schema
+func NewMigrator() *Migrator {
+	_db, dialect := open()
+
+	return &Migrator{db: _db, dialect: dialect}
+}
+
+// lock - Takes migration lock, which is held until enclosing
+// database transaction ends
+//
+// In case of sqlite, database transaction itself serialises writers,
+// so nothing extra is required
+func (m *Migrator) lock(dbWTx *gorm.DB) error {
+	if m.dialect != postgresDialect {
+		return nil
+	}
+
+	return dbWTx.Exec("select pg_advisory_xact_lock(?)", migrationLockKey).Error
+}
+
+// prepare - Creates migration book keeping table, if not present
+func (m *Migrator) prepare() error {
+	return m.db.Transaction(func(dbWTx *gorm.DB) error {
+
+		if err := m.lock(dbWTx); err != nil {
+			return err
+		}
+
+		return dbWTx.Exec(`create table if not exists schema_migrations (
+			version bigint primary key,
+			description varchar(255) not null,
+			appliedat timestamp not null
+		)`).Error
+
+	})
+}
+
+// run - Executes statements of given migration, in given direction, inside
+// already open database transaction, while keeping book keeping table in sync
+func (m *Migrator) run(dbWTx *gorm.DB, migration *Migration, up bool) error {
+	statements := migration.Down[m.dialect]
+	if up {
+		statements = migration.Up[m.dialect]
+	}
+
+	for _, v := range statements {
+		if err := dbWTx.Exec(v).Error; err != nil {
+			return fmt.Errorf("migration %d : %s", migration.Version, err.Error())
+		}
+	}
+
+	if migration.Run != nil {
+		if err := migration.Run(dbWTx, m.dialect, up); err != nil {
+			return fmt.Errorf("migration %d : %s", migration.Version, err.Error())
+		}
+	}
+
+	if !up {
+		return dbWTx.Where("version = ?", migration.Version).Delete(&SchemaMigrations{}).Error
+	}
+
+	return dbWTx.Create(&SchemaMigrations{
+		Version:     migration.Version,
+		Description: migration.Description,
+		AppliedAt:   time.Now().UTC(),
+	}).Error
+}
+
+// apply - Applies given migration inside database transaction, while holding
+// migration lock, returning whether anything was done or not
+//
+// Applied state is checked again after acquiring lock, because some other
+// `ette` instance might have done it while we were waiting
+func (m *Migrator) apply(migration *Migration) (bool, error) {
+	done := false
+
+	err := m.db.Transaction(func(dbWTx *gorm.DB) error {
+
+		if err := m.lock(dbWTx); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := dbWTx.Model(&SchemaMigrations{}).Where("version = ?", migration.Version).Count(&count).Error; err != nil {
+			return err
+		}
+
+		if count != 0 {
+			return nil
+		}
+
+		if err := m.run(dbWTx, migration, true); err != nil {
+			return err
+		}
+
+		done = true
+		return nil
+
+	})
+
+	return done, err
+}
+
+// Up - Applies all pending migrations in order, returning
+// ones which got applied by this invocation
+//
+// Migrations not enabled using configuration are skipped, staying
+// pending, so that they get applied once enabled
+func (m *Migrator) Up() ([]*Migration, error) {
+	if err := m.prepare(); err != nil {
+		return nil, err
+	}
+
+	applied := make([]*Migration, 0)
+
+	for _, v := range migrations {
+		if v.Enabled != nil && !v.Enabled() {
+			continue
+		}
+
+		done, err := m.apply(v)
+		if err != nil {
+			return applied, err
+		}
+
+		if done {
+			applied = append(applied, v)
+		}
+	}
+
+	return applied, nil
+}
+
+// Down - Reverts most recently applied migration, returning
+// that one, if anything was reverted
+//
+// Latest applied version is read only after acquiring migration lock,
+// so that concurrent invocations revert one migration each, instead
+// of both targeting same one
+func (m *Migrator) Down() (*Migration, error) {
+	if err := m.prepare(); err != nil {
+		return nil, err
+	}
+
+	var reverted *Migration
+
+	err := m.db.Transaction(func(dbWTx *gorm.DB) error {
+
+		if err := m.lock(dbWTx); err != nil {
+			return err
+		}
+
+		var latest SchemaMigrations
+
+		result := dbWTx.Model(&SchemaMigrations{}).Order("version desc").Limit(1).Find(&latest)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		for _, v := range migrations {
+			if v.Version != latest.Version {
+				continue
+			}
+
+			if err := m.run(dbWTx, v, false); err != nil {
+				return err
+			}
+
+			reverted = v
+			return nil
+		}
+
+		return errors.New("latest applied migration is unknown to this version of `ette`")
+
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reverted, nil
+}
+
+// Status - Returns state of all known migrations, in order
+func (m *Migrator) Status() ([]*MigrationStatus, error) {
+	if err := m.prepare(); err != nil {
+		return nil, err
+	}
+
+	var applied []*SchemaMigrations
+	if err := m.db.Model(&SchemaMigrations{}).Find(&applied).Error; err != nil {
+		return nil, err
+	}
+
+	appliedAt := make(map[uint64]time.Time)
+	for _, v := range applied {
+		appliedAt[v.Version] = v.AppliedAt
+	}
+
+	status := make([]*MigrationStatus, len(migrations))
+
+	for k, v := range migrations {
+		ts, ok := appliedAt[v.Version]
+
+		status[k] = &MigrationStatus{
+			Version:     v.Version,
+			Description: v.Description,
+			Applied:     ok,
+			AppliedAt:   ts,
+		}
+	}
+
+	return status, nil
+}
+
+// Close - Releases underlying database connection
+func (m *Migrator) Close() error {
+	sql, err := m.db.DB()
+	if err != nil {
+		return err
+	}
+
+	return sql.Close()
+}
@@ -0,0 +1,974 @@
+package db
+
+import "gorm.io/gorm"
+
+// Migration - One versioned, ordered schema change, with statements
+// to apply & revert it, for each supported database engine
+//
+// Changes which can't be expressed as fixed statements are made by `Run`,
+// invoked after statements, if any, while ones depending upon configuration
+// are kept pending, as long as `Enabled` says so
+type Migration struct {
+	Version     uint64
+	Description string
+	Up          map[string][]string
+	Down        map[string][]string
+	Run         func(dbWTx *gorm.DB, dialect string, up bool) error
+	Enabled     func() bool
+}
+
+// Supported database engines, same as values accepted
+// for `Database` in configuration
+const (
+	postgresDialect = "postgres"
+	sqliteDialect   = "sqlite"
+)
+
+// migrations - All schema changes `ette` knows about, in order they need to be applied
+//
+// ⚠️ Never edit already released migration, rather append new one
+var migrations = []*Migration{
+	{
+		Version:     1,
+		Description: "initial schema",
+		Up: map[string][]string{
+			// Names of tables, indices & constraints are kept same as ones
+			// created by gorm's auto migration, which was used earlier, so that
+			// existing deployments can adopt versioned migrations smoothly
+			postgresDialect: {
+				`create table if not exists blocks (
+					hash char(66) primary key,
+					number bigint not null unique,
+					time bigint not null,
+					parenthash char(66) not null,
+					difficulty varchar not null,
+					gasused bigint not null,
+					gaslimit bigint not null,
+					nonce varchar not null,
+					miner char(42) not null,
+					size float(8) not null,
+					stateroothash char(66) not null,
+					unclehash char(66) not null,
+					txroothash char(66) not null,
+					receiptroothash char(66) not null,
+					extradata bytea
+				)`,
+				`create index if not exists idx_blocks_number on blocks (number asc)`,
+				`create index if not exists idx_blocks_time on blocks (time asc)`,
+				`create table if not exists transactions (
+					hash char(66) primary key,
+					"from" char(42) not null,
+					"to" char(42),
+					contract char(42),
+					value varchar,
+					data bytea,
+					gas bigint not null,
+					gasprice varchar not null,
+					cost varchar not null,
+					nonce bigint not null,
+					state smallint not null,
+					blockhash char(66) not null,
+					constraint fk_blocks_transactions foreign key (blockhash) references blocks (hash) on delete cascade
+				)`,
+				`create index if not exists idx_transactions_from on transactions ("from")`,
+				`create index if not exists idx_transactions_to on transactions ("to")`,
+				`create index if not exists idx_transactions_contract on transactions (contract)`,
+				`create index if not exists idx_transactions_nonce on transactions (nonce)`,
+				`create index if not exists idx_transactions_blockhash on transactions (blockhash)`,
+				`create table if not exists events (
+					blockhash char(66) not null,
+					"index" integer not null,
+					origin char(42) not null,
+					topics text[] not null,
+					data bytea,
+					txhash char(66) not null,
+					primary key (blockhash, "index"),
+					constraint fk_blocks_events foreign key (blockhash) references blocks (hash) on delete cascade,
+					constraint fk_transactions_events foreign key (txhash) references transactions (hash) on delete cascade
+				)`,
+				`create index if not exists idx_events_origin on events (origin)`,
+				`create index if not exists idx_events_topics on events using gin (topics)`,
+				`create index if not exists idx_events_txhash on events (txhash)`,
+				`create table if not exists users (
+					address char(42) not null,
+					apikey char(66) primary key,
+					ts timestamp not null,
+					enabled boolean default true
+				)`,
+				`create index if not exists idx_users_address on users (address)`,
+				`create table if not exists delivery_history (
+					id uuid default gen_random_uuid() primary key,
+					client char(42) not null,
+					ts timestamp not null,
+					endpoint varchar(100) not null,
+					datalength bigint not null
+				)`,
+				`create index if not exists idx_delivery_history_client on delivery_history (client)`,
+				`create index if not exists idx_delivery_history_ts on delivery_history (ts asc)`,
+				`create table if not exists subscription_plans (
+					id serial primary key,
+					name varchar(20) not null unique,
+					deliverycount bigint not null unique
+				)`,
+				`create table if not exists subscription_details (
+					address char(42) primary key,
+					subscriptionplan int not null,
+					constraint fk_subscription_plans_subscription_details foreign key (subscriptionplan) references subscription_plans (id)
+				)`,
+				`create index if not exists idx_subscription_details_subscriptionplan on subscription_details (subscriptionplan)`,
+			},
+			// Event topics are stored as postgres array literal i.e. `{a,b}`, which
+			// is how `pq.StringArray` encodes itself
+			sqliteDialect: {
+				`create table if not exists blocks (
+					hash char(66) primary key,
+					number bigint not null unique,
+					time bigint not null,
+					parenthash char(66) not null,
+					difficulty varchar not null,
+					gasused bigint not null,
+					gaslimit bigint not null,
+					nonce varchar not null,
+					miner char(42) not null,
+					size real not null,
+					stateroothash char(66) not null,
+					unclehash char(66) not null,
+					txroothash char(66) not null,
+					receiptroothash char(66) not null,
+					extradata blob
+				)`,
+				`create index if not exists idx_blocks_time on blocks (time)`,
+				`create table if not exists transactions (
+					hash char(66) primary key,
+					"from" char(42) not null,
+					"to" char(42),
+					contract char(42),
+					value varchar,
+					data blob,
+					gas bigint not null,
+					gasprice varchar not null,
+					cost varchar not null,
+					nonce bigint not null,
+					state smallint not null,
+					blockhash char(66) not null references blocks (hash) on delete cascade
+				)`,
+				`create index if not exists idx_transactions_from on transactions ("from")`,
+				`create index if not exists idx_transactions_to on transactions ("to")`,
+				`create index if not exists idx_transactions_contract on transactions (contract)`,
+				`create index if not exists idx_transactions_nonce on transactions (nonce)`,
+				`create index if not exists idx_transactions_blockhash on transactions (blockhash)`,
+				`create table if not exists events (
+					blockhash char(66) not null references blocks (hash) on delete cascade,
+					"index" integer not null,
+					origin char(42) not null,
+					topics text not null,
+					data blob,
+					txhash char(66) not null references transactions (hash) on delete cascade,
+					primary key (blockhash, "index")
+				)`,
+				`create index if not exists idx_events_origin on events (origin)`,
+				`create index if not exists idx_events_txhash on events (txhash)`,
+				`create table if not exists users (
+					address char(42) not null,
+					apikey char(66) primary key,
+					ts timestamp not null,
+					enabled boolean default true
+				)`,
+				`create index if not exists idx_users_address on users (address)`,
+				`create table if not exists delivery_history (
+					id varchar(36) primary key,
+					client char(42) not null,
+					ts timestamp not null,
+					endpoint varchar(100) not null,
+					datalength bigint not null
+				)`,
+				`create index if not exists idx_delivery_history_client on delivery_history (client)`,
+				`create index if not exists idx_delivery_history_ts on delivery_history (ts)`,
+				`create table if not exists subscription_plans (
+					id integer primary key autoincrement,
+					name varchar(20) not null unique,
+					deliverycount bigint not null unique
+				)`,
+				`create table if not exists subscription_details (
+					address char(42) primary key,
+					subscriptionplan int not null references subscription_plans (id)
+				)`,
+				`create index if not exists idx_subscription_details_subscriptionplan on subscription_details (subscriptionplan)`,
+			},
+		},
+		Down: map[string][]string{
+			postgresDialect: {
+				`drop table if exists subscription_details`,
+				`drop table if exists subscription_plans`,
+				`drop table if exists delivery_history`,
+				`drop table if exists users`,
+				`drop table if exists events`,
+				`drop table if exists transactions`,
+				`drop table if exists blocks`,
+			},
+			sqliteDialect: {
+				`drop table if exists subscription_details`,
+				`drop table if exists subscription_plans`,
+				`drop table if exists delivery_history`,
+				`drop table if exists users`,
+				`drop table if exists events`,
+				`drop table if exists transactions`,
+				`drop table if exists blocks`,
+			},
+		},
+	},
+	{
+		Version:     2,
+		Description: "block number on transactions & events",
+		Up: map[string][]string{
+			postgresDialect: {
+				`alter table transactions add column if not exists blocknumber bigint`,
+				`update transactions as t set blocknumber = b.number from blocks as b where t.blockhash = b.hash and t.blocknumber is null`,
+				`alter table transactions alter column blocknumber set not null`,
+				`create index if not exists idx_transactions_blocknumber on transactions (blocknumber)`,
+				`alter table events add column if not exists blocknumber bigint`,
+				`update events as e set blocknumber = b.number from blocks as b where e.blockhash = b.hash and e.blocknumber is null`,
+				`alter table events alter column blocknumber set not null`,
+				`create index if not exists idx_events_blocknumber on events (blocknumber)`,
+				`create index if not exists idx_events_origin_blocknumber on events (origin, blocknumber)`,
+			},
+			sqliteDialect: {
+				`alter table transactions add column blocknumber bigint`,
+				`update transactions set blocknumber = (select number from blocks where blocks.hash = transactions.blockhash)`,
+				`create index if not exists idx_transactions_blocknumber on transactions (blocknumber)`,
+				`alter table events add column blocknumber bigint`,
+				`update events set blocknumber = (select number from blocks where blocks.hash = events.blockhash)`,
+				`create index if not exists idx_events_blocknumber on events (blocknumber)`,
+				`create index if not exists idx_events_origin_blocknumber on events (origin, blocknumber)`,
+			},
+		},
+		// Block number being partition key, it can't be dropped
+		// once tables are range partitioned
+		//
+		// sqlite doesn't support dropping column, so tables are rebuilt
+		// where events are put aside, because dropping transactions table
+		// would otherwise cascade to them
+		Down: map[string][]string{
+			postgresDialect: {
+				`drop index if exists idx_events_origin_blocknumber`,
+				`drop index if exists idx_events_blocknumber`,
+				`alter table events drop column if exists blocknumber`,
+				`drop index if exists idx_transactions_blocknumber`,
+				`alter table transactions drop column if exists blocknumber`,
+			},
+			sqliteDialect: {
+				`create table events_down as select blockhash, "index", origin, topics, data, txhash from events`,
+				`drop table events`,
+				`create table transactions_down (
+					hash char(66) primary key,
+					"from" char(42) not null,
+					"to" char(42),
+					contract char(42),
+					value varchar,
+					data blob,
+					gas bigint not null,
+					gasprice varchar not null,
+					cost varchar not null,
+					nonce bigint not null,
+					state smallint not null,
+					blockhash char(66) not null references blocks (hash) on delete cascade
+				)`,
+				`insert into transactions_down select hash, "from", "to", contract, value, data, gas, gasprice, cost, nonce, state, blockhash from transactions`,
+				`drop table transactions`,
+				`alter table transactions_down rename to transactions`,
+				`create index if not exists idx_transactions_from on transactions ("from")`,
+				`create index if not exists idx_transactions_to on transactions ("to")`,
+				`create index if not exists idx_transactions_contract on transactions (contract)`,
+				`create index if not exists idx_transactions_nonce on transactions (nonce)`,
+				`create index if not exists idx_transactions_blockhash on transactions (blockhash)`,
+				`create table events (
+					blockhash char(66) not null references blocks (hash) on delete cascade,
+					"index" integer not null,
+					origin char(42) not null,
+					topics text not null,
+					data blob,
+					txhash char(66) not null references transactions (hash) on delete cascade,
+					primary key (blockhash, "index")
+				)`,
+				`insert into events select * from events_down`,
+				`drop table events_down`,
+				`create index if not exists idx_events_origin on events (origin)`,
+				`create index if not exists idx_events_txhash on events (txhash)`,
+			},
+		},
+	},
+	{
+		Version:     3,
+		Description: "numeric value, gas price, cost & difficulty",
+		// Restoring from snapshot used to put contract address in
+		// value column, which can't be recovered, so it's set to null
+		Up: map[string][]string{
+			postgresDialect: {
+				`alter table blocks alter column difficulty type numeric(78,0) using difficulty::numeric(78,0)`,
+				`alter table transactions alter column value type numeric(78,0) using case when value ~ '^[0-9]+$' then value::numeric(78,0) end`,
+				`alter table transactions alter column gasprice type numeric(78,0) using gasprice::numeric(78,0)`,
+				`alter table transactions alter column cost type numeric(78,0) using cost::numeric(78,0)`,
+				`create index if not exists idx_transactions_value on transactions (value)`,
+			},
+			// sqlite keeps them as decimal strings, because numeric
+			// affinity would turn large values into lossy floats
+			sqliteDialect: {
+				`update transactions set value = null where value = '' or value glob '*[^0-9]*'`,
+			},
+		},
+		Down: map[string][]string{
+			postgresDialect: {
+				`drop index if exists idx_transactions_value`,
+				`alter table transactions alter column cost type varchar using cost::text`,
+				`alter table transactions alter column gasprice type varchar using gasprice::text`,
+				`alter table transactions alter column value type varchar using value::text`,
+				`alter table blocks alter column difficulty type varchar using difficulty::text`,
+			},
+			sqliteDialect: {},
+		},
+	},
+	{
+		Version:     4,
+		Description: "block time on transactions & events, with composite indexes",
+		// Single column indexes on sender, receiver & origin are
+		// replaced by composite ones, having them as prefix
+		Up: map[string][]string{
+			postgresDialect: {
+				`alter table transactions add column if not exists blocktime bigint`,
+				`update transactions as t set blocktime = b.time from blocks as b where t.blockhash = b.hash and t.blocktime is null`,
+				`alter table transactions alter column blocktime set not null`,
+				`create index if not exists idx_transactions_blocktime on transactions (blocktime)`,
+				`create index if not exists idx_transactions_from_blocknumber on transactions ("from", blocknumber)`,
+				`create index if not exists idx_transactions_from_blocktime on transactions ("from", blocktime)`,
+				`create index if not exists idx_transactions_to_blocknumber on transactions ("to", blocknumber)`,
+				`create index if not exists idx_transactions_to_blocktime on transactions ("to", blocktime)`,
+				`drop index if exists idx_transactions_from`,
+				`drop index if exists idx_transactions_to`,
+				`alter table events add column if not exists blocktime bigint`,
+				`update events as e set blocktime = b.time from blocks as b where e.blockhash = b.hash and e.blocktime is null`,
+				`alter table events alter column blocktime set not null`,
+				`create index if not exists idx_events_blocktime on events (blocktime)`,
+				`create index if not exists idx_events_origin_blocktime on events (origin, blocktime)`,
+				`drop index if exists idx_events_origin`,
+			},
+			sqliteDialect: {
+				`alter table transactions add column blocktime bigint`,
+				`update transactions set blocktime = (select time from blocks where blocks.hash = transactions.blockhash)`,
+				`create index if not exists idx_transactions_blocktime on transactions (blocktime)`,
+				`create index if not exists idx_transactions_from_blocknumber on transactions ("from", blocknumber)`,
+				`create index if not exists idx_transactions_from_blocktime on transactions ("from", blocktime)`,
+				`create index if not exists idx_transactions_to_blocknumber on transactions ("to", blocknumber)`,
+				`create index if not exists idx_transactions_to_blocktime on transactions ("to", blocktime)`,
+				`drop index if exists idx_transactions_from`,
+				`drop index if exists idx_transactions_to`,
+				`alter table events add column blocktime bigint`,
+				`update events set blocktime = (select time from blocks where blocks.hash = events.blockhash)`,
+				`create index if not exists idx_events_blocktime on events (blocktime)`,
+				`create index if not exists idx_events_origin_blocktime on events (origin, blocktime)`,
+				`drop index if exists idx_events_origin`,
+			},
+		},
+		// sqlite doesn't support dropping column, so tables are rebuilt,
+		// same as done while reverting block number
+		Down: map[string][]string{
+			postgresDialect: {
+				`create index if not exists idx_events_origin on events (origin)`,
+				`drop index if exists idx_events_origin_blocktime`,
+				`drop index if exists idx_events_blocktime`,
+				`alter table events drop column if exists blocktime`,
+				`create index if not exists idx_transactions_from on transactions ("from")`,
+				`create index if not exists idx_transactions_to on transactions ("to")`,
+				`drop index if exists idx_transactions_to_blocktime`,
+				`drop index if exists idx_transactions_to_blocknumber`,
+				`drop index if exists idx_transactions_from_blocktime`,
+				`drop index if exists idx_transactions_from_blocknumber`,
+				`drop index if exists idx_transactions_blocktime`,
+				`alter table transactions drop column if exists blocktime`,
+			},
+			sqliteDialect: {
+				`create table events_down as select blockhash, "index", origin, topics, data, txhash, blocknumber from events`,
+				`drop table events`,
+				`create table transactions_down (
+					hash char(66) primary key,
+					"from" char(42) not null,
+					"to" char(42),
+					contract char(42),
+					value varchar,
+					data blob,
+					gas bigint not null,
+					gasprice varchar not null,
+					cost varchar not null,
+					nonce bigint not null,
+					state smallint not null,
+					blockhash char(66) not null references blocks (hash) on delete cascade,
+					blocknumber bigint
+				)`,
+				`insert into transactions_down select hash, "from", "to", contract, value, data, gas, gasprice, cost, nonce, state, blockhash, blocknumber from transactions`,
+				`drop table transactions`,
+				`alter table transactions_down rename to transactions`,
+				`create index if not exists idx_transactions_from on transactions ("from")`,
+				`create index if not exists idx_transactions_to on transactions ("to")`,
+				`create index if not exists idx_transactions_contract on transactions (contract)`,
+				`create index if not exists idx_transactions_nonce on transactions (nonce)`,
+				`create index if not exists idx_transactions_blockhash on transactions (blockhash)`,
+				`create index if not exists idx_transactions_blocknumber on transactions (blocknumber)`,
+				`create table events (
+					blockhash char(66) not null references blocks (hash) on delete cascade,
+					"index" integer not null,
+					origin char(42) not null,
+					topics text not null,
+					data blob,
+					txhash char(66) not null references transactions (hash) on delete cascade,
+					blocknumber bigint,
+					primary key (blockhash, "index")
+				)`,
+				`insert into events select * from events_down`,
+				`drop table events_down`,
+				`create index if not exists idx_events_origin on events (origin)`,
+				`create index if not exists idx_events_txhash on events (txhash)`,
+				`create index if not exists idx_events_blocknumber on events (blocknumber)`,
+				`create index if not exists idx_events_origin_blocknumber on events (origin, blocknumber)`,
+			},
+		},
+	},
+	{
+		Version:     5,
+		Description: "positional event topic columns",
+		// Topics are 32 bytes hex encoded, which is why sqlite, keeping
+		// them as `{"topic0","topic1",...}` text, can be sliced at fixed offsets
+		//
+		// Array index isn't useful anymore, as topics are matched
+		// against positional columns
+		Up: map[string][]string{
+			postgresDialect: {
+				`alter table events add column if not exists topic0 char(66)`,
+				`alter table events add column if not exists topic1 char(66)`,
+				`alter table events add column if not exists topic2 char(66)`,
+				`alter table events add column if not exists topic3 char(66)`,
+				`update events set topic0 = topics[1], topic1 = topics[2], topic2 = topics[3], topic3 = topics[4]`,
+				`create index if not exists idx_events_topic0 on events (topic0)`,
+				`create index if not exists idx_events_origin_topic0 on events (origin, topic0)`,
+				`create index if not exists idx_events_topic1 on events (topic1)`,
+				`create index if not exists idx_events_origin_topic1 on events (origin, topic1)`,
+				`create index if not exists idx_events_topic2 on events (topic2)`,
+				`create index if not exists idx_events_origin_topic2 on events (origin, topic2)`,
+				`create index if not exists idx_events_topic3 on events (topic3)`,
+				`create index if not exists idx_events_origin_topic3 on events (origin, topic3)`,
+				`drop index if exists idx_events_topics`,
+			},
+			sqliteDialect: {
+				`alter table events add column topic0 char(66)`,
+				`alter table events add column topic1 char(66)`,
+				`alter table events add column topic2 char(66)`,
+				`alter table events add column topic3 char(66)`,
+				`update events set
+					topic0 = case when length(topics) > 69 then substr(topics, 3, 66) end,
+					topic1 = case when length(topics) > 138 then substr(topics, 72, 66) end,
+					topic2 = case when length(topics) > 207 then substr(topics, 141, 66) end,
+					topic3 = case when length(topics) > 276 then substr(topics, 210, 66) end`,
+				`create index if not exists idx_events_topic0 on events (topic0)`,
+				`create index if not exists idx_events_origin_topic0 on events (origin, topic0)`,
+				`create index if not exists idx_events_topic1 on events (topic1)`,
+				`create index if not exists idx_events_origin_topic1 on events (origin, topic1)`,
+				`create index if not exists idx_events_topic2 on events (topic2)`,
+				`create index if not exists idx_events_origin_topic2 on events (origin, topic2)`,
+				`create index if not exists idx_events_topic3 on events (topic3)`,
+				`create index if not exists idx_events_origin_topic3 on events (origin, topic3)`,
+			},
+		},
+		// sqlite doesn't support dropping column, so events table is rebuilt
+		Down: map[string][]string{
+			postgresDialect: {
+				`create index if not exists idx_events_topics on events using gin (topics)`,
+				`drop index if exists idx_events_origin_topic3`,
+				`drop index if exists idx_events_topic3`,
+				`drop index if exists idx_events_origin_topic2`,
+				`drop index if exists idx_events_topic2`,
+				`drop index if exists idx_events_origin_topic1`,
+				`drop index if exists idx_events_topic1`,
+				`drop index if exists idx_events_origin_topic0`,
+				`drop index if exists idx_events_topic0`,
+				`alter table events drop column if exists topic3`,
+				`alter table events drop column if exists topic2`,
+				`alter table events drop column if exists topic1`,
+				`alter table events drop column if exists topic0`,
+			},
+			sqliteDialect: {
+				`drop index if exists idx_events_origin_topic3`,
+				`drop index if exists idx_events_topic3`,
+				`drop index if exists idx_events_origin_topic2`,
+				`drop index if exists idx_events_topic2`,
+				`drop index if exists idx_events_origin_topic1`,
+				`drop index if exists idx_events_topic1`,
+				`drop index if exists idx_events_origin_topic0`,
+				`drop index if exists idx_events_topic0`,
+				`create table events_down as select blockhash, "index", origin, topics, data, txhash, blocknumber, blocktime from events`,
+				`drop table events`,
+				`create table events (
+					blockhash char(66) not null references blocks (hash) on delete cascade,
+					"index" integer not null,
+					origin char(42) not null,
+					topics text not null,
+					data blob,
+					txhash char(66) not null references transactions (hash) on delete cascade,
+					blocknumber bigint,
+					blocktime bigint,
+					primary key (blockhash, "index")
+				)`,
+				`insert into events select * from events_down`,
+				`drop table events_down`,
+				`create index if not exists idx_events_txhash on events (txhash)`,
+				`create index if not exists idx_events_blocknumber on events (blocknumber)`,
+				`create index if not exists idx_events_origin_blocknumber on events (origin, blocknumber)`,
+				`create index if not exists idx_events_blocktime on events (blocktime)`,
+				`create index if not exists idx_events_origin_blocktime on events (origin, blocktime)`,
+			},
+		},
+	},
+	{
+		Version:     6,
+		Description: "tx index in block",
+		// Index of tx(s) persisted earlier isn't known, so it's kept 0,
+		// where tx hash breaks ties, while paginating
+		Up: map[string][]string{
+			postgresDialect: {
+				`alter table transactions add column if not exists txindex integer not null default 0`,
+				`create index if not exists idx_transactions_blocknumber_txindex on transactions (blocknumber, txindex)`,
+			},
+			sqliteDialect: {
+				`alter table transactions add column txindex integer not null default 0`,
+				`create index if not exists idx_transactions_blocknumber_txindex on transactions (blocknumber, txindex)`,
+			},
+		},
+		// sqlite doesn't support dropping column, so tables are rebuilt,
+		// same as done while reverting block time
+		Down: map[string][]string{
+			postgresDialect: {
+				`drop index if exists idx_transactions_blocknumber_txindex`,
+				`alter table transactions drop column if exists txindex`,
+			},
+			sqliteDialect: {
+				`drop index if exists idx_transactions_blocknumber_txindex`,
+				`create table events_down as select blockhash, "index", origin, topics, data, txhash, blocknumber, blocktime, topic0, topic1, topic2, topic3 from events`,
+				`drop table events`,
+				`create table transactions_down (
+					hash char(66) primary key,
+					"from" char(42) not null,
+					"to" char(42),
+					contract char(42),
+					value varchar,
+					data blob,
+					gas bigint not null,
+					gasprice varchar not null,
+					cost varchar not null,
+					nonce bigint not null,
+					state smallint not null,
+					blockhash char(66) not null references blocks (hash) on delete cascade,
+					blocknumber bigint,
+					blocktime bigint
+				)`,
+				`insert into transactions_down select hash, "from", "to", contract, value, data, gas, gasprice, cost, nonce, state, blockhash, blocknumber, blocktime from transactions`,
+				`drop table transactions`,
+				`alter table transactions_down rename to transactions`,
+				`create index if not exists idx_transactions_contract on transactions (contract)`,
+				`create index if not exists idx_transactions_nonce on transactions (nonce)`,
+				`create index if not exists idx_transactions_blockhash on transactions (blockhash)`,
+				`create index if not exists idx_transactions_blocknumber on transactions (blocknumber)`,
+				`create index if not exists idx_transactions_blocktime on transactions (blocktime)`,
+				`create index if not exists idx_transactions_from_blocknumber on transactions ("from", blocknumber)`,
+				`create index if not exists idx_transactions_from_blocktime on transactions ("from", blocktime)`,
+				`create index if not exists idx_transactions_to_blocknumber on transactions ("to", blocknumber)`,
+				`create index if not exists idx_transactions_to_blocktime on transactions ("to", blocktime)`,
+				`create table events (
+					blockhash char(66) not null references blocks (hash) on delete cascade,
+					"index" integer not null,
+					origin char(42) not null,
+					topics text not null,
+					data blob,
+					txhash char(66) not null references transactions (hash) on delete cascade,
+					blocknumber bigint,
+					blocktime bigint,
+					topic0 char(66),
+					topic1 char(66),
+					topic2 char(66),
+					topic3 char(66),
+					primary key (blockhash, "index")
+				)`,
+				`insert into events select * from events_down`,
+				`drop table events_down`,
+				`create index if not exists idx_events_txhash on events (txhash)`,
+				`create index if not exists idx_events_blocknumber on events (blocknumber)`,
+				`create index if not exists idx_events_origin_blocknumber on events (origin, blocknumber)`,
+				`create index if not exists idx_events_blocktime on events (blocktime)`,
+				`create index if not exists idx_events_origin_blocktime on events (origin, blocktime)`,
+				`create index if not exists idx_events_topic0 on events (topic0)`,
+				`create index if not exists idx_events_origin_topic0 on events (origin, topic0)`,
+				`create index if not exists idx_events_topic1 on events (topic1)`,
+				`create index if not exists idx_events_origin_topic1 on events (origin, topic1)`,
+				`create index if not exists idx_events_topic2 on events (topic2)`,
+				`create index if not exists idx_events_origin_topic2 on events (origin, topic2)`,
+				`create index if not exists idx_events_topic3 on events (topic3)`,
+				`create index if not exists idx_events_origin_topic3 on events (origin, topic3)`,
+			},
+		},
+	},
+	{
+		Version:     7,
+		Description: "per account aggregates",
+		// Aggregates are backfilled from tx(s) & events already present,
+		// afterwards they're maintained incrementally, as blocks get persisted
+		Up: map[string][]string{
+			postgresDialect: {
+				`create table if not exists accounts (
+					address char(42) primary key,
+					firstseen bigint not null,
+					lastseen bigint not null,
+					sent bigint not null default 0,
+					received bigint not null default 0,
+					deployed bigint not null default 0,
+					emitted bigint not null default 0,
+					nonce bigint,
+					createdat bigint
+				)`,
+				`insert into accounts (address, firstseen, lastseen, sent, received, deployed, emitted, nonce, createdat)
+				select address, min(blocknumber), max(blocknumber), sum(sent), sum(received), sum(deployed), sum(emitted), max(nonce), min(createdat) from (
+					select "from" as address, blocknumber, 1 as sent, 0 as received, case when contract <> '' then 1 else 0 end as deployed, 0 as emitted, nonce, null as createdat from transactions
+					union all
+					select "to", blocknumber, 0, 1, 0, 0, null, null from transactions where "to" <> ''
+					union all
+					select contract, blocknumber, 0, 0, 0, 0, null, blocknumber from transactions where contract <> ''
+					union all
+					select origin, blocknumber, 0, 0, 0, 1, null, null from events
+				) as c where true group by address`,
+			},
+			sqliteDialect: {
+				`create table if not exists accounts (
+					address char(42) primary key,
+					firstseen bigint not null,
+					lastseen bigint not null,
+					sent bigint not null default 0,
+					received bigint not null default 0,
+					deployed bigint not null default 0,
+					emitted bigint not null default 0,
+					nonce bigint,
+					createdat bigint
+				)`,
+				`insert into accounts (address, firstseen, lastseen, sent, received, deployed, emitted, nonce, createdat)
+				select address, min(blocknumber), max(blocknumber), sum(sent), sum(received), sum(deployed), sum(emitted), max(nonce), min(createdat) from (
+					select "from" as address, blocknumber, 1 as sent, 0 as received, case when contract <> '' then 1 else 0 end as deployed, 0 as emitted, nonce, null as createdat from transactions
+					union all
+					select "to", blocknumber, 0, 1, 0, 0, null, null from transactions where "to" <> ''
+					union all
+					select contract, blocknumber, 0, 0, 0, 0, null, blocknumber from transactions where contract <> ''
+					union all
+					select origin, blocknumber, 0, 0, 0, 1, null, null from events
+				) as c where true group by address`,
+			},
+		},
+		Down: map[string][]string{
+			postgresDialect: {
+				`drop table if exists accounts`,
+			},
+			sqliteDialect: {
+				`drop table if exists accounts`,
+			},
+		},
+	},
+	{
+		Version:     8,
+		Description: "hourly & daily chain stats rollups",
+		// Rollups are backfilled from blocks, tx(s) & events already present,
+		// afterwards they're maintained incrementally, as blocks get persisted
+		//
+		// Base fee wasn't stored earlier, so it's not accounted for in backfilled
+		// rollups, while sqlite, keeping numeric values as decimal strings, can only
+		// sum up gas prices approximately, during backfill
+		Up: map[string][]string{
+			postgresDialect: {
+				`alter table blocks add column if not exists basefee numeric(78,0)`,
+				`create table if not exists chain_stats (
+					period varchar(4) not null,
+					start bigint not null,
+					blocks bigint not null default 0,
+					txcount bigint not null default 0,
+					gasused bigint not null default 0,
+					gaspricesum numeric(78,0) not null default 0,
+					basefeesum numeric(78,0) not null default 0,
+					basefeeblocks bigint not null default 0,
+					activesenders bigint not null default 0,
+					contracts bigint not null default 0,
+					events bigint not null default 0,
+					primary key (period, start)
+				)`,
+				`create table if not exists chain_stats_senders (
+					period varchar(4) not null,
+					start bigint not null,
+					address char(42) not null,
+					txcount bigint not null,
+					primary key (period, start, address)
+				)`,
+				`insert into chain_stats_senders (period, start, address, txcount)
+				select p.period, t.blocktime - t.blocktime % p.width, t."from", count(*) from transactions as t cross join (select 'hour' as period, 3600 as width union all select 'day', 86400) as p group by 1, 2, 3`,
+				`insert into chain_stats (period, start, blocks, txcount, gasused, gaspricesum, contracts, activesenders, events)
+				select b.period, b.start, b.blocks, coalesce(t.txcount, 0), b.gasused, coalesce(t.gaspricesum, 0), coalesce(t.contracts, 0), coalesce(s.activesenders, 0), coalesce(e.events, 0) from
+					(select p.period, b.time - b.time % p.width as start, count(*) as blocks, sum(b.gasused) as gasused from blocks as b cross join (select 'hour' as period, 3600 as width union all select 'day', 86400) as p group by 1, 2) as b
+				left join
+					(select p.period, t.blocktime - t.blocktime % p.width as start, count(*) as txcount, sum(t.gasprice) as gaspricesum, sum(case when t.contract <> '' then 1 else 0 end) as contracts from transactions as t cross join (select 'hour' as period, 3600 as width union all select 'day', 86400) as p group by 1, 2) as t
+					on t.period = b.period and t.start = b.start
+				left join
+					(select period, start, count(*) as activesenders from chain_stats_senders group by period, start) as s
+					on s.period = b.period and s.start = b.start
+				left join
+					(select p.period, e.blocktime - e.blocktime % p.width as start, count(*) as events from events as e cross join (select 'hour' as period, 3600 as width union all select 'day', 86400) as p group by 1, 2) as e
+					on e.period = b.period and e.start = b.start`,
+			},
+			sqliteDialect: {
+				`alter table blocks add column basefee varchar`,
+				`create table if not exists chain_stats (
+					period varchar(4) not null,
+					start bigint not null,
+					blocks bigint not null default 0,
+					txcount bigint not null default 0,
+					gasused bigint not null default 0,
+					gaspricesum varchar not null default 0,
+					basefeesum varchar not null default 0,
+					basefeeblocks bigint not null default 0,
+					activesenders bigint not null default 0,
+					contracts bigint not null default 0,
+					events bigint not null default 0,
+					primary key (period, start)
+				)`,
+				`create table if not exists chain_stats_senders (
+					period varchar(4) not null,
+					start bigint not null,
+					address char(42) not null,
+					txcount bigint not null,
+					primary key (period, start, address)
+				)`,
+				`insert into chain_stats_senders (period, start, address, txcount)
+				select p.period, t.blocktime - t.blocktime % p.width, t."from", count(*) from transactions as t cross join (select 'hour' as period, 3600 as width union all select 'day', 86400) as p group by 1, 2, 3`,
+				`insert into chain_stats (period, start, blocks, txcount, gasused, gaspricesum, contracts, activesenders, events)
+				select b.period, b.start, b.blocks, coalesce(t.txcount, 0), b.gasused, coalesce(t.gaspricesum, 0), coalesce(t.contracts, 0), coalesce(s.activesenders, 0), coalesce(e.events, 0) from
+					(select p.period, b.time - b.time % p.width as start, count(*) as blocks, sum(b.gasused) as gasused from blocks as b cross join (select 'hour' as period, 3600 as width union all select 'day', 86400) as p group by 1, 2) as b
+				left join
+					(select p.period, t.blocktime - t.blocktime % p.width as start, count(*) as txcount, printf('%.0f', total(t.gasprice)) as gaspricesum, sum(case when t.contract <> '' then 1 else 0 end) as contracts from transactions as t cross join (select 'hour' as period, 3600 as width union all select 'day', 86400) as p group by 1, 2) as t
+					on t.period = b.period and t.start = b.start
+				left join
+					(select period, start, count(*) as activesenders from chain_stats_senders group by period, start) as s
+					on s.period = b.period and s.start = b.start
+				left join
+					(select p.period, e.blocktime - e.blocktime % p.width as start, count(*) as events from events as e cross join (select 'hour' as period, 3600 as width union all select 'day', 86400) as p group by 1, 2) as e
+					on e.period = b.period and e.start = b.start`,
+			},
+		},
+		// sqlite doesn't support dropping column, so blocks table is rebuilt, where
+		// tx(s) & events are kept aside, otherwise they'd be deleted in cascade
+		Down: map[string][]string{
+			postgresDialect: {
+				`drop table if exists chain_stats_senders`,
+				`drop table if exists chain_stats`,
+				`alter table blocks drop column if exists basefee`,
+			},
+			sqliteDialect: {
+				`drop table if exists chain_stats_senders`,
+				`drop table if exists chain_stats`,
+				`create table events_down as select blockhash, "index", origin, topics, data, txhash, blocknumber, blocktime, topic0, topic1, topic2, topic3 from events`,
+				`create table transactions_down as select hash, "from", "to", contract, value, data, gas, gasprice, cost, nonce, state, blockhash, blocknumber, blocktime, txindex from transactions`,
+				`drop table events`,
+				`drop table transactions`,
+				`create table blocks_down (
+					hash char(66) primary key,
+					number bigint not null unique,
+					time bigint not null,
+					parenthash char(66) not null,
+					difficulty varchar not null,
+					gasused bigint not null,
+					gaslimit bigint not null,
+					nonce varchar not null,
+					miner char(42) not null,
+					size real not null,
+					stateroothash char(66) not null,
+					unclehash char(66) not null,
+					txroothash char(66) not null,
+					receiptroothash char(66) not null,
+					extradata blob
+				)`,
+				`insert into blocks_down select hash, number, time, parenthash, difficulty, gasused, gaslimit, nonce, miner, size, stateroothash, unclehash, txroothash, receiptroothash, extradata from blocks`,
+				`drop table blocks`,
+				`alter table blocks_down rename to blocks`,
+				`create index if not exists idx_blocks_time on blocks (time)`,
+				`create table transactions (
+					hash char(66) primary key,
+					"from" char(42) not null,
+					"to" char(42),
+					contract char(42),
+					value varchar,
+					data blob,
+					gas bigint not null,
+					gasprice varchar not null,
+					cost varchar not null,
+					nonce bigint not null,
+					state smallint not null,
+					blockhash char(66) not null references blocks (hash) on delete cascade,
+					blocknumber bigint,
+					blocktime bigint,
+					txindex integer not null default 0
+				)`,
+				`insert into transactions select * from transactions_down`,
+				`drop table transactions_down`,
+				`create index if not exists idx_transactions_contract on transactions (contract)`,
+				`create index if not exists idx_transactions_nonce on transactions (nonce)`,
+				`create index if not exists idx_transactions_blockhash on transactions (blockhash)`,
+				`create index if not exists idx_transactions_blocknumber on transactions (blocknumber)`,
+				`create index if not exists idx_transactions_blocktime on transactions (blocktime)`,
+				`create index if not exists idx_transactions_from_blocknumber on transactions ("from", blocknumber)`,
+				`create index if not exists idx_transactions_from_blocktime on transactions ("from", blocktime)`,
+				`create index if not exists idx_transactions_to_blocknumber on transactions ("to", blocknumber)`,
+				`create index if not exists idx_transactions_to_blocktime on transactions ("to", blocktime)`,
+				`create index if not exists idx_transactions_blocknumber_txindex on transactions (blocknumber, txindex)`,
+				`create table events (
+					blockhash char(66) not null references blocks (hash) on delete cascade,
+					"index" integer not null,
+					origin char(42) not null,
+					topics text not null,
+					data blob,
+					txhash char(66) not null references transactions (hash) on delete cascade,
+					blocknumber bigint,
+					blocktime bigint,
+					topic0 char(66),
+					topic1 char(66),
+					topic2 char(66),
+					topic3 char(66),
+					primary key (blockhash, "index")
+				)`,
+				`insert into events select * from events_down`,
+				`drop table events_down`,
+				`create index if not exists idx_events_txhash on events (txhash)`,
+				`create index if not exists idx_events_blocknumber on events (blocknumber)`,
+				`create index if not exists idx_events_origin_blocknumber on events (origin, blocknumber)`,
+				`create index if not exists idx_events_blocktime on events (blocktime)`,
+				`create index if not exists idx_events_origin_blocktime on events (origin, blocktime)`,
+				`create index if not exists idx_events_topic0 on events (topic0)`,
+				`create index if not exists idx_events_origin_topic0 on events (origin, topic0)`,
+				`create index if not exists idx_events_topic1 on events (topic1)`,
+				`create index if not exists idx_events_origin_topic1 on events (origin, topic1)`,
+				`create index if not exists idx_events_topic2 on events (topic2)`,
+				`create index if not exists idx_events_origin_topic2 on events (origin, topic2)`,
+				`create index if not exists idx_events_topic3 on events (topic3)`,
+				`create index if not exists idx_events_origin_topic3 on events (origin, topic3)`,
+			},
+		},
+	},
+	{
+		Version:     9,
+		Description: "webhooks & their delivery log",
+		Up: map[string][]string{
+			postgresDialect: {
+				`create table if not exists webhooks (
+					id uuid default gen_random_uuid() primary key,
+					apikey char(66) not null references users (apikey) on delete cascade,
+					name varchar(1000) not null,
+					url varchar(2000) not null,
+					secret char(64) not null,
+					enabled boolean not null default true,
+					ts timestamp not null
+				)`,
+				`create index if not exists idx_webhooks_apikey on webhooks (apikey)`,
+				`create table if not exists webhook_deliveries (
+					id uuid default gen_random_uuid() primary key,
+					webhookid uuid not null references webhooks (id) on delete cascade,
+					topic varchar(20) not null,
+					datalength bigint not null,
+					attempts bigint not null default 0,
+					statuscode integer not null default 0,
+					error varchar(500) not null default '',
+					delivered boolean not null default false,
+					ts timestamp not null,
+					lastattempt timestamp
+				)`,
+				`create index if not exists idx_webhook_deliveries_webhookid_ts on webhook_deliveries (webhookid, ts)`,
+			},
+			sqliteDialect: {
+				`create table if not exists webhooks (
+					id varchar(36) primary key,
+					apikey char(66) not null references users (apikey) on delete cascade,
+					name varchar(1000) not null,
+					url varchar(2000) not null,
+					secret char(64) not null,
+					enabled boolean not null default true,
+					ts timestamp not null
+				)`,
+				`create index if not exists idx_webhooks_apikey on webhooks (apikey)`,
+				`create table if not exists webhook_deliveries (
+					id varchar(36) primary key,
+					webhookid varchar(36) not null references webhooks (id) on delete cascade,
+					topic varchar(20) not null,
+					datalength bigint not null,
+					attempts bigint not null default 0,
+					statuscode integer not null default 0,
+					error varchar(500) not null default '',
+					delivered boolean not null default false,
+					ts timestamp not null,
+					lastattempt timestamp
+				)`,
+				`create index if not exists idx_webhook_deliveries_webhookid_ts on webhook_deliveries (webhookid, ts)`,
+			},
+		},
+		Down: map[string][]string{
+			postgresDialect: {
+				`drop table if exists webhook_deliveries`,
+				`drop table if exists webhooks`,
+			},
+			sqliteDialect: {
+				`drop table if exists webhook_deliveries`,
+				`drop table if exists webhooks`,
+			},
+		},
+	},
+	{
+		Version:     10,
+		Description: "banned addresses",
+		Up: map[string][]string{
+			postgresDialect: {
+				`create table if not exists banned_addresses (
+					address char(42) primary key,
+					reason varchar(200) not null default '',
+					ts timestamp not null
+				)`,
+			},
+			sqliteDialect: {
+				`create table if not exists banned_addresses (
+					address char(42) primary key,
+					reason varchar(200) not null default '',
+					ts timestamp not null
+				)`,
+			},
+		},
+		Down: map[string][]string{
+			postgresDialect: {
+				`drop table if exists banned_addresses`,
+			},
+			sqliteDialect: {
+				`drop table if exists banned_addresses`,
+			},
+		},
+	},
+	{
+		Version:     11,
+		Description: "range partitioned blocks, transactions & events, when `Partitioning` is enabled",
+		// Data is copied into freshly created partitioned tables, which depends upon
+		// existing blocks & `PartitionSize`, so it's done programmatically
+		Run: func(dbWTx *gorm.DB, dialect string, up bool) error {
+			if dialect != postgresDialect {
+				return nil
+			}
+
+			if up {
+				return partitionTables(dbWTx)
+			}
+
+			return unpartitionTables(dbWTx)
+		},
+		Enabled: partitioned,
+	},
+}
@@ -0,0 +1,110 @@
+package db
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lib/pq"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// openTestSqlite - Fresh in-memory sqlite database, dropped once test completes
+func openTestSqlite(t *testing.T) *gorm.DB {
+	_db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %s", err.Error())
+	}
+
+	conn, err := _db.DB()
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %s", err.Error())
+	}
+
+	// Each connection gets its own in-memory database
+	conn.SetMaxOpenConns(1)
+	t.Cleanup(func() { conn.Close() })
+
+	return _db
+}
+
+// migrationStatement - Statement of migration, for given dialect, starting with prefix
+func migrationStatement(t *testing.T, version uint64, dialect string, prefix string) string {
+	for _, m := range migrations {
+		if m.Version != version {
+			continue
+		}
+
+		for _, v := range m.Up[dialect] {
+			if strings.HasPrefix(v, prefix) {
+				return v
+			}
+		}
+	}
+
+	t.Fatalf("no statement of migration %d starting with `%s`", version, prefix)
+	return ""
+}
+
+func TestSqliteTopicOffsets(t *testing.T) {
+	_db := openTestSqlite(t)
+
+	if err := _db.Exec(`create table events (id integer primary key, topics text not null, topic0 char(66), topic1 char(66), topic2 char(66), topic3 char(66))`).Error; err != nil {
+		t.Fatalf("failed to create table: %s", err.Error())
+	}
+
+	topic := func(c string) string {
+		return "0x" + strings.Repeat(c, 64)
+	}
+
+	tests := [][]string{
+		{},
+		{topic("a")},
+		{topic("a"), topic("b")},
+		{topic("a"), topic("b"), topic("c")},
+		{topic("a"), topic("b"), topic("c"), topic("d")},
+	}
+
+	for k, v := range tests {
+		encoded, err := pq.StringArray(v).Value()
+		if err != nil {
+			t.Fatalf("failed to encode topics: %s", err.Error())
+		}
+
+		if err := _db.Exec(`insert into events (id, topics) values (?, ?)`, k, encoded).Error; err != nil {
+			t.Fatalf("failed to insert event: %s", err.Error())
+		}
+	}
+
+	if err := _db.Exec(migrationStatement(t, 5, sqliteDialect, "update events set")).Error; err != nil {
+		t.Fatalf("failed to split topics: %s", err.Error())
+	}
+
+	for k, v := range tests {
+		var row struct {
+			Topic0 *string `gorm:"column:topic0"`
+			Topic1 *string `gorm:"column:topic1"`
+			Topic2 *string `gorm:"column:topic2"`
+			Topic3 *string `gorm:"column:topic3"`
+		}
+
+		if err := _db.Raw(`select topic0, topic1, topic2, topic3 from events where id = ?`, k).Scan(&row).Error; err != nil {
+			t.Fatalf("failed to read event: %s", err.Error())
+		}
+
+		for i, found := range []*string{row.Topic0, row.Topic1, row.Topic2, row.Topic3} {
+			if i >= len(v) {
+				if found != nil {
+					t.Fatalf("event with %d topics: expected no topic%d, found %s", len(v), i, *found)
+				}
+
+				continue
+			}
+
+			if found == nil || *found != v[i] {
+				t.Fatalf("event with %d topics: expected topic%d %s, found %v", len(v), i, v[i], found)
+			}
+		}
+	}
+}
@@ -3,10 +3,11 @@ package db
 import (
 	"bytes"
 	"encoding/json"
-	"log"
 	"time"
 
+	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
 )
 
 // Tabler - ...
@@ -20,7 +21,7 @@ type Blocks struct {
 	Number              uint64       `gorm:"column:number;type:bigint;not null;unique;index:,sort:asc"`
 	Time                uint64       `gorm:"column:time;type:bigint;not null;index:,sort:asc"`
 	ParentHash          string       `gorm:"column:parenthash;type:char(66);not null"`
-	Difficulty          string       `gorm:"column:difficulty;type:varchar;not null"`
+	Difficulty          d.Numeric    `gorm:"column:difficulty;type:numeric(78,0);not null"`
 	GasUsed             uint64       `gorm:"column:gasused;type:bigint;not null"`
 	GasLimit            uint64       `gorm:"column:gaslimit;type:bigint;not null"`
 	Nonce               string       `gorm:"column:nonce;type:varchar;not null"`
@@ -31,6 +32,7 @@ type Blocks struct {
 	TransactionRootHash string       `gorm:"column:txroothash;type:char(66);not null"`
 	ReceiptRootHash     string       `gorm:"column:receiptroothash;type:char(66);not null"`
 	ExtraData           []byte       `gorm:"column:extradata;type:bytea"`
+	BaseFee             d.Numeric    `gorm:"column:basefee;type:numeric(78,0)"`
 	Transactions        Transactions `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
 	Events              Events       `gorm:"foreignKey:blockhash;constraint:OnDelete:CASCADE;"`
 }
@@ -61,19 +63,22 @@ func (b *Blocks) SimilarTo(_b *Blocks) bool {
 
 // Transactions - Blockchain transaction holder table model
 type Transactions struct {
-	Hash      string `gorm:"column:hash;type:char(66);primaryKey"`
-	From      string `gorm:"column:from;type:char(42);not null;index"`
-	To        string `gorm:"column:to;type:char(42);index"`
-	Contract  string `gorm:"column:contract;type:char(42);index"`
-	Value     string `gorm:"column:value;type:varchar"`
-	Data      []byte `gorm:"column:data;type:bytea"`
-	Gas       uint64 `gorm:"column:gas;type:bigint;not null"`
-	GasPrice  string `gorm:"column:gasprice;type:varchar;not null"`
-	Cost      string `gorm:"column:cost;type:varchar;not null"`
-	Nonce     uint64 `gorm:"column:nonce;type:bigint;not null;index"`
-	State     uint64 `gorm:"column:state;type:smallint;not null"`
-	BlockHash string `gorm:"column:blockhash;type:char(66);not null;index"`
-	Events    Events `gorm:"foreignKey:txhash;constraint:OnDelete:CASCADE;"`
+	Hash        string    `gorm:"column:hash;type:char(66);primaryKey"`
+	From        string    `gorm:"column:from;type:char(42);not null;index"`
+	To          string    `gorm:"column:to;type:char(42);index"`
+	Contract    string    `gorm:"column:contract;type:char(42);index"`
+	Value       d.Numeric `gorm:"column:value;type:numeric(78,0)"`
+	Data        []byte    `gorm:"column:data;type:bytea"`
+	Gas         uint64    `gorm:"column:gas;type:bigint;not null"`
+	GasPrice    d.Numeric `gorm:"column:gasprice;type:numeric(78,0);not null"`
+	Cost        d.Numeric `gorm:"column:cost;type:numeric(78,0);not null"`
+	Nonce       uint64    `gorm:"column:nonce;type:bigint;not null;index"`
+	State       uint64    `gorm:"column:state;type:smallint;not null"`
+	BlockHash   string    `gorm:"column:blockhash;type:char(66);not null;index"`
+	BlockNumber uint64    `gorm:"column:blocknumber;type:bigint;not null;index"`
+	BlockTime   uint64    `gorm:"column:blocktime;type:bigint;not null;index"`
+	TxIndex     uint      `gorm:"column:txindex;type:integer;not null;default:0"`
+	Events      Events    `gorm:"foreignKey:txhash;constraint:OnDelete:CASCADE;"`
 }
 
 // TableName - Overriding default table name
@@ -86,9 +91,15 @@ type Events struct {
 	BlockHash       string         `gorm:"column:blockhash;type:char(66);not null;primaryKey"`
 	Index           uint           `gorm:"column:index;type:integer;not null;primaryKey"`
 	Origin          string         `gorm:"column:origin;type:char(42);not null;index"`
-	Topics          pq.StringArray `gorm:"column:topics;type:text[];not null;index:,type:gin"`
+	Topics          pq.StringArray `gorm:"column:topics;type:text[];not null"`
+	Topic0          *string        `gorm:"column:topic0;type:char(66);index"`
+	Topic1          *string        `gorm:"column:topic1;type:char(66);index"`
+	Topic2          *string        `gorm:"column:topic2;type:char(66);index"`
+	Topic3          *string        `gorm:"column:topic3;type:char(66);index"`
 	Data            []byte         `gorm:"column:data;type:bytea"`
 	TransactionHash string         `gorm:"column:txhash;type:char(66);not null;index"`
+	BlockNumber     uint64         `gorm:"column:blocknumber;type:bigint;not null;index"`
+	BlockTime       uint64         `gorm:"column:blocktime;type:bigint;not null;index"`
 }
 
 // TableName - Overriding default table name
@@ -96,6 +107,20 @@ func (Events) TableName() string {
 	return "events"
 }
 
+// SpreadTopics - Copies topics into their positional columns, so that
+// events can be filtered by topic at certain position, in database itself,
+// where absent topics are kept as NULL
+func (e *Events) SpreadTopics() {
+	for k, v := range []**string{&e.Topic0, &e.Topic1, &e.Topic2, &e.Topic3} {
+		*v = nil
+
+		if k < len(e.Topics) {
+			topic := e.Topics[k]
+			*v = &topic
+		}
+	}
+}
+
 // PackedTransaction - All data that is stored in a tx, to be passed from
 // tx data fetcher to whole block data persist handler function
 type PackedTransaction struct {
@@ -111,6 +136,63 @@ type PackedBlock struct {
 	Transactions []*PackedTransaction
 }
 
+// Accounts - Per account aggregates, maintained incrementally as blocks
+// get persisted, so that summary of address can be served without scanning
+// all of its tx(s) & events
+type Accounts struct {
+	Address   string  `gorm:"column:address;type:char(42);primaryKey"`
+	FirstSeen uint64  `gorm:"column:firstseen;type:bigint;not null"`
+	LastSeen  uint64  `gorm:"column:lastseen;type:bigint;not null"`
+	Sent      uint64  `gorm:"column:sent;type:bigint;not null;default:0"`
+	Received  uint64  `gorm:"column:received;type:bigint;not null;default:0"`
+	Deployed  uint64  `gorm:"column:deployed;type:bigint;not null;default:0"`
+	Emitted   uint64  `gorm:"column:emitted;type:bigint;not null;default:0"`
+	Nonce     *uint64 `gorm:"column:nonce;type:bigint"`
+	CreatedAt *uint64 `gorm:"column:createdat;type:bigint"`
+}
+
+// TableName - Overriding default table name
+func (Accounts) TableName() string {
+	return "accounts"
+}
+
+// ChainStats - Rollup of chain activity during one hour/ day, maintained
+// incrementally as blocks get persisted & reorganised, where averages are
+// derived from sums, while being queried
+type ChainStats struct {
+	Period        string    `gorm:"column:period;type:varchar(4);primaryKey"`
+	Start         uint64    `gorm:"column:start;type:bigint;primaryKey"`
+	Blocks        uint64    `gorm:"column:blocks;type:bigint;not null;default:0"`
+	TxCount       uint64    `gorm:"column:txcount;type:bigint;not null;default:0"`
+	GasUsed       uint64    `gorm:"column:gasused;type:bigint;not null;default:0"`
+	GasPriceSum   d.Numeric `gorm:"column:gaspricesum;type:numeric(78,0);not null;default:0"`
+	BaseFeeSum    d.Numeric `gorm:"column:basefeesum;type:numeric(78,0);not null;default:0"`
+	BaseFeeBlocks uint64    `gorm:"column:basefeeblocks;type:bigint;not null;default:0"`
+	ActiveSenders uint64    `gorm:"column:activesenders;type:bigint;not null;default:0"`
+	Contracts     uint64    `gorm:"column:contracts;type:bigint;not null;default:0"`
+	Events        uint64    `gorm:"column:events;type:bigint;not null;default:0"`
+}
+
+// TableName - Overriding default table name
+func (ChainStats) TableName() string {
+	return "chain_stats"
+}
+
+// ChainStatsSenders - Distinct tx senders seen during one hour/ day, along
+// with how many tx(s) they sent, so that active sender count of rollup can be
+// corrected, when blocks get reorganised
+type ChainStatsSenders struct {
+	Period  string `gorm:"column:period;type:varchar(4);primaryKey"`
+	Start   uint64 `gorm:"column:start;type:bigint;primaryKey"`
+	Address string `gorm:"column:address;type:char(42);primaryKey"`
+	TxCount uint64 `gorm:"column:txcount;type:bigint;not null"`
+}
+
+// TableName - Overriding default table name
+func (ChainStatsSenders) TableName() string {
+	return "chain_stats_senders"
+}
+
 // Users - User address & created api key related info, holder table
 type Users struct {
 	Address   string    `gorm:"column:address;type:char(42);not null;index" json:"address"`
@@ -128,7 +210,7 @@ func (Users) TableName() string {
 func (u *Users) ToJSON() []byte {
 	data, err := json.Marshal(u)
 	if err != nil {
-		log.Printf("[!] Failed to encode `ette` apps data to JSON : %s\n", err.Error())
+		log.WithError(err).Error("Failed to encode `ette` apps data to JSON")
 		return nil
 	}
 
@@ -179,3 +261,66 @@ type SubscriptionDetails struct {
 func (SubscriptionDetails) TableName() string {
 	return "subscription_details"
 }
+
+// BannedAddresses - Ethereum addresses banned by admin, API keys of which are
+// no more accepted & who can't log in, until unbanned
+type BannedAddresses struct {
+	Address   string    `gorm:"column:address;type:char(42);primaryKey" json:"address"`
+	Reason    string    `gorm:"column:reason;type:varchar(200);not null;default:''" json:"reason"`
+	TimeStamp time.Time `gorm:"column:ts;type:timestamp;not null" json:"timeStamp"`
+}
+
+// TableName - Overriding default table name
+func (BannedAddresses) TableName() string {
+	return "banned_addresses"
+}
+
+// Webhooks - Endpoints registered against API key, where real-time data, matching
+// subscription `name`, is POST-ed, signed using `secret`
+type Webhooks struct {
+	ID        string    `gorm:"column:id;type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	APIKey    string    `gorm:"column:apikey;type:char(66);not null;index" json:"apiKey"`
+	Name      string    `gorm:"column:name;type:varchar(1000);not null" json:"name"`
+	URL       string    `gorm:"column:url;type:varchar(2000);not null" json:"url"`
+	Secret    string    `gorm:"column:secret;type:char(64);not null" json:"secret"`
+	Enabled   bool      `gorm:"column:enabled;type:boolean;not null;default:true" json:"enabled"`
+	TimeStamp time.Time `gorm:"column:ts;type:timestamp;not null" json:"timeStamp"`
+}
+
+// TableName - Overriding default table name
+func (Webhooks) TableName() string {
+	return "webhooks"
+}
+
+// WebhookDeliveries - Log of payloads being delivered to webhooks, along with
+// outcome of last attempt, where `statuscode` is 0, when no response was received
+type WebhookDeliveries struct {
+	ID          string     `gorm:"column:id;type:uuid;default:gen_random_uuid();primaryKey" json:"id"`
+	WebhookID   string     `gorm:"column:webhookid;type:uuid;not null;index" json:"webhookId"`
+	Topic       string     `gorm:"column:topic;type:varchar(20);not null" json:"topic"`
+	DataLength  uint64     `gorm:"column:datalength;type:bigint;not null" json:"dataLength"`
+	Attempts    uint64     `gorm:"column:attempts;type:bigint;not null;default:0" json:"attempts"`
+	StatusCode  int        `gorm:"column:statuscode;type:integer;not null;default:0" json:"statusCode"`
+	Error       string     `gorm:"column:error;type:varchar(500);not null;default:''" json:"error,omitempty"`
+	Delivered   bool       `gorm:"column:delivered;type:boolean;not null;default:false" json:"delivered"`
+	TimeStamp   time.Time  `gorm:"column:ts;type:timestamp;not null" json:"timeStamp"`
+	LastAttempt *time.Time `gorm:"column:lastattempt;type:timestamp" json:"lastAttempt,omitempty"`
+}
+
+// TableName - Overriding default table name
+func (WebhookDeliveries) TableName() string {
+	return "webhook_deliveries"
+}
+
+// SchemaMigrations - Keeps track of which versioned schema migrations
+// have been applied on this database
+type SchemaMigrations struct {
+	Version     uint64    `gorm:"column:version;primaryKey"`
+	Description string    `gorm:"column:description"`
+	AppliedAt   time.Time `gorm:"column:appliedat"`
+}
+
+// TableName - Overriding default table name
+func (SchemaMigrations) TableName() string {
+	return "schema_migrations"
+}
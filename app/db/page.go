@@ -0,0 +1,92 @@
+package db
+
+import (
+	"github.com/itzmeanjan/ette/app/data"
+	"gorm.io/gorm"
+)
+
+// paginateBlocks - Orders blocks by number & when page is asked for,
+// returns at max `limit` of them, starting right after cursor
+func paginateBlocks(query *gorm.DB, page *data.Page) *gorm.DB {
+	query = query.Order("blocks.number asc")
+
+	if page == nil {
+		return query
+	}
+
+	if page.After != nil {
+		query = query.Where("blocks.number > ?", page.After.BlockNumber)
+	}
+
+	return query.Limit(int(page.Limit))
+}
+
+// paginateTransactions - Orders tx(s) by block number & index in block,
+// with hash breaking ties & when page is asked for, returns at max `limit`
+// of them, starting right after cursor
+func paginateTransactions(query *gorm.DB, page *data.Page) *gorm.DB {
+	query = query.Order("transactions.blocknumber asc, transactions.txindex asc, transactions.hash asc")
+
+	if page == nil {
+		return query
+	}
+
+	if page.After != nil {
+		query = query.Where("(transactions.blocknumber, transactions.txindex, transactions.hash) > (?, ?, ?)", page.After.BlockNumber, page.After.Index, page.After.Hash)
+	}
+
+	return query.Limit(int(page.Limit))
+}
+
+// paginateEvents - Orders events by block number & log index in block
+// & when page is asked for, returns at max `limit` of them, starting
+// right after cursor
+func paginateEvents(query *gorm.DB, page *data.Page) *gorm.DB {
+	query = query.Order("events.blocknumber asc, events.\"index\" asc")
+
+	if page == nil {
+		return query
+	}
+
+	if page.After != nil {
+		query = query.Where("(events.blocknumber, events.\"index\") > (?, ?)", page.After.BlockNumber, page.After.Index)
+	}
+
+	return query.Limit(int(page.Limit))
+}
+
+// blocksPage - Wraps blocks of page, along with cursor to be used for
+// fetching next one, if page got filled up
+func blocksPage(blocks []*data.Block, page *data.Page) *data.Blocks {
+	result := &data.Blocks{Blocks: blocks}
+
+	if page.Full(len(blocks)) {
+		result.Next = blocks[len(blocks)-1].Cursor().String()
+	}
+
+	return result
+}
+
+// transactionsPage - Wraps tx(s) of page, along with cursor to be used for
+// fetching next one, if page got filled up
+func transactionsPage(tx []*data.Transaction, page *data.Page) *data.Transactions {
+	result := &data.Transactions{Transactions: tx}
+
+	if page.Full(len(tx)) {
+		result.Next = tx[len(tx)-1].Cursor().String()
+	}
+
+	return result
+}
+
+// eventsPage - Wraps events of page, along with cursor to be used for
+// fetching next one, if page got filled up
+func eventsPage(events []*data.Event, page *data.Page) *data.Events {
+	result := &data.Events{Events: events}
+
+	if page.Full(len(events)) {
+		result.Next = events[len(events)-1].Cursor().String()
+	}
+
+	return result
+}
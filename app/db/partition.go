@@ -0,0 +1,569 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Key used for acquiring postgres advisory lock, while changing partition layout,
+// so that multiple `ette` instances don't attempt to do it together
+const partitionLockKey = 0x70617274
+
+// How often partition layout is checked against latest block number seen
+const partitionCheckInterval = 15 * time.Second
+
+// partitionedTables - Tables range partitioned by block number, along with
+// their partition key, in order they're to be created, while dropping
+// reverse order is followed
+var partitionedTables = []struct {
+	name string
+	key  string
+}{
+	{name: "blocks", key: "number"},
+	{name: "transactions", key: "blocknumber"},
+	{name: "events", key: "blocknumber"},
+}
+
+// Partition bound, as rendered by postgres i.e. `FOR VALUES FROM ('0') TO ('1000000')`
+var partitionBoundRegex = regexp.MustCompile(`FROM \('?(\d+)'?\) TO \('?(\d+)'?\)`)
+
+// partitioned - Whether blocks, transactions & events tables are range
+// partitioned by block number or not
+func partitioned() bool {
+	return cfg.Current().PartitioningEnabled()
+}
+
+// upsert - Inserts given entry, updating all other columns when one with same
+// keys already exists
+//
+// When tables are range partitioned, partition key i.e. block number is part of
+// primary key, so conflict target needs to be spelled out explicitly
+func upsert(dbWTx *gorm.DB, value interface{}, keys ...string) error {
+	if !partitioned() {
+		return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).Create(value).Error
+	}
+
+	stmt := &gorm.Statement{DB: dbWTx}
+	if err := stmt.Parse(value); err != nil {
+		return err
+	}
+
+	isKey := make(map[string]bool)
+	columns := make([]clause.Column, len(keys))
+
+	for k, v := range keys {
+		isKey[v] = true
+		columns[k] = clause.Column{Name: v}
+	}
+
+	updates := make([]string, 0, len(stmt.Schema.DBNames))
+	for _, v := range stmt.Schema.DBNames {
+		if !isKey[v] {
+			updates = append(updates, v)
+		}
+	}
+
+	return dbWTx.Clauses(clause.OnConflict{Columns: columns, DoUpdates: clause.AssignmentColumns(updates)}).Create(value).Error
+}
+
+// RetainedFrom - Given latest block number, returns lowest block number being retained,
+// when pruning is enabled using `RetainBlocks`, 0 denotes everything is retained
+//
+// Pruning happens in terms of whole partitions, so block syncers must not
+// attempt to fetch blocks below this one
+func RetainedFrom(latest uint64) uint64 {
+	_config := cfg.Current()
+
+	if _config.RetainBlocks == 0 || latest < _config.RetainBlocks {
+		return 0
+	}
+
+	return (latest - _config.RetainBlocks) / _config.PartitionSize * _config.PartitionSize
+}
+
+// partitionRange - One partition of blocks table, holding blocks
+// in [from, to), same range is used for transactions & events
+type partitionRange struct {
+	name string
+	from uint64
+	to   uint64
+}
+
+// suffix - Partitions of all tables, holding same range, are named
+// using same suffix i.e. `blocks_from_0`, `transactions_from_0`
+func (p *partitionRange) suffix() string {
+	return strings.TrimPrefix(p.name, "blocks")
+}
+
+// partitionManager - Keeps managing partitions of range partitioned blocks,
+// transactions & events tables as chain grows, while remembering ranges
+// already covered, so that block being persisted can be checked cheaply
+type partitionManager struct {
+	db      *gorm.DB
+	lock    *sync.RWMutex
+	covered []*partitionRange
+}
+
+// lockPartitions - Takes partition management lock, which is held
+// until enclosing database transaction ends
+func lockPartitions(dbWTx *gorm.DB) error {
+	return dbWTx.Exec("select pg_advisory_xact_lock(?)", partitionLockKey).Error
+}
+
+// isPartitioned - Checks whether blocks table is already range partitioned
+func isPartitioned(_db *gorm.DB) (bool, error) {
+	var count int64
+
+	if err := _db.Raw("select count(*) from pg_partitioned_table where partrelid = to_regclass('blocks')").Scan(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count != 0, nil
+}
+
+// partitions - Returns all partitions of blocks table, sorted by range
+func partitions(_db *gorm.DB) ([]*partitionRange, error) {
+	var rows []struct {
+		Name  string
+		Bound string
+	}
+
+	if err := _db.Raw(`select c.relname as name, pg_get_expr(c.relpartbound, c.oid) as bound
+		from pg_inherits as i join pg_class as c on c.oid = i.inhrelid
+		where i.inhparent = to_regclass('blocks')`).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	ranges := make([]*partitionRange, 0, len(rows))
+
+	for _, v := range rows {
+		matches := partitionBoundRegex.FindStringSubmatch(v.Bound)
+		if matches == nil {
+			return nil, fmt.Errorf("unexpected bound `%s` of partition `%s`", v.Bound, v.Name)
+		}
+
+		from, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		to, err := strconv.ParseUint(matches[2], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		ranges = append(ranges, &partitionRange{name: v.Name, from: from, to: to})
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].from < ranges[j].from
+	})
+
+	return ranges, nil
+}
+
+// createPartitions - Creates partitions of all tables, holding blocks in [from, to)
+func createPartitions(dbWTx *gorm.DB, parentSuffix string, from uint64, to uint64) error {
+	for _, t := range partitionedTables {
+		if err := dbWTx.Exec(fmt.Sprintf("create table if not exists %s_from_%d partition of %s%s for values from (%d) to (%d)",
+			t.name, from, t.name, parentSuffix, from, to)).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// partitionTables - Converts blocks, transactions & events tables to range partitioned
+// ones, if not done yet, by copying existing data into freshly created partitions,
+// to be run as part of versioned schema migration
+//
+// Only non-unique indices are carried over as is, primary keys & foreign keys
+// are recreated with block number included, as postgres requires partition
+// key to be part of them
+//
+// ⚠️ Whole data set is copied inside single database transaction,
+// so it might take long time, when lots of blocks are already present
+func partitionTables(dbWTx *gorm.DB) error {
+	if err := lockPartitions(dbWTx); err != nil {
+		return err
+	}
+
+	// Tables might have been converted before conversion became
+	// versioned migration, which is why it's checked
+	_partitioned, err := isPartitioned(dbWTx)
+	if err != nil {
+		return err
+	}
+
+	if _partitioned {
+		return nil
+	}
+
+	var lowest, highest uint64
+	if err := dbWTx.Raw("select coalesce(min(number), 0), coalesce(max(number), 0) from blocks").Row().Scan(&lowest, &highest); err != nil {
+		return err
+	}
+
+	indices, err := nonConstraintIndices(dbWTx)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range partitionedTables {
+		if err := dbWTx.Exec(fmt.Sprintf("create table %s_partitioned (like %s including defaults including storage) partition by range (%s)", t.name, t.name, t.key)).Error; err != nil {
+			return err
+		}
+	}
+
+	size := cfg.Current().PartitionSize
+
+	for from := lowest / size * size; from <= highest; from += size {
+		if err := createPartitions(dbWTx, "_partitioned", from, from+size); err != nil {
+			return err
+		}
+	}
+
+	statements := []string{
+		`insert into blocks_partitioned select * from blocks`,
+		`insert into transactions_partitioned select * from transactions`,
+		`insert into events_partitioned select * from events`,
+		`drop table events`,
+		`drop table transactions`,
+		`drop table blocks`,
+		`alter table blocks_partitioned rename to blocks`,
+		`alter table transactions_partitioned rename to transactions`,
+		`alter table events_partitioned rename to events`,
+		`alter table blocks add constraint blocks_pkey primary key (hash, number)`,
+		`alter table blocks add constraint blocks_number_key unique (number)`,
+		`alter table transactions add constraint transactions_pkey primary key (hash, blocknumber)`,
+		`alter table transactions add constraint fk_blocks_transactions foreign key (blockhash, blocknumber) references blocks (hash, number) on delete cascade`,
+		`alter table events add constraint events_pkey primary key (blockhash, "index", blocknumber)`,
+		`alter table events add constraint fk_blocks_events foreign key (blockhash, blocknumber) references blocks (hash, number) on delete cascade`,
+		`alter table events add constraint fk_transactions_events foreign key (txhash, blocknumber) references transactions (hash, blocknumber) on delete cascade`,
+	}
+
+	for _, v := range append(statements, indices...) {
+		if err := dbWTx.Exec(v).Error; err != nil {
+			return err
+		}
+	}
+
+	log.Info("Converted blocks, transactions & events tables to range partitioned ones")
+	return nil
+}
+
+// unpartitionTables - Converts range partitioned blocks, transactions & events tables
+// back to plain ones, by copying data out of all partitions, to be run while
+// reverting versioned schema migration
+//
+// Primary keys & foreign keys are recreated without block number, same as
+// they were before tables got partitioned
+func unpartitionTables(dbWTx *gorm.DB) error {
+	if err := lockPartitions(dbWTx); err != nil {
+		return err
+	}
+
+	_partitioned, err := isPartitioned(dbWTx)
+	if err != nil {
+		return err
+	}
+
+	if !_partitioned {
+		return nil
+	}
+
+	indices, err := nonConstraintIndices(dbWTx)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range partitionedTables {
+		if err := dbWTx.Exec(fmt.Sprintf("create table %s_plain (like %s including defaults including storage)", t.name, t.name)).Error; err != nil {
+			return err
+		}
+	}
+
+	statements := []string{
+		`insert into blocks_plain select * from blocks`,
+		`insert into transactions_plain select * from transactions`,
+		`insert into events_plain select * from events`,
+		`drop table events`,
+		`drop table transactions`,
+		`drop table blocks`,
+		`alter table blocks_plain rename to blocks`,
+		`alter table transactions_plain rename to transactions`,
+		`alter table events_plain rename to events`,
+		`alter table blocks add constraint blocks_pkey primary key (hash)`,
+		`alter table blocks add constraint blocks_number_key unique (number)`,
+		`alter table transactions add constraint transactions_pkey primary key (hash)`,
+		`alter table transactions add constraint fk_blocks_transactions foreign key (blockhash) references blocks (hash) on delete cascade`,
+		`alter table events add constraint events_pkey primary key (blockhash, "index")`,
+		`alter table events add constraint fk_blocks_events foreign key (blockhash) references blocks (hash) on delete cascade`,
+		`alter table events add constraint fk_transactions_events foreign key (txhash) references transactions (hash) on delete cascade`,
+	}
+
+	for _, v := range append(statements, indices...) {
+		if err := dbWTx.Exec(v).Error; err != nil {
+			return err
+		}
+	}
+
+	log.Info("Converted blocks, transactions & events tables back to plain ones")
+	return nil
+}
+
+// nonConstraintIndices - Definitions of indices on blocks, transactions & events
+// tables, which don't back any constraint, so that they can be recreated after
+// tables are rebuilt
+//
+// Indices of partitioned table are defined `ON ONLY` it, which is dropped,
+// because they're to be recreated on all partitions or on plain table
+func nonConstraintIndices(dbWTx *gorm.DB) ([]string, error) {
+	indices := make([]string, 0)
+
+	for _, t := range partitionedTables {
+		var _indices []string
+
+		if err := dbWTx.Raw(`select indexdef from pg_indexes
+			where schemaname = current_schema() and tablename = ? and indexname not in
+			(select conname from pg_constraint where conrelid = to_regclass(?))`, t.name, t.name).Scan(&_indices).Error; err != nil {
+			return nil, err
+		}
+
+		for _, v := range _indices {
+			indices = append(indices, strings.Replace(v, " ON ONLY ", " ON ", 1))
+		}
+	}
+
+	return indices, nil
+}
+
+// ensure - Creates partitions for all blocks being retained, upto
+// one partition ahead of latest block, filling up gaps, if any
+func (p *partitionManager) ensure(latest uint64) error {
+	size := cfg.Current().PartitionSize
+
+	return p.db.Transaction(func(dbWTx *gorm.DB) error {
+
+		if err := lockPartitions(dbWTx); err != nil {
+			return err
+		}
+
+		existing, err := partitions(dbWTx)
+		if err != nil {
+			return err
+		}
+
+		cursor := RetainedFrom(latest)
+
+	OUTER:
+		for cursor < latest+size {
+
+			next := (cursor/size + 1) * size
+
+			for _, v := range existing {
+				// Already covered by some partition, which might have
+				// been created with different `PartitionSize`
+				if v.from <= cursor && cursor < v.to {
+					cursor = v.to
+					continue OUTER
+				}
+
+				// Partitions can't overlap
+				if cursor < v.from && v.from < next {
+					next = v.from
+				}
+			}
+
+			if err := createPartitions(dbWTx, "", cursor, next); err != nil {
+				return err
+			}
+
+			log.WithFields(log.Fields{"from": cursor, "to": next}).Info("Created partitions")
+			cursor = next
+
+		}
+
+		return nil
+
+	})
+}
+
+// refresh - Re-reads ranges covered by existing partitions
+func (p *partitionManager) refresh() error {
+	existing, err := partitions(p.db)
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.covered = existing
+	return nil
+}
+
+// isCovered - Checks whether some partition, known to exist, can
+// hold block with given number
+func (p *partitionManager) isCovered(number uint64) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	for _, v := range p.covered {
+		if v.from <= number && number < v.to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ensureFor - Makes sure partition holding block with given number exists,
+// before it's persisted, creating it on demand, otherwise insert would fail,
+// when block is ahead of partitions created by periodic check
+//
+// i.e. first block seen on fresh database
+func (p *partitionManager) ensureFor(number uint64) error {
+	if p.isCovered(number) {
+		return nil
+	}
+
+	if err := p.ensure(number); err != nil {
+		return err
+	}
+
+	return p.refresh()
+}
+
+// prune - Drops partitions of all tables, holding blocks which
+// are not to be retained anymore, returning #-of blocks dropped
+func (p *partitionManager) prune(latest uint64) (uint64, error) {
+	retainedFrom := RetainedFrom(latest)
+	if retainedFrom == 0 {
+		return 0, nil
+	}
+
+	var pruned uint64
+
+	err := p.db.Transaction(func(dbWTx *gorm.DB) error {
+
+		if err := lockPartitions(dbWTx); err != nil {
+			return err
+		}
+
+		existing, err := partitions(dbWTx)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range existing {
+			if v.to > retainedFrom {
+				continue
+			}
+
+			var count uint64
+			if err := dbWTx.Raw(fmt.Sprintf("select count(*) from %s", v.name)).Scan(&count).Error; err != nil {
+				return err
+			}
+
+			// Events first, then transactions & lastly blocks, so
+			// that foreign key constraints are never violated
+			for i := len(partitionedTables) - 1; i >= 0; i-- {
+				name := partitionedTables[i].name
+
+				if err := dbWTx.Exec(fmt.Sprintf("alter table %s detach partition %s%s", name, name, v.suffix())).Error; err != nil {
+					return err
+				}
+
+				if err := dbWTx.Exec(fmt.Sprintf("drop table %s%s", name, v.suffix())).Error; err != nil {
+					return err
+				}
+			}
+
+			log.WithFields(log.Fields{"from": v.from, "to": v.to, "count": count}).Info("Dropped partitions")
+			pruned += count
+		}
+
+		return nil
+
+	})
+
+	return pruned, err
+}
+
+// run - Keeps partition layout in sync with latest block seen, until context
+// gets cancelled, while letting status holder know about pruned blocks
+func (p *partitionManager) run(ctx context.Context, status *d.StatusHolder) {
+	for {
+
+		if latest := status.GetLatestBlockNumber(); latest != 0 {
+
+			if err := p.ensure(latest); err != nil {
+				log.WithError(err).Error("Failed to create partitions")
+			}
+
+			pruned, err := p.prune(latest)
+			if err != nil {
+				log.WithError(err).Error("Failed to drop partitions")
+			}
+
+			status.AddBlocksPruned(pruned)
+
+			if err := p.refresh(); err != nil {
+				log.WithError(err).Error("Failed to read partitions")
+			}
+
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(partitionCheckInterval):
+		}
+
+	}
+}
+
+// setUpPartitioning - Makes sure tables are range partitioned, when enabled, which
+// is done by versioned schema migration, returning manager for their partitions,
+// else makes sure tables are not partitioned already, because then primary keys
+// used while upserting won't match
+func setUpPartitioning(_db *gorm.DB) (*partitionManager, error) {
+	_partitioned, err := isPartitioned(_db)
+	if err != nil {
+		return nil, err
+	}
+
+	if !partitioned() {
+		if _partitioned {
+			return nil, errors.New("tables are range partitioned, `Partitioning` needs to be enabled")
+		}
+
+		return nil, nil
+	}
+
+	if !_partitioned {
+		return nil, errors.New("tables are not range partitioned yet, schema migrations need to be applied")
+	}
+
+	manager := &partitionManager{db: _db, lock: &sync.RWMutex{}}
+	if err := manager.refresh(); err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
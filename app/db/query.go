@@ -1,12 +1,12 @@
 package db
 
 import (
+	"errors"
 	"fmt"
-	"log"
-	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/itzmeanjan/ette/app/data"
+	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
@@ -18,14 +18,14 @@ func GetAllBlockNumbersInRange(db *gorm.DB, from uint64, to uint64) []uint64 {
 	if from < to {
 		if err := db.Model(&Blocks{}).Where("number >= ? and number <= ?", from, to).Order("number asc").Select("number").Find(&blocks).Error; err != nil {
 
-			log.Printf("[!] Failed to fetch block numbers by range : %s\n", err.Error())
+			log.WithError(err).Error("Failed to fetch block numbers by range")
 			return nil
 
 		}
 	} else {
 		if err := db.Model(&Blocks{}).Where("number >= ? and number <= ?", to, from).Order("number asc").Select("number").Find(&blocks).Error; err != nil {
 
-			log.Printf("[!] Failed to fetch block numbers by range : %s\n", err.Error())
+			log.WithError(err).Error("Failed to fetch block numbers by range")
 			return nil
 
 		}
@@ -113,32 +113,69 @@ func GetBlockByNumber(db *gorm.DB, number uint64) *data.Block {
 //
 // If more blocks are requested, simply to be rejected
 // In that case, consider splitting them such that they satisfy criteria
-func GetBlocksByNumberRange(db *gorm.DB, from uint64, to uint64) *data.Blocks {
+func GetBlocksByNumberRange(db *gorm.DB, from uint64, to uint64, page *data.Page) *data.Blocks {
 	var blocks []*data.Block
 
-	if res := db.Model(&Blocks{}).Where("number >= ? and number <= ?", from, to).Order("number asc").Find(&blocks); res.Error != nil {
+	if res := paginateBlocks(db.Model(&Blocks{}).Where("number >= ? and number <= ?", from, to), page).Find(&blocks); res.Error != nil {
 		return nil
 	}
 
-	return &data.Blocks{
-		Blocks: blocks,
-	}
+	return blocksPage(blocks, page)
 }
 
 // GetBlocksByTimeRange - Given time range ( of 60 sec span at max ), returns blocks
 // mined in that time span
 //
 // If asked to find out blocks in time span larger than 60 sec, simply drops query request
-func GetBlocksByTimeRange(db *gorm.DB, from uint64, to uint64) *data.Blocks {
+func GetBlocksByTimeRange(db *gorm.DB, from uint64, to uint64, page *data.Page) *data.Blocks {
 	var blocks []*data.Block
 
-	if res := db.Model(&Blocks{}).Where("time >= ? and time <= ?", from, to).Order("number asc").Find(&blocks); res.Error != nil {
+	if res := paginateBlocks(db.Model(&Blocks{}).Where("time >= ? and time <= ?", from, to), page).Find(&blocks); res.Error != nil {
 		return nil
 	}
 
-	return &data.Blocks{
-		Blocks: blocks,
+	return blocksPage(blocks, page)
+}
+
+// ErrNotYetIndexed - Block being looked up by time might be mined after
+// latest one persisted, so it can't be answered yet
+var ErrNotYetIndexed = errors.New("Not yet indexed")
+
+// GetBlockByTime - Given unix timestamp, finds out first block mined at or
+// after it, when `after` is set, otherwise last block mined at or before it
+//
+// If timestamp is past latest block persisted, `ErrNotYetIndexed` is returned,
+// while nil block denotes nothing was found. Any other error is a failure in
+// talking to database, which is passed to caller as it's
+func GetBlockByTime(db *gorm.DB, at uint64, after bool) (*data.Block, error) {
+	var latest Blocks
+
+	res := db.Model(&Blocks{}).Select("number, time").Order("number desc").Limit(1).Find(&latest)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	if res.RowsAffected == 0 || at > latest.Time {
+		return nil, ErrNotYetIndexed
+	}
+
+	query := db.Model(&Blocks{}).Where("time <= ?", at).Order("time desc, number desc")
+	if after {
+		query = db.Model(&Blocks{}).Where("time >= ?", at).Order("time asc, number asc")
 	}
+
+	var block data.Block
+
+	res = query.Limit(1).Find(&block)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return &block, nil
 }
 
 // GetTransactionCountByBlockHash - Given block hash, finds out how many
@@ -155,18 +192,42 @@ func GetTransactionCountByBlockHash(db *gorm.DB, hash common.Hash) int64 {
 
 }
 
+// GetTransactionIndexes - Given hashes of tx(s) mined in block number range,
+// finds out their position in respective blocks, using one query per chunk
+// of hashes, instead of looking up whole blocks
+func GetTransactionIndexes(db *gorm.DB, from uint64, to uint64, hashes []string) map[string]uint {
+	indexes := make(map[string]uint)
+
+	for start := 0; start < len(hashes); start += 1000 {
+		end := start + 1000
+		if end > len(hashes) {
+			end = len(hashes)
+		}
+
+		var txs []*Transactions
+
+		if err := db.Model(&Transactions{}).Select("hash, txindex").Where("blocknumber >= ? and blocknumber <= ? and hash in ?", from, to, hashes[start:end]).Find(&txs).Error; err != nil {
+			return indexes
+		}
+
+		for _, v := range txs {
+			indexes[v.Hash] = v.TxIndex
+		}
+	}
+
+	return indexes
+}
+
 // GetTransactionsByBlockHash - Given block hash, returns all transactions
 // present in that block
-func GetTransactionsByBlockHash(db *gorm.DB, hash common.Hash) *data.Transactions {
+func GetTransactionsByBlockHash(db *gorm.DB, hash common.Hash, page *data.Page) *data.Transactions {
 	var tx []*data.Transaction
 
-	if res := db.Model(&Transactions{}).Where("blockhash = ?", hash.Hex()).Find(&tx); res.Error != nil {
+	if res := paginateTransactions(db.Model(&Transactions{}).Where("blockhash = ?", hash.Hex()), page).Find(&tx); res.Error != nil {
 		return nil
 	}
 
-	return &data.Transactions{
-		Transactions: tx,
-	}
+	return transactionsPage(tx, page)
 }
 
 // GetTransactionCountByBlockNumber - Given block number, finds out how many
@@ -175,7 +236,7 @@ func GetTransactionCountByBlockNumber(db *gorm.DB, number uint64) int64 {
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Where("blockhash = (?)", db.Model(&Blocks{}).Where("number = ?", number).Select("hash")).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("blocknumber = ?", number).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -185,16 +246,14 @@ func GetTransactionCountByBlockNumber(db *gorm.DB, number uint64) int64 {
 
 // GetTransactionsByBlockNumber - Given block number, returns all transactions
 // present in that block
-func GetTransactionsByBlockNumber(db *gorm.DB, number uint64) *data.Transactions {
+func GetTransactionsByBlockNumber(db *gorm.DB, number uint64, page *data.Page) *data.Transactions {
 	var tx []*data.Transaction
 
-	if res := db.Model(&Transactions{}).Where("blockhash = (?)", db.Model(&Blocks{}).Where("number = ?", number).Select("hash")).Find(&tx); res.Error != nil {
+	if res := paginateTransactions(db.Model(&Transactions{}).Where("blocknumber = ?", number), page).Find(&tx); res.Error != nil {
 		return nil
 	}
 
-	return &data.Transactions{
-		Transactions: tx,
-	}
+	return transactionsPage(tx, page)
 }
 
 // GetTransactionByHash - Given tx hash, extracts out transaction related data
@@ -214,7 +273,7 @@ func GetTransactionCountFromAccountByBlockNumberRange(db *gorm.DB, account commo
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.\"from\" = ? and transactions.blocknumber >= ? and transactions.blocknumber <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -224,16 +283,14 @@ func GetTransactionCountFromAccountByBlockNumberRange(db *gorm.DB, account commo
 
 // GetTransactionsFromAccountByBlockNumberRange - Given account address & block number range, it can find out
 // all transactions which are performed from this account
-func GetTransactionsFromAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsFromAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64, page *data.Page) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := paginateTransactions(db.Model(&Transactions{}).Where("transactions.\"from\" = ? and transactions.blocknumber >= ? and transactions.blocknumber <= ?", account.Hex(), from, to), page).Select("transactions.hash, transactions.\"from\", transactions.\"to\", transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.blocknumber, transactions.txindex").Find(&tx).Error; err != nil {
 		return nil
 	}
 
-	return &data.Transactions{
-		Transactions: tx,
-	}
+	return transactionsPage(tx, page)
 }
 
 // GetTransactionCountFromAccountByBlockTimeRange - Given account address & block mining time stamp range, it can find out
@@ -242,7 +299,7 @@ func GetTransactionCountFromAccountByBlockTimeRange(db *gorm.DB, account common.
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.\"from\" = ? and transactions.blocktime >= ? and transactions.blocktime <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -252,16 +309,14 @@ func GetTransactionCountFromAccountByBlockTimeRange(db *gorm.DB, account common.
 
 // GetTransactionsFromAccountByBlockTimeRange - Given account address & block mining time stamp range, it can find out
 // all tx(s) performed from this account, with in that time span
-func GetTransactionsFromAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsFromAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64, page *data.Page) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := paginateTransactions(db.Model(&Transactions{}).Where("transactions.\"from\" = ? and transactions.blocktime >= ? and transactions.blocktime <= ?", account.Hex(), from, to), page).Select("transactions.hash, transactions.\"from\", transactions.\"to\", transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.blocknumber, transactions.txindex").Find(&tx).Error; err != nil {
 		return nil
 	}
 
-	return &data.Transactions{
-		Transactions: tx,
-	}
+	return transactionsPage(tx, page)
 }
 
 // GetTransactionCountToAccountByBlockNumberRange - Given account address & block number range, returns #-of transactions where
@@ -270,7 +325,7 @@ func GetTransactionCountToAccountByBlockNumberRange(db *gorm.DB, account common.
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.\"to\" = ? and transactions.blocknumber >= ? and transactions.blocknumber <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -280,16 +335,14 @@ func GetTransactionCountToAccountByBlockNumberRange(db *gorm.DB, account common.
 
 // GetTransactionsToAccountByBlockNumberRange - Given account address & block number range, returns transactions where
 // `account` was in `to` field
-func GetTransactionsToAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsToAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64, page *data.Page) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := paginateTransactions(db.Model(&Transactions{}).Where("transactions.\"to\" = ? and transactions.blocknumber >= ? and transactions.blocknumber <= ?", account.Hex(), from, to), page).Select("transactions.hash, transactions.\"from\", transactions.\"to\", transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.blocknumber, transactions.txindex").Find(&tx).Error; err != nil {
 		return nil
 	}
 
-	return &data.Transactions{
-		Transactions: tx,
-	}
+	return transactionsPage(tx, page)
 }
 
 // GetTransactionCountToAccountByBlockTimeRange - Given account address which is present in `to` field of tx(s)
@@ -298,7 +351,7 @@ func GetTransactionCountToAccountByBlockTimeRange(db *gorm.DB, account common.Ad
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.\"to\" = ? and transactions.blocktime >= ? and transactions.blocktime <= ?", account.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -308,16 +361,14 @@ func GetTransactionCountToAccountByBlockTimeRange(db *gorm.DB, account common.Ad
 
 // GetTransactionsToAccountByBlockTimeRange - Given account address which is present in `to` field of tx(s)
 // held in blocks mined with in given time range
-func GetTransactionsToAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsToAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64, page *data.Page) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.to = ? and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := paginateTransactions(db.Model(&Transactions{}).Where("transactions.\"to\" = ? and transactions.blocktime >= ? and transactions.blocktime <= ?", account.Hex(), from, to), page).Select("transactions.hash, transactions.\"from\", transactions.\"to\", transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.blocknumber, transactions.txindex").Find(&tx).Error; err != nil {
 		return nil
 	}
 
-	return &data.Transactions{
-		Transactions: tx,
-	}
+	return transactionsPage(tx, page)
 }
 
 // GetTransactionCountBetweenAccountsByBlockNumberRange - Given from & to account addresses & block number range,
@@ -326,7 +377,7 @@ func GetTransactionCountBetweenAccountsByBlockNumberRange(db *gorm.DB, fromAccou
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.number >= ? and blocks.number <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.\"from\" = ? and transactions.\"to\" = ? and transactions.blocknumber >= ? and transactions.blocknumber <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -336,16 +387,14 @@ func GetTransactionCountBetweenAccountsByBlockNumberRange(db *gorm.DB, fromAccou
 
 // GetTransactionsBetweenAccountsByBlockNumberRange - Given from & to account addresses & block number range,
 // returns transactions where `from` & `to` fields are matching
-func GetTransactionsBetweenAccountsByBlockNumberRange(db *gorm.DB, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsBetweenAccountsByBlockNumberRange(db *gorm.DB, fromAccount common.Address, toAccount common.Address, from uint64, to uint64, page *data.Page) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.number >= ? and blocks.number <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := paginateTransactions(db.Model(&Transactions{}).Where("transactions.\"from\" = ? and transactions.\"to\" = ? and transactions.blocknumber >= ? and transactions.blocknumber <= ?", fromAccount.Hex(), toAccount.Hex(), from, to), page).Select("transactions.hash, transactions.\"from\", transactions.\"to\", transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.blocknumber, transactions.txindex").Find(&tx).Error; err != nil {
 		return nil
 	}
 
-	return &data.Transactions{
-		Transactions: tx,
-	}
+	return transactionsPage(tx, page)
 }
 
 // GetTransactionCountBetweenAccountsByBlockTimeRange - Given from & to account addresses & block mining time range,
@@ -354,7 +403,7 @@ func GetTransactionCountBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount
 
 	var count int64
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.time >= ? and blocks.time <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Count(&count).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.\"from\" = ? and transactions.\"to\" = ? and transactions.blocktime >= ? and transactions.blocktime <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Count(&count).Error; err != nil {
 		return 0
 	}
 
@@ -364,51 +413,45 @@ func GetTransactionCountBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount
 
 // GetTransactionsBetweenAccountsByBlockTimeRange - Given from & to account addresses & block mining time range,
 // returns transactions where `from` & `to` fields are matching
-func GetTransactionsBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount common.Address, toAccount common.Address, from uint64, to uint64) *data.Transactions {
+func GetTransactionsBetweenAccountsByBlockTimeRange(db *gorm.DB, fromAccount common.Address, toAccount common.Address, from uint64, to uint64, page *data.Page) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.to = ? and blocks.time >= ? and blocks.time <= ?", fromAccount.Hex(), toAccount.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := paginateTransactions(db.Model(&Transactions{}).Where("transactions.\"from\" = ? and transactions.\"to\" = ? and transactions.blocktime >= ? and transactions.blocktime <= ?", fromAccount.Hex(), toAccount.Hex(), from, to), page).Select("transactions.hash, transactions.\"from\", transactions.\"to\", transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.blocknumber, transactions.txindex").Find(&tx).Error; err != nil {
 		return nil
 	}
 
-	return &data.Transactions{
-		Transactions: tx,
-	}
+	return transactionsPage(tx, page)
 }
 
 // GetContractCreationTransactionsFromAccountByBlockNumberRange - Fetch all contract creation tx(s) from given account
 // with in specific block number range
-func GetContractCreationTransactionsFromAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetContractCreationTransactionsFromAccountByBlockNumberRange(db *gorm.DB, account common.Address, from uint64, to uint64, page *data.Page) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.contract <> '' and blocks.number >= ? and blocks.number <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := paginateTransactions(db.Model(&Transactions{}).Where("transactions.\"from\" = ? and transactions.contract <> '' and transactions.blocknumber >= ? and transactions.blocknumber <= ?", account.Hex(), from, to), page).Select("transactions.hash, transactions.\"from\", transactions.\"to\", transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.blocknumber, transactions.txindex").Find(&tx).Error; err != nil {
 		return nil
 	}
 
-	return &data.Transactions{
-		Transactions: tx,
-	}
+	return transactionsPage(tx, page)
 }
 
 // GetContractCreationTransactionsFromAccountByBlockTimeRange - Fetch all contract creation tx(s) from given account
 // with in specific block time span range
-func GetContractCreationTransactionsFromAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64) *data.Transactions {
+func GetContractCreationTransactionsFromAccountByBlockTimeRange(db *gorm.DB, account common.Address, from uint64, to uint64, page *data.Page) *data.Transactions {
 	var tx []*data.Transaction
 
-	if err := db.Model(&Transactions{}).Joins("left join blocks on transactions.blockhash = blocks.hash").Where("transactions.from = ? and transactions.contract <> '' and blocks.time >= ? and blocks.time <= ?", account.Hex(), from, to).Select("transactions.hash, transactions.from, transactions.to, transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash").Find(&tx).Error; err != nil {
+	if err := paginateTransactions(db.Model(&Transactions{}).Where("transactions.\"from\" = ? and transactions.contract <> '' and transactions.blocktime >= ? and transactions.blocktime <= ?", account.Hex(), from, to), page).Select("transactions.hash, transactions.\"from\", transactions.\"to\", transactions.contract, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.blocknumber, transactions.txindex").Find(&tx).Error; err != nil {
 		return nil
 	}
 
-	return &data.Transactions{
-		Transactions: tx,
-	}
+	return transactionsPage(tx, page)
 }
 
 // GetTransactionFromAccountWithNonce - Given tx sender address & account nonce, finds out tx, satisfying condition
 func GetTransactionFromAccountWithNonce(db *gorm.DB, account common.Address, nonce uint64) *data.Transaction {
 	var tx data.Transaction
 
-	if err := db.Model(&Transactions{}).Where("transactions.from = ? and transactions.nonce = ?", account.Hex(), nonce).First(&tx).Error; err != nil {
+	if err := db.Model(&Transactions{}).Where("transactions.\"from\" = ? and transactions.nonce = ?", account.Hex(), nonce).First(&tx).Error; err != nil {
 		return nil
 	}
 
@@ -417,127 +460,75 @@ func GetTransactionFromAccountWithNonce(db *gorm.DB, account common.Address, non
 
 // GetEventsFromContractByBlockNumberRange - Given block number range & contract address, extracts out all
 // events emitted by this contract during block span
-func GetEventsFromContractByBlockNumberRange(db *gorm.DB, contract common.Address, from uint64, to uint64) *data.Events {
+func GetEventsFromContractByBlockNumberRange(db *gorm.DB, contract common.Address, from uint64, to uint64, page *data.Page) *data.Events {
 
 	var events []*data.Event
 
-	if err := db.Model(&Events{}).Joins("left join blocks on events.blockhash = blocks.hash").Where("events.origin = ? and blocks.number >= ? and blocks.number <= ?", contract.Hex(), from, to).Select("events.origin, events.index, events.topics, events.data, events.txhash, events.blockhash").Find(&events).Error; err != nil {
+	if err := paginateEvents(db.Model(&Events{}).Where("events.origin = ? and events.blocknumber >= ? and events.blocknumber <= ?", contract.Hex(), from, to), page).Select("events.origin, events.\"index\", events.topics, events.data, events.txhash, events.blockhash, events.blocknumber").Find(&events).Error; err != nil {
 		return nil
 	}
 
-	return &data.Events{
-		Events: events,
-	}
+	return eventsPage(events, page)
 
 }
 
 // GetEventsFromContractByBlockTimeRange - Given block time range & contract address, extracts out all
 // events emitted by this contract during time span
-func GetEventsFromContractByBlockTimeRange(db *gorm.DB, contract common.Address, from uint64, to uint64) *data.Events {
+func GetEventsFromContractByBlockTimeRange(db *gorm.DB, contract common.Address, from uint64, to uint64, page *data.Page) *data.Events {
 
 	var events []*data.Event
 
-	if err := db.Model(&Events{}).Joins("left join blocks on events.blockhash = blocks.hash").Where("events.origin = ? and blocks.time >= ? and blocks.time <= ?", contract.Hex(), from, to).Select("events.origin, events.index, events.topics, events.data, events.txhash, events.blockhash").Find(&events).Error; err != nil {
+	if err := paginateEvents(db.Model(&Events{}).Where("events.origin = ? and events.blocktime >= ? and events.blocktime <= ?", contract.Hex(), from, to), page).Select("events.origin, events.\"index\", events.topics, events.data, events.txhash, events.blockhash, events.blocknumber").Find(&events).Error; err != nil {
 		return nil
 	}
 
-	return &data.Events{
-		Events: events,
-	}
+	return eventsPage(events, page)
 
 }
 
 // GetEventsByBlockHash - Given block hash retrieves all events from all tx present in that block
-func GetEventsByBlockHash(db *gorm.DB, blockHash common.Hash) *data.Events {
+func GetEventsByBlockHash(db *gorm.DB, blockHash common.Hash, page *data.Page) *data.Events {
 	var events []*data.Event
 
-	if err := db.Model(&Events{}).Where("events.blockhash = ?", blockHash.Hex()).Find(&events).Error; err != nil {
+	if err := paginateEvents(db.Model(&Events{}).Where("events.blockhash = ?", blockHash.Hex()), page).Find(&events).Error; err != nil {
 		return nil
 	}
 
-	return &data.Events{
-		Events: events,
-	}
+	return eventsPage(events, page)
 }
 
 // GetEventsByTransactionHash - Given tx hash, returns all events emitted during contract interaction ( i.e. tx execution )
-func GetEventsByTransactionHash(db *gorm.DB, txHash common.Hash) *data.Events {
+func GetEventsByTransactionHash(db *gorm.DB, txHash common.Hash, page *data.Page) *data.Events {
 	var events []*data.Event
 
-	if err := db.Model(&Events{}).Where("events.txhash = ?", txHash.Hex()).Find(&events).Error; err != nil {
+	if err := paginateEvents(db.Model(&Events{}).Where("events.txhash = ?", txHash.Hex()), page).Find(&events).Error; err != nil {
 		return nil
 	}
 
-	return &data.Events{
-		Events: events,
-	}
+	return eventsPage(events, page)
 }
 
-// DoesItMatch - Given one event emitted by some contract & topic
-// signature against which we're attempting to match the event
-// it'll return boolean depending upon whether it's satisfying
-// all conditions or not
-func DoesItMatch(event *data.Event, topics map[uint8]string) bool {
+// filterEventsByTopics - Applies topic signature conditions on events query,
+// where each topic is matched against its positional column
+func filterEventsByTopics(query *gorm.DB, topics map[uint8]string) *gorm.DB {
 
 	for k, v := range topics {
 
-		if !(len(event.Topics) > int(k) && event.Topics[k] == v) {
-			return false
-		}
-
-	}
-
-	return true
-
-}
-
-// ExtractOutOnlyMatchingEvents - Extract out only those events which are having
-// full match with specified event topic signatures
-func ExtractOutOnlyMatchingEvents(events []*data.Event, topics map[uint8]string) *data.Events {
-
-	sink := make([]*data.Event, 0, len(events))
-
-	for _, e := range events {
-
-		if DoesItMatch(e, topics) {
-			sink = append(sink, e)
-		}
+		query = query.Where(fmt.Sprintf("events.topic%d = ?", k), v)
 
 	}
 
-	return &data.Events{
-		Events: sink,
-	}
-
-}
-
-// EventTopicsAsString - Given event topic signatures as map,
-// returns these topics as string, seperated by `,` i.e. comma
-// to be used for forming DB raw query
-func EventTopicsAsString(topics map[uint8]string) string {
-
-	_topics := make([]string, 0, len(topics))
-
-	for _, v := range topics {
-
-		_topics = append(_topics, v)
-
-	}
-
-	return strings.Join(_topics, ", ")
+	return query
 
 }
 
 // GetEventsFromContractWithTopicsByBlockNumberRange - Given block number range, contract address & topics of event log, extracts out all
 // events emitted by this contract during block span with topic signatures matching
-func GetEventsFromContractWithTopicsByBlockNumberRange(db *gorm.DB, contract common.Address, from uint64, to uint64, topics map[uint8]string) *data.Events {
+func GetEventsFromContractWithTopicsByBlockNumberRange(db *gorm.DB, contract common.Address, from uint64, to uint64, topics map[uint8]string, page *data.Page) *data.Events {
 
 	var events []*data.Event
 
-	if err := db.Raw(fmt.Sprintf(
-		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash from events as e "+
-			"left join blocks as b on e.blockhash = b.hash where e.origin = '%s' and b.number >= %d and b.number <= %d and '{%s}' <@ e.topics",
-		contract.Hex(), from, to, EventTopicsAsString(topics))).Scan(&events).Error; err != nil {
+	if err := paginateEvents(filterEventsByTopics(db.Model(&Events{}).Where("events.origin = ? and events.blocknumber >= ? and events.blocknumber <= ?", contract.Hex(), from, to), topics), page).Select("events.origin, events.\"index\", events.topics, events.data, events.txhash, events.blockhash, events.blocknumber").Find(&events).Error; err != nil {
 		return nil
 	}
 
@@ -545,20 +536,17 @@ func GetEventsFromContractWithTopicsByBlockNumberRange(db *gorm.DB, contract com
 		return nil
 	}
 
-	return ExtractOutOnlyMatchingEvents(events, topics)
+	return eventsPage(events, page)
 
 }
 
 // GetEventsFromContractWithTopicsByBlockTimeRange - Given time range, contract address & topics of event log, extracts out all
 // events emitted by this contract during block span with topic signatures matching
-func GetEventsFromContractWithTopicsByBlockTimeRange(db *gorm.DB, contract common.Address, from uint64, to uint64, topics map[uint8]string) *data.Events {
+func GetEventsFromContractWithTopicsByBlockTimeRange(db *gorm.DB, contract common.Address, from uint64, to uint64, topics map[uint8]string, page *data.Page) *data.Events {
 
 	var events []*data.Event
 
-	if err := db.Raw(fmt.Sprintf(
-		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash from events as e "+
-			"left join blocks as b on e.blockhash = b.hash where e.origin = '%s' and b.time >= %d and b.time <= %d and '{%s}' <@ e.topics",
-		contract.Hex(), from, to, EventTopicsAsString(topics))).Scan(&events).Error; err != nil {
+	if err := paginateEvents(filterEventsByTopics(db.Model(&Events{}).Where("events.origin = ? and events.blocktime >= ? and events.blocktime <= ?", contract.Hex(), from, to), topics), page).Select("events.origin, events.\"index\", events.topics, events.data, events.txhash, events.blockhash, events.blocknumber").Find(&events).Error; err != nil {
 		return nil
 	}
 
@@ -566,7 +554,7 @@ func GetEventsFromContractWithTopicsByBlockTimeRange(db *gorm.DB, contract commo
 		return nil
 	}
 
-	return ExtractOutOnlyMatchingEvents(events, topics)
+	return eventsPage(events, page)
 
 }
 
@@ -576,8 +564,8 @@ func GetLastXEventsFromContract(db *gorm.DB, contract common.Address, x int) *da
 	var events []*data.Event
 
 	if err := db.Raw(fmt.Sprintf(
-		"select e.origin, e.index, e.topics, e.data, e.txhash, e.blockhash from events as e "+
-			"left join blocks as b on e.blockhash = b.hash where e.origin = '%s' order by b.number desc limit %d",
+		"select e.origin, e.\"index\", e.topics, e.data, e.txhash, e.blockhash, e.blocknumber from events as e "+
+			"where e.origin = '%s' order by e.blocknumber desc limit %d",
 		contract.Hex(), x)).Scan(&events).Error; err != nil {
 		return nil
 	}
@@ -598,7 +586,7 @@ func GetEventByBlockHashAndLogIndex(db *gorm.DB, hash common.Hash, index uint) *
 
 	var event data.Event
 
-	if err := db.Model(&Events{}).Where("blockhash = ? and index = ?", hash.Hex(), index).First(&event).Error; err != nil {
+	if err := db.Model(&Events{}).Where("blockhash = ? and \"index\" = ?", hash.Hex(), index).First(&event).Error; err != nil {
 		return nil
 	}
 
@@ -610,17 +598,9 @@ func GetEventByBlockHashAndLogIndex(db *gorm.DB, hash common.Hash, index uint) *
 // return respective event log, if any exists
 func GetEventByBlockNumberAndLogIndex(db *gorm.DB, number uint64, index uint) *data.Event {
 
-	block := GetBlockByNumber(db, number)
-	// seems bad block number or may be `ette`
-	// hasn't synced upto this point or missed
-	// this block some how
-	if block == nil {
-		return nil
-	}
-
 	var event data.Event
 
-	if err := db.Model(&Events{}).Where("blockhash = ? and index = ?", block.Hash, index).First(&event).Error; err != nil {
+	if err := db.Model(&Events{}).Where("blocknumber = ? and \"index\" = ?", number, index).First(&event).Error; err != nil {
 		return nil
 	}
 
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGetBlockByTime(t *testing.T) {
+	_db := openTestSqlite(t)
+
+	if err := _db.Migrator().CreateTable(&Blocks{}); err != nil {
+		t.Fatalf("failed to create table: %s", err.Error())
+	}
+
+	if _, err := GetBlockByTime(_db, 100, false); err != ErrNotYetIndexed {
+		t.Fatalf("expected `%v` for empty table, found %v", ErrNotYetIndexed, err)
+	}
+
+	// Blocks 2 & 3 share timestamp
+	for _, v := range []struct {
+		number uint64
+		time   uint64
+	}{{1, 100}, {2, 110}, {3, 110}, {4, 125}} {
+		if err := _db.Create(&Blocks{Hash: fmt.Sprintf("0x%064x", v.number), Number: v.number, Time: v.time, Difficulty: "0"}).Error; err != nil {
+			t.Fatalf("failed to insert block: %s", err.Error())
+		}
+	}
+
+	tests := []struct {
+		name   string
+		at     uint64
+		after  bool
+		number uint64
+		err    error
+	}{
+		{"exact match, before", 100, false, 1, nil},
+		{"exact match, after", 125, true, 4, nil},
+		{"in between, before", 105, false, 1, nil},
+		{"in between, after", 105, true, 2, nil},
+		{"shared time, before", 110, false, 3, nil},
+		{"shared time, after", 110, true, 2, nil},
+		{"before first block", 50, false, 0, nil},
+		{"before first block, after", 50, true, 1, nil},
+		{"past latest block", 126, false, 0, ErrNotYetIndexed},
+		{"past latest block, after", 126, true, 0, ErrNotYetIndexed},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			block, err := GetBlockByTime(_db, v.at, v.after)
+			if err != v.err {
+				t.Fatalf("expected error %v, found %v", v.err, err)
+			}
+
+			if v.number == 0 {
+				if block != nil {
+					t.Fatalf("expected no block, found %d", block.Number)
+				}
+
+				return
+			}
+
+			if block == nil || block.Number != v.number {
+				t.Fatalf("expected block %d, found %+v", v.number, block)
+			}
+		})
+	}
+
+	if err := _db.Exec(`drop table blocks`).Error; err != nil {
+		t.Fatalf("failed to drop table: %s", err.Error())
+	}
+
+	if _, err := GetBlockByTime(_db, 100, false); err == nil || err == ErrNotYetIndexed {
+		t.Fatalf("expected database error, found %v", err)
+	}
+}
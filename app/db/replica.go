@@ -0,0 +1,219 @@
+package db
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	cfg "github.com/itzmeanjan/ette/app/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// How often replicas are asked for latest block they've applied
+const replicaCheckInterval = time.Second
+
+// replica - Read only copy of primary database, along with latest
+// block it was found to have applied, during last check
+type replica struct {
+	host    string
+	db      *gorm.DB
+	lock    sync.RWMutex
+	healthy bool
+	number  uint64
+	time    uint64
+}
+
+// head - Latest block number & time applied by replica, where
+// unreachable replica is never considered to be having any block
+func (r *replica) head() (bool, uint64, uint64) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.healthy, r.number, r.time
+}
+
+// check - Asks replica for latest block it has applied, logging
+// only when its reachability changes
+func (r *replica) check() {
+	var head struct {
+		Number uint64
+		Time   uint64
+	}
+
+	err := r.db.Raw("select coalesce(max(number), 0) as number, coalesce(max(time), 0) as time from blocks").Scan(&head).Error
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if err != nil {
+		if r.healthy {
+			log.WithError(err).WithField("replica", r.host).Warn("Read replica unreachable, falling back to primary")
+		}
+
+		r.healthy = false
+		return
+	}
+
+	if !r.healthy {
+		log.WithField("replica", r.host).Info("Read replica reachable")
+	}
+
+	r.healthy = true
+	r.number = head.Number
+	r.time = head.Time
+}
+
+// replicaSet - Read replicas, picked in round robin manner, among those
+// which have applied blocks being queried, while being refreshed periodically
+//
+// Replicas are considered to be having all blocks up to latest one they've
+// applied, so block written by primary behind its head, i.e. while syncing
+// missing blocks, may not be visible until replica catches up
+type replicaSet struct {
+	primary  *gorm.DB
+	replicas []*replica
+	latest   uint64
+	next     uint32
+	cancel   context.CancelFunc
+}
+
+// connectReplicas - Connects to all read replicas specified in configuration
+// & starts checking how far they've caught up, returns nil if none specified
+func connectReplicas(primary *gorm.DB) *replicaSet {
+	urls := cfg.Current().GetReadReplicas()
+	if len(urls) == 0 {
+		return nil
+	}
+
+	replicas := make([]*replica, 0, len(urls))
+
+	for _, v := range urls {
+		host := v
+		if _url, err := url.Parse(v); err == nil {
+			host = _url.Host
+		}
+
+		_db, err := openPostgresURL(v)
+		if err != nil {
+			log.WithError(err).WithField("replica", host).Fatal("Failed to connect to read replica")
+		}
+
+		replicas = append(replicas, &replica{host: host, db: _db, healthy: true})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	set := &replicaSet{primary: primary, replicas: replicas, cancel: cancel}
+
+	set.check()
+	go set.run(ctx)
+
+	log.WithField("count", len(replicas)).Info("Connected to read replicas")
+
+	return set
+}
+
+// check - Refreshes latest block applied by primary & each replica
+func (r *replicaSet) check() {
+	var latest uint64
+	if err := r.primary.Raw("select coalesce(max(number), 0) from blocks").Scan(&latest).Error; err == nil {
+		atomic.StoreUint64(&r.latest, latest)
+	}
+
+	for _, v := range r.replicas {
+		v.check()
+	}
+}
+
+// run - Keeps checking replicas, until context gets cancelled
+func (r *replicaSet) run(ctx context.Context) {
+	ticker := time.NewTicker(replicaCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			r.check()
+
+		}
+	}
+}
+
+// pick - Picks next replica, in round robin manner, which is reachable &
+// satisfying given criteria, returns nil if none found
+func (r *replicaSet) pick(ok func(number uint64, time uint64) bool) *gorm.DB {
+	if r == nil {
+		return nil
+	}
+
+	start := atomic.AddUint32(&r.next, 1)
+
+	for i := 0; i < len(r.replicas); i++ {
+		_replica := r.replicas[(int(start)+i)%len(r.replicas)]
+
+		if healthy, number, _time := _replica.head(); healthy && ok(number, _time) {
+			return _replica.db
+		}
+	}
+
+	return nil
+}
+
+// forBlock - Replica which has applied block with given number
+func (r *replicaSet) forBlock(number uint64) *gorm.DB {
+	return r.pick(func(_number uint64, _ uint64) bool {
+		return _number >= number
+	})
+}
+
+// forTime - Replica which has applied block mined at given time
+func (r *replicaSet) forTime(time uint64) *gorm.DB {
+	return r.pick(func(_ uint64, _time uint64) bool {
+		return _time >= time
+	})
+}
+
+// forLatest - Replica which has caught up with primary
+func (r *replicaSet) forLatest() *gorm.DB {
+	if r == nil {
+		return nil
+	}
+
+	return r.forBlock(atomic.LoadUint64(&r.latest))
+}
+
+// forAny - Any reachable replica, to be used for lookups by hash,
+// where primary is asked when nothing is found in replica
+func (r *replicaSet) forAny() *gorm.DB {
+	return r.pick(func(_ uint64, _ uint64) bool {
+		return true
+	})
+}
+
+// close - Stops checking replicas & releases their connections
+func (r *replicaSet) close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.cancel()
+
+	for _, v := range r.replicas {
+		sql, err := v.db.DB()
+		if err != nil {
+			return err
+		}
+
+		if err := sql.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
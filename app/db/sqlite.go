@@ -0,0 +1,43 @@
+package db
+
+import (
+	"fmt"
+
+	cfg "github.com/itzmeanjan/ette/app/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// sqliteStore - Store backed by embedded sqlite database, meant to be
+// used during development & testing, so that no postgresql instance is required
+type sqliteStore struct {
+	gormStore
+}
+
+// Opening embedded sqlite database file
+func openSQLite() *gorm.DB {
+	// Foreign keys need to be enabled explicitly for cascaded deletion to work,
+	// while busy timeout along with immediate transaction locking lets writers,
+	// possibly from other processes, wait instead of failing immediately
+	_db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?_foreign_keys=on&_busy_timeout=5000&_journal_mode=WAL&_txlock=immediate", cfg.Current().SQLitePath)),
+		&gorm.Config{
+			Logger:                 logger.Default.LogMode(logger.Silent),
+			SkipDefaultTransaction: true,
+		})
+	if err != nil {
+		log.WithError(err).Fatal("Failed to open sqlite db")
+	}
+
+	sql, err := _db.DB()
+	if err != nil {
+		log.WithError(err).Fatal("Failed to get underlying sqlite connection")
+	}
+
+	// sqlite allows only one writer at a time, so serialising
+	// all access through single connection
+	sql.SetMaxOpenConns(1)
+
+	return _db
+}
@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	d "github.com/itzmeanjan/ette/app/data"
+	q "github.com/itzmeanjan/ette/app/queue"
+)
+
+// Store - Repository interface, every part of `ette` talks to backing data store
+// using this, so that backing database engine can be chosen using configuration
+// i.e. `Database` = postgres/ sqlite
+//
+// Queries returning list of blocks/ tx(s)/ events return all matching ones,
+// in chain order, when page isn't given, otherwise only those in page
+type Store interface {
+	// Ingestion & block book keeping
+	StoreBlock(block *PackedBlock, status *d.StatusHolder, queue *q.BlockProcessorQueue) error
+	GetBlock(number uint64) *Blocks
+	GetAllBlockNumbersInRange(from uint64, to uint64) []uint64
+	GetCurrentOldestBlockNumber() uint64
+	GetCurrentBlockNumber() uint64
+	GetBlockCount() uint64
+
+	// Blocks
+	GetBlockByHash(hash common.Hash) *d.Block
+	GetBlockByNumber(number uint64) *d.Block
+	GetBlocksByNumberRange(from uint64, to uint64, page *d.Page) *d.Blocks
+	GetBlocksByTimeRange(from uint64, to uint64, page *d.Page) *d.Blocks
+	GetBlockByTime(at uint64, after bool) (*d.Block, error)
+
+	// Transactions
+	GetTransactionCountByBlockHash(hash common.Hash) int64
+	GetTransactionsByBlockHash(hash common.Hash, page *d.Page) *d.Transactions
+	GetTransactionCountByBlockNumber(number uint64) int64
+	GetTransactionsByBlockNumber(number uint64, page *d.Page) *d.Transactions
+	GetTransactionByHash(hash common.Hash) *d.Transaction
+	GetTransactionCountFromAccountByBlockNumberRange(account common.Address, from uint64, to uint64) int64
+	GetTransactionsFromAccountByBlockNumberRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions
+	GetTransactionCountFromAccountByBlockTimeRange(account common.Address, from uint64, to uint64) int64
+	GetTransactionsFromAccountByBlockTimeRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions
+	GetTransactionCountToAccountByBlockNumberRange(account common.Address, from uint64, to uint64) int64
+	GetTransactionsToAccountByBlockNumberRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions
+	GetTransactionCountToAccountByBlockTimeRange(account common.Address, from uint64, to uint64) int64
+	GetTransactionsToAccountByBlockTimeRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions
+	GetTransactionCountBetweenAccountsByBlockNumberRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64) int64
+	GetTransactionsBetweenAccountsByBlockNumberRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64, page *d.Page) *d.Transactions
+	GetTransactionCountBetweenAccountsByBlockTimeRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64) int64
+	GetTransactionsBetweenAccountsByBlockTimeRange(fromAccount common.Address, toAccount common.Address, from uint64, to uint64, page *d.Page) *d.Transactions
+	GetContractCreationTransactionsFromAccountByBlockNumberRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions
+	GetContractCreationTransactionsFromAccountByBlockTimeRange(account common.Address, from uint64, to uint64, page *d.Page) *d.Transactions
+	GetTransactionFromAccountWithNonce(account common.Address, nonce uint64) *d.Transaction
+	GetTransactionIndexes(from uint64, to uint64, hashes []string) map[string]uint
+
+	// Value based filtering & aggregation of transactions
+	GetTransactionsByBlockNumberRangeWithFilter(filter *d.TransactionFilter, from uint64, to uint64, page *d.Page) *d.Transactions
+	GetTransactionsByBlockTimeRangeWithFilter(filter *d.TransactionFilter, from uint64, to uint64, page *d.Page) *d.Transactions
+	GetTransactionAggregateByBlockNumberRange(filter *d.TransactionFilter, from uint64, to uint64) *d.TransactionAggregate
+	GetTransactionAggregateByBlockTimeRange(filter *d.TransactionFilter, from uint64, to uint64) *d.TransactionAggregate
+
+	// Events
+	GetEventsFromContractByBlockNumberRange(contract common.Address, from uint64, to uint64, page *d.Page) *d.Events
+	GetEventsFromContractByBlockTimeRange(contract common.Address, from uint64, to uint64, page *d.Page) *d.Events
+	GetEventsByBlockHash(blockHash common.Hash, page *d.Page) *d.Events
+	GetEventsByTransactionHash(txHash common.Hash, page *d.Page) *d.Events
+	GetEventsFromContractWithTopicsByBlockNumberRange(contract common.Address, from uint64, to uint64, topics map[uint8]string, page *d.Page) *d.Events
+	GetEventsFromContractWithTopicsByBlockTimeRange(contract common.Address, from uint64, to uint64, topics map[uint8]string, page *d.Page) *d.Events
+	GetEventsByBlockNumberRangeWithFilter(filter *d.EventFilter, from uint64, to uint64, page *d.Page) *d.Events
+	GetEventsByBlockTimeRangeWithFilter(filter *d.EventFilter, from uint64, to uint64, page *d.Page) *d.Events
+	GetLastXEventsFromContract(contract common.Address, x int) *d.Events
+	GetEventByBlockHashAndLogIndex(hash common.Hash, index uint) *d.Event
+	GetEventByBlockNumberAndLogIndex(number uint64, index uint) *d.Event
+
+	// Streaming of bulk query results, row by row as they're read from database
+	// cursor, in chain order, until `fn` fails
+	//
+	// `fn` must not query database, because cursor keeps connection busy, while
+	// sqlite has only one
+	StreamBlocksByNumberRange(from uint64, to uint64, fn func(*d.Block) error) error
+	StreamBlocksByTimeRange(from uint64, to uint64, fn func(*d.Block) error) error
+	StreamTransactionsByBlockNumberRangeWithFilter(filter *d.TransactionFilter, from uint64, to uint64, fn func(*d.Transaction) error) error
+	StreamTransactionsByBlockTimeRangeWithFilter(filter *d.TransactionFilter, from uint64, to uint64, fn func(*d.Transaction) error) error
+	StreamEventsByBlockNumberRangeWithFilter(filter *d.EventFilter, from uint64, to uint64, fn func(*d.Event) error) error
+	StreamEventsByBlockTimeRangeWithFilter(filter *d.EventFilter, from uint64, to uint64, fn func(*d.Event) error) error
+
+	// Accounts
+	GetAccount(address common.Address) *d.Account
+
+	// Hourly/ daily rollups of chain activity
+	GetChainStats(interval string, from uint64, to uint64) *d.ChainStats
+
+	// Users, API keys & rate limiting
+	GetAppsByUserAddress(address common.Address) []*Users
+	RegisterNewApp(address common.Address) bool
+	ToggleAPIKeyState(apiKey string) bool
+	GetUserFromAPIKey(apiKey string) *Users
+	ValidateAPIKey(apiKey string) bool
+	IsUnderRateLimit(userAddress string) bool
+	GetRemainingDeliveries(userAddress string) uint64
+	DropOldDeliveryHistories()
+	PutDataDeliveryInfo(client string, endPoint string, dataLength uint64)
+	PutDataDeliveryInfos(client string, endPoint string, dataLengths []uint64)
+
+	// Webhooks & their delivery log
+	RegisterWebhook(apiKey string, name string, url string) *Webhooks
+	GetWebhooksByUserAddress(address common.Address) []*Webhooks
+	ToggleWebhookState(address common.Address, id string) bool
+	DeleteWebhook(address common.Address, id string) bool
+	GetEnabledWebhooks() []*Webhooks
+	PutWebhookDelivery(delivery *WebhookDeliveries) bool
+	UpdateWebhookDelivery(delivery *WebhookDeliveries) bool
+	GetWebhookDeliveries(address common.Address, id string, limit int) []*WebhookDeliveries
+	DropOldWebhookDeliveries(before time.Time)
+
+	// Subscription plans
+	PersistAllSubscriptionPlans(file string)
+	ReloadSubscriptionPlans(plans []*Plan) ([]string, error)
+	GetAllSubscriptionPlans() []*SubscriptionPlans
+	CheckSubscriptionPlanByAddress(address common.Address) *SubscriptionDetails
+	CheckSubscriptionPlanDetailsByAddress(address common.Address) *SubscriptionPlans
+	GetAllowedDeliveryCountByAddress(address common.Address) uint64
+	IsValidSubscriptionPlan(id uint32) bool
+	GetDefaultSubscriptionPlanID() uint32
+	AddSubscriptionPlanForAddress(address common.Address, planID uint32) bool
+
+	// Administration of users, API keys, plans & bans
+	GetAllUsers() []*UserDetails
+	GetUserDetails(address common.Address) *UserDetails
+	SetAPIKeyState(apiKey string, enabled bool) bool
+	SetSubscriptionPlanForAddress(address common.Address, planID uint32) bool
+	GetUsageByAddress(address common.Address) *d.Usage
+	BanAddress(address common.Address, reason string) bool
+	UnbanAddress(address common.Address) bool
+	IsBanned(address common.Address) bool
+	GetBannedAddresses() []*BannedAddresses
+
+	// Partitioning & pruning, until context gets cancelled
+	ManagePartitions(ctx context.Context, status *d.StatusHolder)
+
+	// Pruning rollup bookkeeping, not required anymore, until context gets cancelled
+	ManageChainStats(ctx context.Context, status *d.StatusHolder)
+
+	// Query result cache statistics, nil when caching is disabled
+	CacheStats() *d.CacheStats
+
+	// Ping - Checks whether primary database is reachable
+	Ping(ctx context.Context) error
+
+	// Close - Releases underlying database connection
+	Close() error
+}
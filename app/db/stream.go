@@ -0,0 +1,88 @@
+package db
+
+import (
+	"github.com/itzmeanjan/ette/app/data"
+	"gorm.io/gorm"
+)
+
+// streamRows - Runs query & hands over each row to `fn`, as soon as it's read
+// from database cursor, instead of holding whole result set in memory
+//
+// `next` is expected to return fresh variable, each row to be scanned into
+func streamRows(db *gorm.DB, query *gorm.DB, next func() interface{}, fn func(interface{}) error) error {
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		row := next()
+
+		if err := db.ScanRows(rows, row); err != nil {
+			return err
+		}
+
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// streamBlocks - Streams blocks matched by query, in order of block number
+func streamBlocks(db *gorm.DB, query *gorm.DB, fn func(*data.Block) error) error {
+	return streamRows(db, paginateBlocks(query, nil),
+		func() interface{} { return &data.Block{} },
+		func(row interface{}) error { return fn(row.(*data.Block)) })
+}
+
+// streamTransactions - Streams tx(s) matched by query, in chain order
+func streamTransactions(db *gorm.DB, query *gorm.DB, fn func(*data.Transaction) error) error {
+	return streamRows(db, paginateTransactions(query, nil).Select("transactions.hash, transactions.\"from\", transactions.\"to\", transactions.contract, transactions.value, transactions.data, transactions.gas, transactions.gasprice, transactions.cost, transactions.nonce, transactions.state, transactions.blockhash, transactions.blocknumber, transactions.txindex"),
+		func() interface{} { return &data.Transaction{} },
+		func(row interface{}) error { return fn(row.(*data.Transaction)) })
+}
+
+// streamEvents - Streams events matched by query, in chain order
+func streamEvents(db *gorm.DB, query *gorm.DB, fn func(*data.Event) error) error {
+	return streamRows(db, paginateEvents(query, nil).Select("events.origin, events.\"index\", events.topics, events.data, events.txhash, events.blockhash, events.blocknumber"),
+		func() interface{} { return &data.Event{} },
+		func(row interface{}) error { return fn(row.(*data.Event)) })
+}
+
+// StreamBlocksByNumberRange - Given block number range, streams all blocks in it
+func StreamBlocksByNumberRange(db *gorm.DB, from uint64, to uint64, fn func(*data.Block) error) error {
+	return streamBlocks(db, db.Model(&Blocks{}).Where("blocks.number >= ? and blocks.number <= ?", from, to), fn)
+}
+
+// StreamBlocksByTimeRange - Given block time range, streams all blocks mined in it
+func StreamBlocksByTimeRange(db *gorm.DB, from uint64, to uint64, fn func(*data.Block) error) error {
+	return streamBlocks(db, db.Model(&Blocks{}).Where("blocks.time >= ? and blocks.time <= ?", from, to), fn)
+}
+
+// StreamTransactionsByBlockNumberRangeWithFilter - Given block number range & filter,
+// streams all tx(s) satisfying filter, in that block range
+func StreamTransactionsByBlockNumberRangeWithFilter(db *gorm.DB, filter *data.TransactionFilter, from uint64, to uint64, fn func(*data.Transaction) error) error {
+	return streamTransactions(db, filterTransactions(db, db.Model(&Transactions{}).Where("transactions.blocknumber >= ? and transactions.blocknumber <= ?", from, to), filter), fn)
+}
+
+// StreamTransactionsByBlockTimeRangeWithFilter - Given block time range & filter,
+// streams all tx(s) satisfying filter, in that time span
+func StreamTransactionsByBlockTimeRangeWithFilter(db *gorm.DB, filter *data.TransactionFilter, from uint64, to uint64, fn func(*data.Transaction) error) error {
+	return streamTransactions(db, filterTransactions(db, db.Model(&Transactions{}).Where("transactions.blocktime >= ? and transactions.blocktime <= ?", from, to), filter), fn)
+}
+
+// StreamEventsByBlockNumberRangeWithFilter - Given block number range & filter,
+// streams all events satisfying filter, emitted in that block range
+func StreamEventsByBlockNumberRangeWithFilter(db *gorm.DB, filter *data.EventFilter, from uint64, to uint64, fn func(*data.Event) error) error {
+	return streamEvents(db, filterEvents(db.Model(&Events{}).Where("events.blocknumber >= ? and events.blocknumber <= ?", from, to), filter), fn)
+}
+
+// StreamEventsByBlockTimeRangeWithFilter - Given block time range & filter,
+// streams all events satisfying filter, emitted in that time span
+func StreamEventsByBlockTimeRangeWithFilter(db *gorm.DB, filter *data.EventFilter, from uint64, to uint64, fn func(*data.Event) error) error {
+	return streamEvents(db, filterEvents(db.Model(&Events{}).Where("events.blocktime >= ? and events.blocktime <= ?", from, to), filter), fn)
+}
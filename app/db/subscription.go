@@ -2,10 +2,11 @@ package db
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
-	"log"
 
 	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
@@ -26,7 +27,7 @@ func DeliveryCountByPlanName(_db *gorm.DB, planName string) (uint64, error) {
 func UpdateSubscriptionPlan(_db *gorm.DB, name string, deliveryCount uint64) {
 
 	if err := _db.Model(&SubscriptionPlans{}).Where("name = ?", name).Update("deliverycount", deliveryCount).Error; err != nil {
-		log.Printf("[!] Failed to update subscription plan : %s\n", err.Error())
+		log.WithError(err).WithField("plan", name).Error("Failed to update subscription plan")
 	}
 
 }
@@ -38,7 +39,7 @@ func CreateSubscriptionPlan(_db *gorm.DB, name string, deliveryCount uint64) {
 		Name:          name,
 		DeliveryCount: deliveryCount,
 	}).Error; err != nil {
-		log.Printf("[!] Failed to persist subscription plan : %s\n", err.Error())
+		log.WithError(err).WithField("plan", name).Error("Failed to persist subscription plan")
 	}
 
 }
@@ -73,19 +74,19 @@ func AddNewSubscriptionPlan(_db *gorm.DB, name string, deliveryCount uint64) {
 
 }
 
-// PersistAllSubscriptionPlans - Given path to user created subscription plan
-// holder ``.plans.json` file, it'll read that content into memory & then parse JSON
-// content of its, which will be persisted into database, into `subscription_plans` table
-func PersistAllSubscriptionPlans(_db *gorm.DB, file string) {
+// Plan - Subscription plan, as it's present in `.plans.json` file
+type Plan struct {
+	Name          string `json:"name"`
+	DeliveryCount uint64 `json:"deliveryCount"`
+}
+
+// ReadSubscriptionPlans - Reads & parses subscription plans from
+// user created `.plans.json` file
+func ReadSubscriptionPlans(file string) ([]*Plan, error) {
 
 	data, err := ioutil.ReadFile(file)
 	if err != nil {
-		log.Fatalf("[!] Failed to read content from subscription plan file : %s\n", err.Error())
-	}
-
-	type Plan struct {
-		Name          string `json:"name"`
-		DeliveryCount uint64 `json:"deliveryCount"`
+		return nil, err
 	}
 
 	type Plans struct {
@@ -95,14 +96,87 @@ func PersistAllSubscriptionPlans(_db *gorm.DB, file string) {
 	var plans Plans
 
 	if err := json.Unmarshal(data, &plans); err != nil {
-		log.Fatalf("[!] Failed to parse JSON content from subscription plan file : %s\n", err.Error())
+		return nil, err
 	}
 
-	for _, v := range plans.Plans {
+	return plans.Plans, nil
+
+}
+
+// PersistAllSubscriptionPlans - Given path to user created subscription plan
+// holder ``.plans.json` file, it'll read that content into memory & then parse JSON
+// content of its, which will be persisted into database, into `subscription_plans` table
+func PersistAllSubscriptionPlans(_db *gorm.DB, file string) {
+
+	plans, err := ReadSubscriptionPlans(file)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to read subscription plans")
+	}
+
+	for _, v := range plans {
 		AddNewSubscriptionPlan(_db, v.Name, v.DeliveryCount)
 	}
 
-	log.Printf("[+] Successfully persisted subscription plans into database")
+	log.Info("Successfully persisted subscription plans into database")
+
+}
+
+// ReloadSubscriptionPlans - Given plans freshly read from `.plans.json`, applies all
+// changes i.e. newly added plans & updated delivery counts, inside single database
+// transaction, so either all of them get applied or none
+//
+// Plans present in database but not in file anymore are kept as they're, because
+// subscribers might still be referring to them
+//
+// Returns list of changes applied
+func ReloadSubscriptionPlans(_db *gorm.DB, plans []*Plan) ([]string, error) {
+
+	changes := make([]string, 0)
+
+	if err := _db.Transaction(func(dbWTx *gorm.DB) error {
+
+		for _, v := range plans {
+
+			var plan SubscriptionPlans
+
+			result := dbWTx.Where("name = ?", v.Name).Limit(1).Find(&plan)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			if result.RowsAffected == 0 {
+
+				if err := dbWTx.Create(&SubscriptionPlans{
+					Name:          v.Name,
+					DeliveryCount: v.DeliveryCount,
+				}).Error; err != nil {
+					return err
+				}
+
+				changes = append(changes, fmt.Sprintf("plan `%s` : added with deliveryCount %d", v.Name, v.DeliveryCount))
+				continue
+
+			}
+
+			if plan.DeliveryCount == v.DeliveryCount {
+				continue
+			}
+
+			if err := dbWTx.Model(&SubscriptionPlans{}).Where("name = ?", v.Name).Update("deliverycount", v.DeliveryCount).Error; err != nil {
+				return err
+			}
+
+			changes = append(changes, fmt.Sprintf("plan `%s` : deliveryCount %d -> %d", v.Name, plan.DeliveryCount, v.DeliveryCount))
+
+		}
+
+		return nil
+
+	}); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
 
 }
 
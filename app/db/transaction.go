@@ -4,7 +4,6 @@ import (
 	"errors"
 
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 )
 
 // UpsertTransaction - It may be the case previously this block was processed
@@ -20,7 +19,7 @@ func UpsertTransaction(dbWTx *gorm.DB, tx *Transactions) error {
 		return errors.New("empty transaction received while attempting to persist")
 	}
 
-	return dbWTx.Clauses(clause.OnConflict{UpdateAll: true}).Create(tx).Error
+	return upsert(dbWTx, tx, "hash", "blocknumber")
 
 }
 
@@ -101,10 +101,12 @@ func ToggleAPIKeyState(_db *gorm.DB, apiKey string) bool {
 
 // GetUserFromAPIKey - Given API Key, tries to find out if there's any user registered
 // who signed for creating this API Key
+//
+// API keys of banned users are treated as if they never existed
 func GetUserFromAPIKey(_db *gorm.DB, apiKey string) *Users {
 	var user Users
 
-	if err := _db.Model(&Users{}).Where("users.apikey = ?", apiKey).First(&user).Error; err != nil {
+	if err := _db.Model(&Users{}).Where("users.apikey = ? and users.address not in (?)", apiKey, _db.Model(&BannedAddresses{}).Select("address")).First(&user).Error; err != nil {
 		return nil
 	}
 
@@ -122,27 +124,34 @@ func ValidateAPIKey(_db *gorm.DB, apiKey string) bool {
 // i.e. who created API Key ), for query response or for real-time data delivery,
 // is under a limit ( currently hardcoded inside code ) or not
 func IsUnderRateLimit(_db *gorm.DB, userAddress string) bool {
+	return GetRemainingDeliveries(_db, userAddress) > 0
+}
 
-	// Getting current local time of machine
-	now := time.Now()
+// GetRemainingDeliveries - How many more deliveries can be made to client
+// application on this day, as per plan user is subscribed to, which is used
+// for limiting size of streamed response up front
+func GetRemainingDeliveries(_db *gorm.DB, userAddress string) uint64 {
 
-	// Segregating into parts required
-	var (
-		day   = now.Day()
-		month = now.Month()
-		year  = now.Year()
-	)
+	// Deliveries made since start of current day ( UTC ), where time bounds are
+	// computed here, so that query stays portable across database engines
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
 
 	var count int64
 
 	if err := _db.Model(&DeliveryHistory{}).
-		Where("delivery_history.client = ? and extract(day from delivery_history.ts) = ? and extract(month from delivery_history.ts) = ? and extract(year from delivery_history.ts) = ?", userAddress, day, month, year).
+		Where("delivery_history.client = ? and delivery_history.ts >= ? and delivery_history.ts < ?", userAddress, start, start.Add(24*time.Hour)).
 		Count(&count).Error; err != nil {
-		return false
+		return 0
 	}
 
 	// Compare it with allowed rate count per 24 hours, of plan user is subscribed to
-	return count < int64(GetAllowedDeliveryCountByAddress(_db, common.HexToAddress(userAddress)))
+	allowed := GetAllowedDeliveryCountByAddress(_db, common.HexToAddress(userAddress))
+	if uint64(count) >= allowed {
+		return 0
+	}
+
+	return allowed - uint64(count)
 }
 
 // DropOldDeliveryHistories - Attempts to delete older than 24 hours delivery history
@@ -158,7 +167,7 @@ func DropOldDeliveryHistories(_db *gorm.DB) {
 	// consistent to other parties attempting to read from same table
 	_db.Transaction(func(dbWtx *gorm.DB) error {
 
-		return dbWtx.Where("delivery_history.ts < now() - interval '24 hours'").Delete(&DeliveryHistory{}).Error
+		return dbWtx.Where("delivery_history.ts < ?", time.Now().UTC().Add(-24*time.Hour)).Delete(&DeliveryHistory{}).Error
 
 	})
 
@@ -0,0 +1,165 @@
+package db
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// RegisterWebhook - Registers webhook against API key, along with freshly generated
+// secret, to be used for signing payloads delivered to it
+func RegisterWebhook(_db *gorm.DB, apiKey string, name string, url string) *Webhooks {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.WithError(err).Error("Failed to generate webhook secret")
+		return nil
+	}
+
+	webhook := &Webhooks{
+		ID:        uuid.New().String(),
+		APIKey:    apiKey,
+		Name:      name,
+		URL:       url,
+		Secret:    hex.EncodeToString(secret),
+		Enabled:   true,
+		TimeStamp: time.Now().UTC(),
+	}
+
+	if err := _db.Create(webhook).Error; err != nil {
+		log.WithError(err).WithField("url", url).Error("Failed to register webhook")
+		return nil
+	}
+
+	return webhook
+}
+
+// GetWebhooksByUserAddress - Given user address, returns all webhooks registered
+// against any of API keys created by user, latest first
+func GetWebhooksByUserAddress(_db *gorm.DB, address common.Address) []*Webhooks {
+	var webhooks []*Webhooks
+
+	if err := _db.Model(&Webhooks{}).Joins("join users on users.apikey = webhooks.apikey").Where("users.address = ?", address.Hex()).Order("webhooks.ts desc").Find(&webhooks).Error; err != nil {
+		return nil
+	}
+
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	return webhooks
+}
+
+// GetWebhookByUserAddress - Given webhook id, returns it, only if it's registered
+// against one of API keys created by user
+func GetWebhookByUserAddress(_db *gorm.DB, address common.Address, id string) *Webhooks {
+	var webhook Webhooks
+
+	if err := _db.Model(&Webhooks{}).Joins("join users on users.apikey = webhooks.apikey").Where("users.address = ? and webhooks.id = ?", address.Hex(), id).First(&webhook).Error; err != nil {
+		return nil
+	}
+
+	return &webhook
+}
+
+// ToggleWebhookState - Given webhook id, toggles its enabled state, only if it's
+// registered by user
+func ToggleWebhookState(_db *gorm.DB, address common.Address, id string) bool {
+	webhook := GetWebhookByUserAddress(_db, address, id)
+	if webhook == nil {
+		return false
+	}
+
+	if err := _db.Model(&Webhooks{}).Where("webhooks.id = ?", webhook.ID).Update("enabled", !webhook.Enabled).Error; err != nil {
+		return false
+	}
+
+	return true
+}
+
+// DeleteWebhook - Given webhook id, deletes it along with its delivery log,
+// only if it's registered by user
+func DeleteWebhook(_db *gorm.DB, address common.Address, id string) bool {
+	webhook := GetWebhookByUserAddress(_db, address, id)
+	if webhook == nil {
+		return false
+	}
+
+	return _db.Transaction(func(dbWTx *gorm.DB) error {
+
+		if err := dbWTx.Where("webhook_deliveries.webhookid = ?", webhook.ID).Delete(&WebhookDeliveries{}).Error; err != nil {
+			return err
+		}
+
+		return dbWTx.Where("webhooks.id = ?", webhook.ID).Delete(&Webhooks{}).Error
+
+	}) == nil
+}
+
+// GetEnabledWebhooks - Returns all webhooks, which are enabled & registered
+// against enabled API keys, to which real-time data is to be delivered,
+// where nil is returned only when failed to read them
+func GetEnabledWebhooks(_db *gorm.DB) []*Webhooks {
+	webhooks := make([]*Webhooks, 0)
+
+	if err := _db.Model(&Webhooks{}).Joins("join users on users.apikey = webhooks.apikey").Where("webhooks.enabled = ? and users.enabled = ?", true, true).Find(&webhooks).Error; err != nil {
+		log.WithError(err).Error("Failed to read webhooks")
+		return nil
+	}
+
+	return webhooks
+}
+
+// PutWebhookDelivery - Persists delivery, before first attempt is made
+func PutWebhookDelivery(_db *gorm.DB, delivery *WebhookDeliveries) bool {
+	if err := _db.Create(delivery).Error; err != nil {
+		log.WithError(err).Error("Failed to persist webhook delivery")
+		return false
+	}
+
+	return true
+}
+
+// UpdateWebhookDelivery - Records outcome of latest attempt to deliver payload
+func UpdateWebhookDelivery(_db *gorm.DB, delivery *WebhookDeliveries) bool {
+	if err := _db.Model(&WebhookDeliveries{}).Where("webhook_deliveries.id = ?", delivery.ID).Updates(map[string]interface{}{
+		"attempts":    delivery.Attempts,
+		"statuscode":  delivery.StatusCode,
+		"error":       delivery.Error,
+		"delivered":   delivery.Delivered,
+		"lastattempt": delivery.LastAttempt,
+	}).Error; err != nil {
+		log.WithError(err).Error("Failed to update webhook delivery")
+		return false
+	}
+
+	return true
+}
+
+// GetWebhookDeliveries - Given webhook id, returns at max `limit` recent
+// deliveries made to it, latest first, only if it's registered by user
+func GetWebhookDeliveries(_db *gorm.DB, address common.Address, id string, limit int) []*WebhookDeliveries {
+	webhook := GetWebhookByUserAddress(_db, address, id)
+	if webhook == nil {
+		return nil
+	}
+
+	var deliveries []*WebhookDeliveries
+
+	if err := _db.Model(&WebhookDeliveries{}).Where("webhook_deliveries.webhookid = ?", webhook.ID).Order("webhook_deliveries.ts desc").Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil
+	}
+
+	return deliveries
+}
+
+// DropOldWebhookDeliveries - Deletes delivery log entries created before given time
+func DropOldWebhookDeliveries(_db *gorm.DB, before time.Time) {
+	if err := _db.Where("webhook_deliveries.ts < ?", before).Delete(&WebhookDeliveries{}).Error; err != nil {
+		log.WithError(err).Error("Failed to delete old webhook deliveries")
+	}
+}
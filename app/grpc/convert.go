@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	d "github.com/itzmeanjan/ette/app/data"
+	pb "github.com/itzmeanjan/ette/app/pb"
+)
+
+// blockToProtoBuf - Converts block header into protobuf message, where
+// tx(s) of block are included only when asked for
+func (s *Server) blockToProtoBuf(block *d.Block, txs bool) *pb.Block {
+
+	_block := &pb.Block{
+		Hash:                block.Hash,
+		Number:              block.Number,
+		Time:                block.Time,
+		ParentHash:          block.ParentHash,
+		Difficulty:          string(block.Difficulty),
+		GasUsed:             block.GasUsed,
+		GasLimit:            block.GasLimit,
+		Nonce:               block.Nonce,
+		Miner:               block.Miner,
+		Size:                block.Size,
+		StateRootHash:       block.StateRootHash,
+		UncleHash:           block.UncleHash,
+		TransactionRootHash: block.TransactionRootHash,
+		ReceiptRootHash:     block.ReceiptRootHash,
+		ExtraData:           block.ExtraData,
+	}
+
+	if !txs {
+		return _block
+	}
+
+	_txs := s.DB.GetTransactionsByBlockHash(common.HexToHash(block.Hash), nil)
+	if _txs == nil {
+		return _block
+	}
+
+	_block.Transactions = make([]*pb.Transaction, len(_txs.Transactions))
+	for i, v := range _txs.Transactions {
+		_block.Transactions[i] = s.transactionToProtoBuf(v, true)
+	}
+
+	return _block
+
+}
+
+// transactionToProtoBuf - Converts tx into protobuf message, where events
+// emitted by tx are included only when asked for
+func (s *Server) transactionToProtoBuf(tx *d.Transaction, events bool) *pb.Transaction {
+
+	_tx := &pb.Transaction{
+		Hash:      tx.Hash,
+		From:      tx.From,
+		To:        tx.To,
+		Contract:  tx.Contract,
+		Value:     string(tx.Value),
+		Data:      tx.Data,
+		Gas:       tx.Gas,
+		GasPrice:  string(tx.GasPrice),
+		Cost:      string(tx.Cost),
+		Nonce:     tx.Nonce,
+		State:     tx.State,
+		BlockHash: tx.BlockHash,
+	}
+
+	if !events {
+		return _tx
+	}
+
+	_events := s.DB.GetEventsByTransactionHash(common.HexToHash(tx.Hash), nil)
+	if _events == nil {
+		return _tx
+	}
+
+	_tx.Events = eventsToProtoBuf(_events.Events)
+	return _tx
+
+}
+
+// eventToProtoBuf - Converts event into protobuf message
+func eventToProtoBuf(event *d.Event) *pb.Event {
+
+	return &pb.Event{
+		BlockHash:       event.BlockHash,
+		Index:           uint32(event.Index),
+		Origin:          event.Origin,
+		Topics:          event.Topics,
+		Data:            event.Data,
+		TransactionHash: event.TransactionHash,
+	}
+
+}
+
+// eventsToProtoBuf - Converts events into protobuf messages, in order
+func eventsToProtoBuf(events []*d.Event) []*pb.Event {
+
+	_events := make([]*pb.Event, len(events))
+	for i, v := range events {
+		_events[i] = eventToProtoBuf(v)
+	}
+
+	return _events
+
+}
@@ -0,0 +1,189 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+	pb "github.com/itzmeanjan/ette/app/pb"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Server - Serves historical data lookups & real-time data subscriptions
+// over gRPC, using same protobuf definitions used for snapshots
+type Server struct {
+	pb.UnimplementedEtteServer
+
+	DB    db.Store
+	Redis *d.RedisInfo
+}
+
+// RunGRPCServer - Starts gRPC server on `GRPCPort`, which is stopped
+// when context gets cancelled
+func RunGRPCServer(ctx context.Context, _db db.Store, _redis *d.RedisInfo) {
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Current().GRPCPort))
+	if err != nil {
+		log.WithError(err).Error("Failed to listen for gRPC connections")
+		return
+	}
+
+	server := &Server{DB: _db, Redis: _redis}
+
+	_server := grpc.NewServer(grpc.UnaryInterceptor(server.UnaryInterceptor), grpc.StreamInterceptor(server.StreamInterceptor))
+	pb.RegisterEtteServer(_server, server)
+
+	go func() {
+		<-ctx.Done()
+		_server.Stop()
+	}()
+
+	if err := _server.Serve(listener); err != nil {
+		log.WithError(err).Error("Failed to serve gRPC")
+	}
+
+}
+
+// Authenticate - Finds out user, using `apikey` metadata, who is allowed
+// to be served i.e. API key is enabled & user is under rate limit, same
+// as it's done for REST API
+func (s *Server) Authenticate(ctx context.Context) (*db.Users, error) {
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	apiKey := md.Get("apikey")
+	if len(apiKey) == 0 || apiKey[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "API Key Required")
+	}
+
+	user := s.DB.GetUserFromAPIKey(apiKey[0])
+	if user == nil || !user.Enabled {
+		return nil, status.Error(codes.Unauthenticated, "Bad API Key")
+	}
+
+	if !s.DB.IsUnderRateLimit(user.Address) {
+		metrics.RateLimited("grpc")
+		return nil, status.Error(codes.ResourceExhausted, "Crossed Allowed Rate Limit")
+	}
+
+	return user, nil
+
+}
+
+// UnaryInterceptor - Authenticates each lookup & records size of response
+// delivered, to be counted against user's subscription plan
+func (s *Server) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	start := time.Now()
+
+	user, err := s.Authenticate(ctx)
+	if err != nil {
+		served(info.FullMethod, "", start, err)
+		return nil, err
+	}
+
+	resp, err := handler(ctx, req)
+	served(info.FullMethod, user.Address, start, err)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg, ok := resp.(proto.Message); ok {
+		s.DB.PutDataDeliveryInfo(user.Address, info.FullMethod, uint64(proto.Size(msg)))
+	}
+
+	return resp, nil
+
+}
+
+// StreamInterceptor - Authenticates each subscription & keeps checking rate
+// limit, for each piece of data delivered over it
+func (s *Server) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+	start := time.Now()
+
+	user, err := s.Authenticate(stream.Context())
+	if err != nil {
+		served(info.FullMethod, "", start, err)
+		return err
+	}
+
+	metrics.Connected("grpc")
+	defer metrics.Disconnected("grpc")
+
+	err = handler(srv, &meteredStream{ServerStream: stream, server: s, user: user, method: info.FullMethod, topic: streamTopics[info.FullMethod]})
+	served(info.FullMethod, user.Address, start, err)
+
+	return err
+
+}
+
+// served - Logs lookup/ subscription served, same as it's done for HTTP
+// requests, along with address of user, who owns API key, when known
+func served(method string, user string, start time.Time, err error) {
+	entry := log.WithFields(log.Fields{
+		"method": method,
+		"code":   status.Code(err).String(),
+		"took":   time.Since(start).String(),
+	})
+
+	if user != "" {
+		entry = entry.WithField("user", user)
+	}
+
+	if err != nil {
+		entry = entry.WithError(err)
+	}
+
+	entry.Info("Served gRPC call")
+}
+
+// meteredStream - Server stream, which delivers messages only when user is
+// under rate limit, recording size of each one delivered
+type meteredStream struct {
+	grpc.ServerStream
+
+	server *Server
+	user   *db.Users
+	method string
+	topic  string
+}
+
+// Real-time data topic, each of subscriptions mirror
+var streamTopics = map[string]string{
+	"/Ette/SubscribeBlocks":       "block",
+	"/Ette/SubscribeTransactions": "transaction",
+	"/Ette/SubscribeEvents":       "event",
+}
+
+// SendMsg - Delivers message to client, when user is still under rate limit
+func (m *meteredStream) SendMsg(msg interface{}) error {
+
+	if !m.server.DB.IsUnderRateLimit(m.user.Address) {
+		metrics.RateLimited("grpc")
+		return status.Error(codes.ResourceExhausted, "Crossed Allowed Rate Limit")
+	}
+
+	if err := m.ServerStream.SendMsg(msg); err != nil {
+		return err
+	}
+
+	if _msg, ok := msg.(proto.Message); ok {
+		m.server.DB.PutDataDeliveryInfo(m.user.Address, m.method, uint64(proto.Size(_msg)))
+	}
+
+	metrics.Delivered("grpc", m.topic)
+
+	return nil
+
+}
@@ -0,0 +1,155 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/itzmeanjan/ette/app/common"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	pb "github.com/itzmeanjan/ette/app/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkHistoricalMode - Lookups are served only when this `ette`
+// instance supports historical data query
+func checkHistoricalMode() error {
+	if !cfg.Current().HistoricalModeEnabled() {
+		return status.Error(codes.FailedPrecondition, "Disabled Feature")
+	}
+
+	return nil
+}
+
+// isHash - Checks whether given string is 32 bytes hex encoded hash
+func isHash(hash string) bool {
+	return strings.HasPrefix(hash, "0x") && len(hash) == 66
+}
+
+// GetBlockByHash - Given block hash, returns block, along with its
+// tx(s), when asked for
+func (s *Server) GetBlockByHash(ctx context.Context, req *pb.BlockByHashRequest) (*pb.Block, error) {
+
+	if err := checkHistoricalMode(); err != nil {
+		return nil, err
+	}
+
+	if !isHash(req.Hash) {
+		return nil, status.Error(codes.InvalidArgument, "Bad block hash")
+	}
+
+	block := s.DB.GetBlockByHash(common.HexToHash(req.Hash))
+	if block == nil {
+		return nil, status.Error(codes.NotFound, "Not found")
+	}
+
+	return s.blockToProtoBuf(block, req.Transactions), nil
+
+}
+
+// GetBlockByNumber - Given block number, returns block, along with its
+// tx(s), when asked for
+func (s *Server) GetBlockByNumber(ctx context.Context, req *pb.BlockByNumberRequest) (*pb.Block, error) {
+
+	if err := checkHistoricalMode(); err != nil {
+		return nil, err
+	}
+
+	block := s.DB.GetBlockByNumber(req.Number)
+	if block == nil {
+		return nil, status.Error(codes.NotFound, "Not found")
+	}
+
+	return s.blockToProtoBuf(block, req.Transactions), nil
+
+}
+
+// GetTransactionByHash - Given tx hash, returns tx, along with events
+// emitted by it, when asked for
+func (s *Server) GetTransactionByHash(ctx context.Context, req *pb.TransactionByHashRequest) (*pb.Transaction, error) {
+
+	if err := checkHistoricalMode(); err != nil {
+		return nil, err
+	}
+
+	if !isHash(req.Hash) {
+		return nil, status.Error(codes.InvalidArgument, "Bad tx hash")
+	}
+
+	tx := s.DB.GetTransactionByHash(common.HexToHash(req.Hash))
+	if tx == nil {
+		return nil, status.Error(codes.NotFound, "Not found")
+	}
+
+	return s.transactionToProtoBuf(tx, req.Events), nil
+
+}
+
+// GetEvents - Returns events satisfying filter, emitted in given block
+// time range, when set, otherwise in given block number range, same as
+// REST API does, paginated when `limit` or `cursor` is set
+func (s *Server) GetEvents(ctx context.Context, req *pb.EventFilter) (*pb.Events, error) {
+
+	if err := checkHistoricalMode(); err != nil {
+		return nil, err
+	}
+
+	filter, err := cmn.ParseEventFilter(req.Contracts, topicsOf(req.Topics))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := ""
+	if req.Limit != 0 {
+		limit = strconv.FormatUint(req.Limit, 10)
+	}
+
+	page, err := cmn.ParsePage(limit, req.Cursor, cfg.GetMaxPageSize())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var events *d.Events
+
+	if req.FromTime != 0 || req.ToTime != 0 {
+
+		from, to, err := cmn.PagedRangeChecker(strconv.FormatUint(req.FromTime, 10), strconv.FormatUint(req.ToTime, 10), cfg.GetTimeRange(), page != nil)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		events = s.DB.GetEventsByBlockTimeRangeWithFilter(filter, from, to, page)
+
+	} else {
+
+		from, to, err := cmn.PagedRangeChecker(strconv.FormatUint(req.FromBlock, 10), strconv.FormatUint(req.ToBlock, 10), cfg.GetBlockNumberRange(), page != nil)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		events = s.DB.GetEventsByBlockNumberRangeWithFilter(filter, from, to, page)
+
+	}
+
+	if events == nil {
+		return nil, status.Error(codes.NotFound, "Not found")
+	}
+
+	return &pb.Events{Events: eventsToProtoBuf(events.Events), Next: events.Next}, nil
+
+}
+
+// topicsOf - Set of topics for each position, as expected by event filter
+func topicsOf(topics []*pb.Topics) [][]string {
+
+	_topics := make([][]string, len(topics))
+	for i, v := range topics {
+		_topics[i] = v.GetTopics()
+	}
+
+	return _topics
+
+}
@@ -0,0 +1,265 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	cmn "github.com/itzmeanjan/ette/app/common"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	pb "github.com/itzmeanjan/ette/app/pb"
+	ps "github.com/itzmeanjan/ette/app/pubsub"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkRealtimeMode - Subscriptions are served only when this `ette`
+// instance supports real-time data delivery
+func checkRealtimeMode() error {
+	if !cfg.Current().RealtimeModeEnabled() {
+		return status.Error(codes.FailedPrecondition, "Disabled Feature")
+	}
+
+	return nil
+}
+
+// subscribe - Listens for data published on Redis pubsub topic, same as
+// websocket consumers do, handing over each piece to `fn`, until either
+// client goes away or `fn` fails to deliver it
+func (s *Server) subscribe(ctx context.Context, topic string, fn func(string) error) error {
+
+	pubsub := s.Redis.Client.Subscribe(ctx, topic)
+	defer func() {
+		if err := pubsub.Close(); err != nil {
+			log.WithError(err).Error("Failed to close gRPC pubsub subscription")
+		}
+	}()
+
+	// Waiting for subscription to be confirmed, so that nothing
+	// published after this call returns gets missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return status.Error(codes.Unavailable, "Failed to subscribe")
+	}
+
+	messages := pubsub.Channel()
+
+	for {
+
+		select {
+
+		case <-ctx.Done():
+			return nil
+
+		case m, ok := <-messages:
+			if !ok {
+				return status.Error(codes.Unavailable, "Subscription closed")
+			}
+
+			if err := fn(m.Payload); err != nil {
+				return err
+			}
+
+		}
+
+	}
+
+}
+
+// decodeHex - Decodes `0x` prefixed hex string, published as data field
+// of tx/ event, where empty string stands for empty data
+func decodeHex(data string) ([]byte, error) {
+	if data == "" {
+		return []byte{}, nil
+	}
+
+	return hex.DecodeString(strings.TrimPrefix(data, "0x"))
+}
+
+// SubscribeBlocks - Delivers each block, as soon as it's published
+func (s *Server) SubscribeBlocks(req *pb.BlockSubscription, stream pb.Ette_SubscribeBlocksServer) error {
+
+	if err := checkRealtimeMode(); err != nil {
+		return err
+	}
+
+	return s.subscribe(stream.Context(), s.Redis.BlockPublishTopic, func(msg string) error {
+
+		var block struct {
+			Hash                string  `json:"hash"`
+			Number              uint64  `json:"number"`
+			Time                uint64  `json:"time"`
+			ParentHash          string  `json:"parentHash"`
+			Difficulty          string  `json:"difficulty"`
+			GasUsed             uint64  `json:"gasUsed"`
+			GasLimit            uint64  `json:"gasLimit"`
+			Nonce               string  `json:"nonce"`
+			Miner               string  `json:"miner"`
+			Size                float64 `json:"size"`
+			StateRootHash       string  `json:"stateRootHash"`
+			UncleHash           string  `json:"uncleHash"`
+			TransactionRootHash string  `json:"txRootHash"`
+			ReceiptRootHash     string  `json:"receiptRootHash"`
+			ExtraData           string  `json:"extraData"`
+		}
+
+		if err := json.Unmarshal([]byte(msg), &block); err != nil {
+			log.WithError(err).Error("Failed to decode published block data to JSON")
+			return nil
+		}
+
+		extraData, err := decodeHex(block.ExtraData)
+		if err != nil {
+			log.WithError(err).Error("Failed to decode extra data field of block")
+			return nil
+		}
+
+		return stream.Send(&pb.Block{
+			Hash:                block.Hash,
+			Number:              block.Number,
+			Time:                block.Time,
+			ParentHash:          block.ParentHash,
+			Difficulty:          block.Difficulty,
+			GasUsed:             block.GasUsed,
+			GasLimit:            block.GasLimit,
+			Nonce:               block.Nonce,
+			Miner:               block.Miner,
+			Size:                block.Size,
+			StateRootHash:       block.StateRootHash,
+			UncleHash:           block.UncleHash,
+			TransactionRootHash: block.TransactionRootHash,
+			ReceiptRootHash:     block.ReceiptRootHash,
+			ExtraData:           extraData,
+		})
+
+	})
+
+}
+
+// SubscribeTransactions - Delivers each tx, sent from & to given accounts,
+// as soon as it's published, where empty account matches any, same as
+// `transaction/<from>/<to>` websocket topic
+func (s *Server) SubscribeTransactions(req *pb.TransactionSubscription, stream pb.Ette_SubscribeTransactionsServer) error {
+
+	if err := checkRealtimeMode(); err != nil {
+		return err
+	}
+
+	wildcard := func(v string) string {
+		if v == "" {
+			return "*"
+		}
+
+		return v
+	}
+
+	request := &ps.SubscriptionRequest{Name: fmt.Sprintf("transaction/%s/%s", wildcard(req.From), wildcard(req.To))}
+	if !request.IsValidTopic() {
+		return status.Error(codes.InvalidArgument, "Bad account address")
+	}
+
+	return s.subscribe(stream.Context(), s.Redis.TxPublishTopic, func(msg string) error {
+
+		var tx struct {
+			Hash      string `json:"hash"`
+			From      string `json:"from"`
+			To        string `json:"to"`
+			Contract  string `json:"contract"`
+			Value     string `json:"value"`
+			Data      string `json:"data"`
+			Gas       uint64 `json:"gas"`
+			GasPrice  string `json:"gasPrice"`
+			Cost      string `json:"cost"`
+			Nonce     uint64 `json:"nonce"`
+			State     uint64 `json:"state"`
+			BlockHash string `json:"blockHash"`
+		}
+
+		if err := json.Unmarshal([]byte(msg), &tx); err != nil {
+			log.WithError(err).Error("Failed to decode published transaction data to JSON")
+			return nil
+		}
+
+		if !request.DoesMatchWithPublishedTransactionData(&d.Transaction{From: tx.From, To: tx.To}) {
+			return nil
+		}
+
+		data, err := decodeHex(tx.Data)
+		if err != nil {
+			log.WithError(err).Error("Failed to decode data field of transaction")
+			return nil
+		}
+
+		return stream.Send(&pb.Transaction{
+			Hash:      tx.Hash,
+			From:      tx.From,
+			To:        tx.To,
+			Contract:  tx.Contract,
+			Value:     tx.Value,
+			Data:      data,
+			Gas:       tx.Gas,
+			GasPrice:  tx.GasPrice,
+			Cost:      tx.Cost,
+			Nonce:     tx.Nonce,
+			State:     tx.State,
+			BlockHash: tx.BlockHash,
+		})
+
+	})
+
+}
+
+// SubscribeEvents - Delivers each event, satisfying filter, as soon as it's
+// published, where filter is same as one used for querying events
+func (s *Server) SubscribeEvents(req *pb.EventSubscription, stream pb.Ette_SubscribeEventsServer) error {
+
+	if err := checkRealtimeMode(); err != nil {
+		return err
+	}
+
+	filter, err := cmn.ParseEventFilter(req.Contracts, topicsOf(req.Topics))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.subscribe(stream.Context(), s.Redis.EventPublishTopic, func(msg string) error {
+
+		var event struct {
+			Origin          string   `json:"origin"`
+			Index           uint     `json:"index"`
+			Topics          []string `json:"topics"`
+			Data            string   `json:"data"`
+			TransactionHash string   `json:"txHash"`
+			BlockHash       string   `json:"blockHash"`
+		}
+
+		if err := json.Unmarshal([]byte(msg), &event); err != nil {
+			log.WithError(err).Error("Failed to decode published event data to JSON")
+			return nil
+		}
+
+		if !filter.Matches(&d.Event{Origin: event.Origin, Topics: event.Topics}) {
+			return nil
+		}
+
+		data, err := decodeHex(event.Data)
+		if err != nil {
+			log.WithError(err).Error("Failed to decode data field of event")
+			return nil
+		}
+
+		return stream.Send(&pb.Event{
+			Origin:          event.Origin,
+			Index:           uint32(event.Index),
+			Topics:          event.Topics,
+			Data:            data,
+			TransactionHash: event.TransactionHash,
+			BlockHash:       event.BlockHash,
+		})
+
+	})
+
+}
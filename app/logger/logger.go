@@ -0,0 +1,49 @@
+package logger
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Until configuration is read, everything is logged in `logfmt`, without
+// any colour, so that even start up failures can be parsed
+func init() {
+	log.SetFormatter(formatter("logfmt"))
+}
+
+// formatter - Returns formatter for `LogFormat` i.e. {logfmt, json}
+func formatter(format string) log.Formatter {
+	if format == "json" {
+		return &log.JSONFormatter{TimestampFormat: time.RFC3339Nano}
+	}
+
+	return &log.TextFormatter{DisableColors: true, FullTimestamp: true, TimestampFormat: time.RFC3339Nano}
+}
+
+// Setup - Configures process wide logger, using `LogFormat` & `LogLevel`,
+// must be invoked after configuration is read
+//
+// Whatever gin writes on its own i.e. route table in debug mode & recovered
+// panics, is also sent through same logger
+func Setup() {
+	log.SetFormatter(formatter(cfg.Current().LogFormat))
+	SetLevel()
+
+	gin.DefaultWriter = log.StandardLogger().WriterLevel(log.DebugLevel)
+	gin.DefaultErrorWriter = log.StandardLogger().WriterLevel(log.ErrorLevel)
+}
+
+// SetLevel - Applies `LogLevel` from currently active configuration,
+// which is to be invoked after reloading it
+func SetLevel() {
+	level, err := log.ParseLevel(cfg.Current().LogLevel)
+	if err != nil {
+		log.WithError(err).Warn("Bad log level, keeping current one")
+		return
+	}
+
+	log.SetLevel(level)
+}
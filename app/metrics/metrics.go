@@ -0,0 +1,227 @@
+package metrics
+
+import (
+	"sync/atomic"
+	"time"
+
+	d "github.com/itzmeanjan/ette/app/data"
+	q "github.com/itzmeanjan/ette/app/queue"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Namespace every metric exposed by `ette` is prefixed with
+const namespace = "ette"
+
+var (
+	// Time taken for fetching block, along with all of its tx receipts,
+	// from blockchain node
+	blockFetchDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "block_fetch_duration_seconds",
+		Help:      "Time taken for fetching block, along with its tx receipts, from blockchain node",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	})
+
+	// Time taken for persisting whole block, in single database tx
+	blockStoreDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "block_store_duration_seconds",
+		Help:      "Time taken for persisting block, along with its tx(s) & events, into database",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	// Failed calls to blockchain node, by JSON-RPC method
+	rpcErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_errors_total",
+		Help:      "Failed calls to blockchain node, by JSON-RPC method",
+	}, []string{"method"})
+
+	// HTTP requests served, by route & response status
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests served, by route & response status",
+	}, []string{"method", "route", "status"})
+
+	// Time taken for serving HTTP requests, by route
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Time taken for serving HTTP requests, by route",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	// Clients currently connected for receiving real-time data
+	activeConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_connections",
+		Help:      "Clients currently connected for receiving real-time data, by transport",
+	}, []string{"transport"})
+
+	// Real-time data delivered to clients
+	messagesDelivered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_delivered_total",
+		Help:      "Real-time data delivered to clients, by transport & topic",
+	}, []string{"transport", "topic"})
+
+	// Requests/ deliveries dropped, because user crossed allowed rate limit
+	rateLimitRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limit_rejections_total",
+		Help:      "Requests/ deliveries dropped, because user crossed allowed rate limit, by API",
+	}, []string{"api"})
+)
+
+// Highest block number processed so far, to be used for
+// computing how far behind `ette` is from latest block seen
+var highestProcessed uint64
+
+// BlockFetched - Records time taken for fetching block
+func BlockFetched(took time.Duration) {
+	blockFetchDuration.Observe(took.Seconds())
+}
+
+// BlockStored - Records time taken for persisting block
+func BlockStored(took time.Duration) {
+	blockStoreDuration.Observe(took.Seconds())
+}
+
+// BlockProcessed - Keeps track of highest block number processed
+func BlockProcessed(number uint64) {
+	for {
+		current := atomic.LoadUint64(&highestProcessed)
+		if number <= current || atomic.CompareAndSwapUint64(&highestProcessed, current, number) {
+			return
+		}
+	}
+}
+
+// RPCFailed - Records failed call to blockchain node
+func RPCFailed(method string) {
+	rpcErrors.WithLabelValues(method).Inc()
+}
+
+// RequestServed - Records HTTP request served, along with time taken
+func RequestServed(method string, route string, status string, took time.Duration) {
+	httpRequests.WithLabelValues(method, route, status).Inc()
+	httpRequestDuration.WithLabelValues(method, route).Observe(took.Seconds())
+}
+
+// Connected - Records client connected over given transport
+// i.e. {websocket, sse, grpc}
+func Connected(transport string) {
+	activeConnections.WithLabelValues(transport).Inc()
+}
+
+// Disconnected - Records client disconnected from given transport
+func Disconnected(transport string) {
+	activeConnections.WithLabelValues(transport).Dec()
+}
+
+// Delivered - Records real-time data, published on topic i.e. {block,
+// transaction, event}, delivered over given transport
+func Delivered(transport string, topic string) {
+	messagesDelivered.WithLabelValues(transport, topic).Inc()
+}
+
+// RateLimited - Records request/ delivery dropped, because user
+// crossed allowed rate limit
+func RateLimited(api string) {
+	rateLimitRejections.WithLabelValues(api).Inc()
+}
+
+// RegisterStatus - Exposes sync progress kept track of in status holder,
+// which are read only when metrics are scraped
+func RegisterStatus(status *d.StatusHolder) {
+
+	prometheus.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "blocks_processed_total",
+			Help:      "Blocks processed after `ette` started",
+		}, func() float64 {
+			return float64(status.Done())
+		}),
+
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "blocks_inserted_total",
+			Help:      "Blocks inserted into database after `ette` started",
+		}, func() float64 {
+			return float64(status.BlocksInserted())
+		}),
+
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "latest_block_number",
+			Help:      "Latest block seen from blockchain node",
+		}, func() float64 {
+			return float64(status.GetLatestBlockNumber())
+		}),
+
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "blocks_in_db",
+			Help:      "Blocks currently present in database",
+		}, func() float64 {
+			return float64(status.BlockCountInDB())
+		}),
+
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "head_lag_blocks",
+			Help:      "How many blocks highest processed one is behind latest block seen from blockchain node",
+		}, func() float64 {
+			latest, processed := status.GetLatestBlockNumber(), atomic.LoadUint64(&highestProcessed)
+			if processed == 0 || latest <= processed {
+				return 0
+			}
+
+			return float64(latest - processed)
+		}),
+	)
+
+}
+
+// queueCollector - Exposes block processor queue state, asking queue
+// only once per scrape
+type queueCollector struct {
+	queue *q.BlockProcessorQueue
+	desc  *prometheus.Desc
+}
+
+// Describe - Sends descriptor of queue state metric
+func (c *queueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect - Reads current queue state, sending it as metrics
+func (c *queueCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.queue.Stat()
+
+	for state, v := range map[string]uint64{
+		"unconfirmed_progress": stat.UnconfirmedProgress,
+		"unconfirmed_waiting":  stat.UnconfirmedWaiting,
+		"confirmed_progress":   stat.ConfirmedProgress,
+		"confirmed_waiting":    stat.ConfirmedWaiting,
+	} {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(v), state)
+	}
+}
+
+// RegisterQueue - Exposes state of block processor queue, which is read
+// only when metrics are scraped
+func RegisterQueue(queue *q.BlockProcessorQueue) {
+
+	prometheus.MustRegister(&queueCollector{
+		queue: queue,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "queue", "blocks"),
+			"Blocks present in block processor queue, by state",
+			[]string{"state"}, nil),
+	})
+
+}
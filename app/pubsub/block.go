@@ -3,16 +3,16 @@ package pubsub
 import (
 	"context"
 	"encoding/json"
-	"log"
 	"sync"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/websocket"
-	"gorm.io/gorm"
 
 	"github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+	log "github.com/sirupsen/logrus"
 )
 
 // BlockConsumer - To be subscribed to `block` topic using this consumer handle
@@ -22,7 +22,7 @@ type BlockConsumer struct {
 	Requests   map[string]*SubscriptionRequest
 	Connection *websocket.Conn
 	PubSub     *redis.PubSub
-	DB         *gorm.DB
+	DB         db.Store
 	ConnLock   *sync.Mutex
 	TopicLock  *sync.RWMutex
 	Counter    *data.SendReceiveCounter
@@ -94,7 +94,7 @@ func (b *BlockConsumer) Send(msg string) {
 		return
 	}
 
-	user := db.GetUserFromAPIKey(b.DB, request.APIKey)
+	user := b.DB.GetUserFromAPIKey(request.APIKey)
 	if user == nil {
 
 		// -- Critical section of code begins
@@ -107,7 +107,7 @@ func (b *BlockConsumer) Send(msg string) {
 			Code:    0,
 			Message: "Bad API Key",
 		}); err != nil {
-			log.Printf("[!] Failed to deliver bad API key message to client : %s\n", err.Error())
+			log.WithError(err).Warn("Failed to deliver bad API key message to client")
 		}
 
 		b.ConnLock.Unlock()
@@ -131,7 +131,7 @@ func (b *BlockConsumer) Send(msg string) {
 			Code:    0,
 			Message: "Bad API Key",
 		}); err != nil {
-			log.Printf("[!] Failed to deliver bad API key message to client : %s\n", err.Error())
+			log.WithError(err).Warn("Failed to deliver bad API key message to client")
 		}
 
 		b.ConnLock.Unlock()
@@ -145,7 +145,9 @@ func (b *BlockConsumer) Send(msg string) {
 
 	// Don't deliver data & close underlying connection
 	// if client has crossed it's allowed data delivery limit
-	if !db.IsUnderRateLimit(b.DB, user.Address) {
+	if !b.DB.IsUnderRateLimit(user.Address) {
+
+		metrics.RateLimited("websocket")
 
 		// -- Critical section of code begins
 		//
@@ -157,7 +159,7 @@ func (b *BlockConsumer) Send(msg string) {
 			Code:    0,
 			Message: "Crossed Allowed Rate Limit",
 		}); err != nil {
-			log.Printf("[!] Failed to deliver rate limit crossed message to client : %s\n", err.Error())
+			log.WithError(err).WithField("user", user.Address).Warn("Failed to deliver rate limit crossed message to client")
 		}
 
 		b.ConnLock.Unlock()
@@ -191,12 +193,13 @@ func (b *BlockConsumer) Send(msg string) {
 
 	err := json.Unmarshal(_msg, &block)
 	if err != nil {
-		log.Printf("[!] Failed to decode published block data to JSON : %s\n", err.Error())
+		log.WithError(err).Error("Failed to decode published block data to JSON")
 		return
 	}
 
 	if b.SendData(&block) {
-		db.PutDataDeliveryInfo(b.DB, user.Address, "/v1/ws/block", uint64(len(msg)))
+		b.DB.PutDataDeliveryInfo(user.Address, "/v1/ws/block", uint64(len(msg)))
+		metrics.Delivered("websocket", "block")
 	}
 
 }
@@ -215,7 +218,7 @@ func (b *BlockConsumer) SendData(data interface{}) bool {
 	defer b.ConnLock.Unlock()
 
 	if err := b.Connection.WriteJSON(data); err != nil {
-		log.Printf("[!] Failed to deliver `block` data to client : %s\n", err.Error())
+		log.WithError(err).Warn("Failed to deliver `block` data to client")
 		return false
 	}
 
@@ -230,12 +233,12 @@ func (b *BlockConsumer) SendData(data interface{}) bool {
 func (b *BlockConsumer) Unsubscribe() {
 
 	if b.PubSub == nil {
-		log.Printf("[!] Bad attempt to unsubscribe from `block` topic\n")
+		log.Warn("Bad attempt to unsubscribe from `block` topic")
 		return
 	}
 
 	if err := b.PubSub.Unsubscribe(context.Background(), "block"); err != nil {
-		log.Printf("[!] Failed to unsubscribe from `block` topic : %s\n", err.Error())
+		log.WithError(err).Error("Failed to unsubscribe from `block` topic")
 		return
 	}
 
@@ -253,7 +256,7 @@ func (b *BlockConsumer) Unsubscribe() {
 
 	if err := b.Connection.WriteJSON(resp); err != nil {
 
-		log.Printf("[!] Failed to deliver `block` unsubscription confirmation to client : %s\n", err.Error())
+		log.WithError(err).Warn("Failed to deliver `block` unsubscription confirmation to client")
 		return
 
 	}
@@ -7,7 +7,7 @@ import (
 	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/websocket"
 	"github.com/itzmeanjan/ette/app/data"
-	"gorm.io/gorm"
+	"github.com/itzmeanjan/ette/app/db"
 )
 
 // SubscriptionManager - Higher level abstraction to be used
@@ -31,7 +31,7 @@ type SubscriptionManager struct {
 	Consumers  map[string]Consumer
 	Client     *redis.Client
 	Connection *websocket.Conn
-	DB         *gorm.DB
+	DB         db.Store
 	ConnLock   *sync.Mutex
 	TopicLock  *sync.RWMutex
 	Counter    *data.SendReceiveCounter
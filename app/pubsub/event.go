@@ -4,18 +4,18 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
-	"log"
 	"sync"
 	"time"
 
 	"github.com/itzmeanjan/ette/app/data"
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
 	"github.com/lib/pq"
-	"gorm.io/gorm"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
 )
 
 // EventConsumer - Event consumption to be managed by this struct, when new websocket
@@ -27,7 +27,7 @@ type EventConsumer struct {
 	Requests   map[string]*SubscriptionRequest
 	Connection *websocket.Conn
 	PubSub     *redis.PubSub
-	DB         *gorm.DB
+	DB         db.Store
 	ConnLock   *sync.Mutex
 	TopicLock  *sync.RWMutex
 	Counter    *data.SendReceiveCounter
@@ -91,7 +91,7 @@ func (e *EventConsumer) Send(msg string) {
 	_msg := []byte(msg)
 
 	if err := json.Unmarshal(_msg, &event); err != nil {
-		log.Printf("[!] Failed to decode published event data to JSON : %s\n", err.Error())
+		log.WithError(err).Error("Failed to decode published event data to JSON")
 		return
 	}
 
@@ -103,7 +103,7 @@ func (e *EventConsumer) Send(msg string) {
 	}
 
 	if err != nil {
-		log.Printf("[!] Failed to decode data field of event : %s\n", err.Error())
+		log.WithError(err).Error("Failed to decode data field of event")
 		return
 	}
 
@@ -139,7 +139,7 @@ func (e *EventConsumer) Send(msg string) {
 		return
 	}
 
-	user := db.GetUserFromAPIKey(e.DB, request.APIKey)
+	user := e.DB.GetUserFromAPIKey(request.APIKey)
 	if user == nil {
 
 		// -- Critical section of code begins
@@ -152,7 +152,7 @@ func (e *EventConsumer) Send(msg string) {
 			Code:    0,
 			Message: "Bad API Key",
 		}); err != nil {
-			log.Printf("[!] Failed to deliver bad API key message to client : %s\n", err.Error())
+			log.WithError(err).Warn("Failed to deliver bad API key message to client")
 		}
 
 		e.ConnLock.Unlock()
@@ -176,7 +176,7 @@ func (e *EventConsumer) Send(msg string) {
 			Code:    0,
 			Message: "Bad API Key",
 		}); err != nil {
-			log.Printf("[!] Failed to deliver bad API key message to client : %s\n", err.Error())
+			log.WithError(err).Warn("Failed to deliver bad API key message to client")
 		}
 
 		e.ConnLock.Unlock()
@@ -190,7 +190,9 @@ func (e *EventConsumer) Send(msg string) {
 
 	// Don't deliver data & close underlying connection
 	// if client has crossed it's allowed data delivery limit
-	if !db.IsUnderRateLimit(e.DB, user.Address) {
+	if !e.DB.IsUnderRateLimit(user.Address) {
+
+		metrics.RateLimited("websocket")
 
 		// -- Critical section of code begins
 		//
@@ -202,7 +204,7 @@ func (e *EventConsumer) Send(msg string) {
 			Code:    0,
 			Message: "Crossed Allowed Rate Limit",
 		}); err != nil {
-			log.Printf("[!] Failed to deliver rate limit crossed message to client : %s\n", err.Error())
+			log.WithError(err).WithField("user", user.Address).Warn("Failed to deliver rate limit crossed message to client")
 		}
 
 		e.ConnLock.Unlock()
@@ -215,7 +217,8 @@ func (e *EventConsumer) Send(msg string) {
 	}
 
 	if e.SendData(&event) {
-		db.PutDataDeliveryInfo(e.DB, user.Address, "/v1/ws/event", uint64(len(msg)))
+		e.DB.PutDataDeliveryInfo(user.Address, "/v1/ws/event", uint64(len(msg)))
+		metrics.Delivered("websocket", "event")
 	}
 
 }
@@ -234,7 +237,7 @@ func (e *EventConsumer) SendData(data interface{}) bool {
 	defer e.ConnLock.Unlock()
 
 	if err := e.Connection.WriteJSON(data); err != nil {
-		log.Printf("[!] Failed to deliver `event` data to client : %s\n", err.Error())
+		log.WithError(err).Warn("Failed to deliver `event` data to client")
 		return false
 	}
 
@@ -251,12 +254,12 @@ func (e *EventConsumer) SendData(data interface{}) bool {
 func (e *EventConsumer) Unsubscribe() {
 
 	if e.PubSub == nil {
-		log.Printf("[!] Bad attempt to unsubscribe from `event` topic\n")
+		log.Warn("Bad attempt to unsubscribe from `event` topic")
 		return
 	}
 
 	if err := e.PubSub.Unsubscribe(context.Background(), "event"); err != nil {
-		log.Printf("[!] Failed to unsubscribe from `event` topic : %s\n", err.Error())
+		log.WithError(err).Error("Failed to unsubscribe from `event` topic")
 		return
 	}
 
@@ -274,7 +277,7 @@ func (e *EventConsumer) Unsubscribe() {
 
 	if err := e.Connection.WriteJSON(resp); err != nil {
 
-		log.Printf("[!] Failed to deliver `event` unsubscription confirmation to client : %s\n", err.Error())
+		log.WithError(err).Warn("Failed to deliver `event` unsubscription confirmation to client")
 		return
 
 	}
@@ -0,0 +1,62 @@
+package pubsub
+
+import (
+	"encoding/json"
+
+	"github.com/itzmeanjan/ette/app/data"
+	log "github.com/sirupsen/logrus"
+)
+
+// PublishedDataMatcher - Given topic i.e. {block, transaction, event} & data
+// published on it, returns function for checking whether subscription request
+// is interested in it, where nil is returned when data can't be decoded
+//
+// Published data is decoded only once, so that it can be matched against
+// any number of subscription requests i.e. by webhooks or SSE clients
+func PublishedDataMatcher(topic string, payload string) func(*SubscriptionRequest) bool {
+
+	switch topic {
+
+	case "block":
+
+		return func(s *SubscriptionRequest) bool {
+			return s.Topic() == topic
+		}
+
+	case "transaction":
+
+		var tx struct {
+			From string `json:"from"`
+			To   string `json:"to"`
+		}
+
+		if err := json.Unmarshal([]byte(payload), &tx); err != nil {
+			log.WithError(err).Error("Failed to decode published transaction data to JSON")
+			return nil
+		}
+
+		return func(s *SubscriptionRequest) bool {
+			return s.Topic() == topic && s.DoesMatchWithPublishedTransactionData(&data.Transaction{From: tx.From, To: tx.To})
+		}
+
+	case "event":
+
+		var event struct {
+			Origin string   `json:"origin"`
+			Topics []string `json:"topics"`
+		}
+
+		if err := json.Unmarshal([]byte(payload), &event); err != nil {
+			log.WithError(err).Error("Failed to decode published event data to JSON")
+			return nil
+		}
+
+		return func(s *SubscriptionRequest) bool {
+			return s.Topic() == topic && s.DoesMatchWithPublishedEventData(&data.Event{Origin: event.Origin, Topics: event.Topics})
+		}
+
+	}
+
+	return nil
+
+}
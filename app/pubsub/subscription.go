@@ -2,14 +2,14 @@ package pubsub
 
 import (
 	"fmt"
-	"log"
 	"regexp"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/itzmeanjan/ette/app/common"
 	"github.com/itzmeanjan/ette/app/data"
 	_db "github.com/itzmeanjan/ette/app/db"
-	"gorm.io/gorm"
+	log "github.com/sirupsen/logrus"
 )
 
 // SubscriptionRequest - Real time data subscription/ unsubscription request
@@ -22,26 +22,26 @@ type SubscriptionRequest struct {
 
 // GetUserFromAPIKey - Given API Key, which is being used for subscribing to
 // real-time topic, it returns if there exists any user who has signed creation of this API Key
-func (s *SubscriptionRequest) GetUserFromAPIKey(db *gorm.DB) *_db.Users {
+func (s *SubscriptionRequest) GetUserFromAPIKey(db _db.Store) *_db.Users {
 	if !(len(s.APIKey) == 66 && strings.HasPrefix(s.APIKey, "0x")) {
 		return nil
 	}
 
-	return _db.GetUserFromAPIKey(db, s.APIKey)
+	return db.GetUserFromAPIKey(s.APIKey)
 }
 
 // IsUnderRateLimit - Given API key along with realtime notification
 // subscription/ unsubscription request, validates API key, by checking
 // existence against database
-func (s *SubscriptionRequest) IsUnderRateLimit(db *gorm.DB, address common.Address) bool {
-	return _db.IsUnderRateLimit(db, address.Hex())
+func (s *SubscriptionRequest) IsUnderRateLimit(db _db.Store, address common.Address) bool {
+	return db.IsUnderRateLimit(address.Hex())
 }
 
 // GetRegex - Returns regex to be used for validating subscription request
 func (s *SubscriptionRequest) GetRegex() *regexp.Regexp {
-	pattern, err := regexp.Compile("^(block|(transaction(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{40}|\\*))?)?)|(event(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*)(/(0x[a-zA-Z0-9]{64}|\\*))?)?)?)?)?))$")
+	pattern, err := regexp.Compile("^(block|(transaction(/(0x[a-zA-Z0-9]{40}|\\*)(/(0x[a-zA-Z0-9]{40}|\\*))?)?)|(event(/(0x[a-zA-Z0-9]{40}(?:,0x[a-zA-Z0-9]{40})*|\\*)(/(0x[a-zA-Z0-9]{64}(?:,0x[a-zA-Z0-9]{64})*|\\*)(/(0x[a-zA-Z0-9]{64}(?:,0x[a-zA-Z0-9]{64})*|\\*)(/(0x[a-zA-Z0-9]{64}(?:,0x[a-zA-Z0-9]{64})*|\\*)(/(0x[a-zA-Z0-9]{64}(?:,0x[a-zA-Z0-9]{64})*|\\*))?)?)?)?)?))$")
 	if err != nil {
-		log.Printf("[!] Failed to parse regex pattern : %s\n", err.Error())
+		log.WithError(err).Error("Failed to parse regex pattern")
 		return nil
 	}
 
@@ -74,6 +74,8 @@ func (s *SubscriptionRequest) Topic() string {
 //
 // address : Contract address
 // topic{0,1,2,3} : topic signature
+//
+// Each of them can be comma separated list, any of which is to be matched
 func (s *SubscriptionRequest) GetLogEventFilters() []string {
 	pattern := s.GetRegex()
 	if pattern == nil {
@@ -94,53 +96,46 @@ func (s *SubscriptionRequest) GetLogEventFilters() []string {
 // All this function does, is checking whether it satisfies those criterias or not
 func (s *SubscriptionRequest) DoesMatchWithPublishedEventData(event *data.Event) bool {
 
-	// --- Matching specific topic signature provided by client
-	// application with received event data, published by
-	// redis pub-sub
-	matchTopicXInEvent := func(topic string, x uint8) bool {
-		// Not all topics will have 4 elements in topics array
-		//
-		// For those cases, if topic signature for that index is {"", "*"}
-		// provided by consumer, then we're safely going to say it's a match
-		if !(int(x) < len(event.Topics)) {
-			return topic == "" || topic == "*"
-		}
+	filter := s.GetEventFilter()
+	if filter == nil {
+		return false
+	}
 
-		status := false
+	return filter.Matches(event)
 
-		switch topic {
-		// match with any `topic` signature
-		case "", "*":
-			status = true
-		// match with specific `topic` signature
-		default:
-			status = CheckSimilarity(topic, event.Topics[x])
-		}
+}
 
-		return status
-	}
-	// ---
+// GetEventFilter - Builds event filter from contract addresses & topic signatures
+// present in subscription request, same as one used for querying historical
+// events, where {"", "*"} matches anything
+func (s *SubscriptionRequest) GetEventFilter() *data.EventFilter {
 
 	// Fetches desired filter values, against which matching to be performed
 	// for published log event data
 	filters := s.GetLogEventFilters()
 	if filters == nil {
-		return false
+		return nil
 	}
-	status := false
 
-	switch filters[0] {
-	// match with any `contract` address
-	case "", "*":
-		status = matchTopicXInEvent(filters[1], 0) && matchTopicXInEvent(filters[2], 1) && matchTopicXInEvent(filters[3], 2) && matchTopicXInEvent(filters[4], 3)
-	// match with provided `contract` address
-	default:
-		if CheckSimilarity(filters[0], event.Origin) {
-			status = matchTopicXInEvent(filters[1], 0) && matchTopicXInEvent(filters[2], 1) && matchTopicXInEvent(filters[3], 2) && matchTopicXInEvent(filters[4], 3)
+	set := func(v string) []string {
+		if v == "" || v == "*" {
+			return nil
 		}
+
+		return strings.Split(v, ",")
 	}
 
-	return status
+	topics := make([][]string, 0, 4)
+	for _, v := range filters[1:] {
+		topics = append(topics, set(v))
+	}
+
+	filter, err := cmn.ParseEventFilter(set(filters[0]), topics)
+	if err != nil {
+		return nil
+	}
+
+	return filter
 
 }
 
@@ -163,7 +158,7 @@ func CheckSimilarity(first string, second string) bool {
 
 	reg, err := regexp.Compile(fmt.Sprintf("(?i)^(%s)$", first))
 	if err != nil {
-		log.Printf("[!] Failed to parse regex pattern : %s\n", err.Error())
+		log.WithError(err).Error("Failed to parse regex pattern")
 		return false
 	}
 
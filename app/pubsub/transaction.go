@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
-	"log"
 	"sync"
 	"time"
 
@@ -13,7 +12,8 @@ import (
 	"github.com/itzmeanjan/ette/app/data"
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
-	"gorm.io/gorm"
+	"github.com/itzmeanjan/ette/app/metrics"
+	log "github.com/sirupsen/logrus"
 )
 
 // TransactionConsumer - Transaction consumer info holder struct, to be used
@@ -26,7 +26,7 @@ type TransactionConsumer struct {
 	Requests   map[string]*SubscriptionRequest
 	Connection *websocket.Conn
 	PubSub     *redis.PubSub
-	DB         *gorm.DB
+	DB         db.Store
 	ConnLock   *sync.Mutex
 	TopicLock  *sync.RWMutex
 	Counter    *data.SendReceiveCounter
@@ -100,7 +100,7 @@ func (t *TransactionConsumer) Send(msg string) {
 	_msg := []byte(msg)
 
 	if err := json.Unmarshal(_msg, &transaction); err != nil {
-		log.Printf("[!] Failed to decode published transaction data to JSON : %s\n", err.Error())
+		log.WithError(err).Error("Failed to decode published transaction data to JSON")
 		return
 	}
 
@@ -115,7 +115,7 @@ func (t *TransactionConsumer) Send(msg string) {
 	}
 
 	if err != nil {
-		log.Printf("[!] Failed to decode data field of transaction : %s\n", err.Error())
+		log.WithError(err).Error("Failed to decode data field of transaction")
 		return
 	}
 
@@ -124,11 +124,11 @@ func (t *TransactionConsumer) Send(msg string) {
 		From:      transaction.From,
 		To:        transaction.To,
 		Contract:  transaction.Contract,
-		Value:     transaction.Value,
+		Value:     d.Numeric(transaction.Value),
 		Data:      data,
 		Gas:       transaction.Gas,
-		GasPrice:  transaction.GasPrice,
-		Cost:      transaction.Cost,
+		GasPrice:  d.Numeric(transaction.GasPrice),
+		Cost:      d.Numeric(transaction.Cost),
 		Nonce:     transaction.Nonce,
 		State:     transaction.State,
 		BlockHash: transaction.BlockHash,
@@ -158,7 +158,7 @@ func (t *TransactionConsumer) Send(msg string) {
 		return
 	}
 
-	user := db.GetUserFromAPIKey(t.DB, request.APIKey)
+	user := t.DB.GetUserFromAPIKey(request.APIKey)
 	if user == nil {
 
 		// -- Critical section of code begins
@@ -171,7 +171,7 @@ func (t *TransactionConsumer) Send(msg string) {
 			Code:    0,
 			Message: "Bad API Key",
 		}); err != nil {
-			log.Printf("[!] Failed to deliver bad API key message to client : %s\n", err.Error())
+			log.WithError(err).Warn("Failed to deliver bad API key message to client")
 		}
 
 		t.ConnLock.Unlock()
@@ -195,7 +195,7 @@ func (t *TransactionConsumer) Send(msg string) {
 			Code:    0,
 			Message: "Bad API Key",
 		}); err != nil {
-			log.Printf("[!] Failed to deliver bad API key message to client : %s\n", err.Error())
+			log.WithError(err).Warn("Failed to deliver bad API key message to client")
 		}
 
 		t.ConnLock.Unlock()
@@ -209,7 +209,9 @@ func (t *TransactionConsumer) Send(msg string) {
 
 	// Don't deliver data & close underlying connection
 	// if client has crossed it's allowed data delivery limit
-	if !db.IsUnderRateLimit(t.DB, user.Address) {
+	if !t.DB.IsUnderRateLimit(user.Address) {
+
+		metrics.RateLimited("websocket")
 
 		// -- Critical section of code begins
 		//
@@ -221,7 +223,7 @@ func (t *TransactionConsumer) Send(msg string) {
 			Code:    0,
 			Message: "Crossed Allowed Rate Limit",
 		}); err != nil {
-			log.Printf("[!] Failed to deliver rate limit crossed message to client : %s\n", err.Error())
+			log.WithError(err).WithField("user", user.Address).Warn("Failed to deliver rate limit crossed message to client")
 		}
 
 		t.ConnLock.Unlock()
@@ -234,7 +236,8 @@ func (t *TransactionConsumer) Send(msg string) {
 	}
 
 	if t.SendData(&transaction) {
-		db.PutDataDeliveryInfo(t.DB, user.Address, "/v1/ws/transaction", uint64(len(msg)))
+		t.DB.PutDataDeliveryInfo(user.Address, "/v1/ws/transaction", uint64(len(msg)))
+		metrics.Delivered("websocket", "transaction")
 	}
 
 }
@@ -253,7 +256,7 @@ func (t *TransactionConsumer) SendData(data interface{}) bool {
 	defer t.ConnLock.Unlock()
 
 	if err := t.Connection.WriteJSON(data); err != nil {
-		log.Printf("[!] Failed to deliver `transaction` data to client : %s\n", err.Error())
+		log.WithError(err).Warn("Failed to deliver `transaction` data to client")
 		return false
 	}
 
@@ -268,12 +271,12 @@ func (t *TransactionConsumer) SendData(data interface{}) bool {
 func (t *TransactionConsumer) Unsubscribe() {
 
 	if t.PubSub == nil {
-		log.Printf("[!] Bad attempt to unsubscribe from `transaction` topic\n")
+		log.Warn("Bad attempt to unsubscribe from `transaction` topic")
 		return
 	}
 
 	if err := t.PubSub.Unsubscribe(context.Background(), "transaction"); err != nil {
-		log.Printf("[!] Failed to unsubscribe from `transaction` topic : %s\n", err.Error())
+		log.WithError(err).Error("Failed to unsubscribe from `transaction` topic")
 		return
 	}
 
@@ -291,7 +294,7 @@ func (t *TransactionConsumer) Unsubscribe() {
 
 	if err := t.Connection.WriteJSON(resp); err != nil {
 
-		log.Printf("[!] Failed to deliver `transaction` unsubscription confirmation to client : %s\n", err.Error())
+		log.WithError(err).Warn("Failed to deliver `transaction` unsubscription confirmation to client")
 		return
 
 	}
@@ -0,0 +1,60 @@
+package app
+
+import (
+	cfg "github.com/itzmeanjan/ette/app/config"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/logger"
+	log "github.com/sirupsen/logrus"
+)
+
+// reload - Re-reads subscription plans from `.plans.json` & reloadable part
+// of configuration, applying them while `ette` keeps running, so that none of
+// connected websocket clients get dropped
+//
+// Plans file is read only once & those plans are committed to database before
+// fresh configuration is made active, so either both get applied or none
+//
+// Returns list of changes applied, which is also logged
+func reload(_db db.Store, configFile, subscriptionPlansFile string) ([]string, error) {
+
+	plans, err := db.ReadSubscriptionPlans(subscriptionPlansFile)
+	if err != nil {
+		log.WithError(err).Error("Failed to read subscription plans")
+		return nil, err
+	}
+
+	var planChanges []string
+
+	applied, ignored, err := cfg.Reload(configFile, func() error {
+		changes, err := _db.ReloadSubscriptionPlans(plans)
+		if err != nil {
+			return err
+		}
+
+		planChanges = changes
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to reload")
+		return nil, err
+	}
+
+	logger.SetLevel()
+
+	for _, v := range ignored {
+		log.WithField("change", v).Warn("Ignored configuration change, requires restart")
+	}
+
+	changes := append(applied, planChanges...)
+	if len(changes) == 0 {
+		log.Info("Reloaded, nothing changed")
+		return changes, nil
+	}
+
+	for _, v := range changes {
+		log.WithField("change", v).Info("Reloaded")
+	}
+
+	return changes, nil
+
+}
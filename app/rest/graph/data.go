@@ -5,22 +5,24 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
+	cmn "github.com/itzmeanjan/ette/app/common"
+	cfg "github.com/itzmeanjan/ette/app/config"
 	"github.com/itzmeanjan/ette/app/data"
 	_db "github.com/itzmeanjan/ette/app/db"
 	"github.com/itzmeanjan/ette/app/rest/graph/model"
 	"github.com/lib/pq"
-	"gorm.io/gorm"
+	log "github.com/sirupsen/logrus"
 )
 
-var db *gorm.DB
+var db _db.Store
 
 // GetDatabaseConnection - Passing already connected database handle to this package,
 // so that it can be used for handling database queries for resolving graphQL queries
-func GetDatabaseConnection(conn *gorm.DB) {
+func GetDatabaseConnection(conn _db.Store) {
 	db = conn
 }
 
@@ -50,7 +52,7 @@ func getAPIKey(ctx context.Context) string {
 	routerCtx, err := routerContextFromGraphQLContext(ctx)
 	if err != nil {
 
-		log.Printf("[!] Failed to get `APIKey` : %s\n", err.Error())
+		log.WithError(err).Error("Failed to get `APIKey`")
 		return ""
 
 	}
@@ -68,12 +70,12 @@ func doBookKeeping(ctx context.Context, _data []byte) error {
 		return errors.New("JSON marshalling failed")
 	}
 
-	user := _db.GetUserFromAPIKey(db, getAPIKey(ctx))
+	user := db.GetUserFromAPIKey(getAPIKey(ctx))
 	if user == nil {
 		return errors.New("Failed to get user from `APIKey`")
 	}
 
-	_db.PutDataDeliveryInfo(db, user.Address, "/v1/graphql", uint64(len(_data)))
+	db.PutDataDeliveryInfo(user.Address, "/v1/graphql", uint64(len(_data)))
 	return nil
 
 }
@@ -103,7 +105,7 @@ func getGraphQLCompatibleBlock(ctx context.Context, block *data.Block, bookKeepi
 		Number:          fmt.Sprintf("%d", block.Number),
 		Time:            fmt.Sprintf("%d", block.Time),
 		ParentHash:      block.ParentHash,
-		Difficulty:      block.Difficulty,
+		Difficulty:      string(block.Difficulty),
 		GasUsed:         fmt.Sprintf("%d", block.GasUsed),
 		GasLimit:        fmt.Sprintf("%d", block.GasLimit),
 		Nonce:           block.Nonce,
@@ -114,6 +116,7 @@ func getGraphQLCompatibleBlock(ctx context.Context, block *data.Block, bookKeepi
 		TxRootHash:      block.TransactionRootHash,
 		ReceiptRootHash: block.ReceiptRootHash,
 		ExtraData:       extraData,
+		Cursor:          block.Cursor().String(),
 	}, nil
 
 }
@@ -167,14 +170,15 @@ func getGraphQLCompatibleTransaction(ctx context.Context, tx *data.Transaction,
 			From:      tx.From,
 			To:        tx.To,
 			Contract:  "",
-			Value:     tx.Value,
+			Value:     string(tx.Value),
 			Data:      data,
 			Gas:       fmt.Sprintf("%d", tx.Gas),
-			GasPrice:  tx.GasPrice,
-			Cost:      tx.Cost,
+			GasPrice:  string(tx.GasPrice),
+			Cost:      string(tx.Cost),
 			Nonce:     fmt.Sprintf("%d", tx.Nonce),
 			State:     fmt.Sprintf("%d", tx.State),
 			BlockHash: tx.BlockHash,
+			Cursor:    tx.Cursor().String(),
 		}, nil
 	}
 
@@ -183,14 +187,15 @@ func getGraphQLCompatibleTransaction(ctx context.Context, tx *data.Transaction,
 		From:      tx.From,
 		To:        "",
 		Contract:  tx.Contract,
-		Value:     tx.Value,
+		Value:     string(tx.Value),
 		Data:      data,
 		Gas:       fmt.Sprintf("%d", tx.Gas),
-		GasPrice:  tx.GasPrice,
-		Cost:      tx.Cost,
+		GasPrice:  string(tx.GasPrice),
+		Cost:      string(tx.Cost),
 		Nonce:     fmt.Sprintf("%d", tx.Nonce),
 		State:     fmt.Sprintf("%d", tx.State),
 		BlockHash: tx.BlockHash,
+		Cursor:    tx.Cursor().String(),
 	}, nil
 }
 
@@ -218,6 +223,165 @@ func getGraphQLCompatibleTransactions(ctx context.Context, tx *data.Transactions
 	return _tx, nil
 }
 
+// Building tx filter from optional graphQL query arguments, where
+// values are in wei
+func buildTransactionFilter(fromAccount *string, toAccount *string, minValue *string, maxValue *string) (*data.TransactionFilter, error) {
+	filter := &data.TransactionFilter{}
+
+	if fromAccount != nil {
+		if !(strings.HasPrefix(*fromAccount, "0x") && len(*fromAccount) == 42) {
+			return nil, errors.New("Bad From Account Address")
+		}
+
+		_account := common.HexToAddress(*fromAccount)
+		filter.From = &_account
+	}
+
+	if toAccount != nil {
+		if !(strings.HasPrefix(*toAccount, "0x") && len(*toAccount) == 42) {
+			return nil, errors.New("Bad To Account Address")
+		}
+
+		_account := common.HexToAddress(*toAccount)
+		filter.To = &_account
+	}
+
+	if minValue != nil {
+		_value, err := cmn.ParseValue(*minValue)
+		if err != nil {
+			return nil, errors.New("Bad Min Value")
+		}
+
+		filter.MinValue = _value
+	}
+
+	if maxValue != nil {
+		_value, err := cmn.ParseValue(*maxValue)
+		if err != nil {
+			return nil, errors.New("Bad Max Value")
+		}
+
+		filter.MaxValue = _value
+	}
+
+	return filter, nil
+}
+
+// Building page from optional graphQL query arguments, which is nil
+// when results aren't to be paginated
+func buildPage(limit *int, cursor *string) (*data.Page, error) {
+	_limit, _cursor := "", ""
+
+	if limit != nil {
+		if *limit <= 0 {
+			return nil, errors.New("Bad Page Size")
+		}
+
+		_limit = fmt.Sprintf("%d", *limit)
+	}
+
+	if cursor != nil {
+		_cursor = *cursor
+	}
+
+	page, err := cmn.ParsePage(_limit, _cursor, cfg.GetMaxPageSize())
+	if err != nil {
+		return nil, errors.New("Bad Page Size Or Cursor")
+	}
+
+	return page, nil
+}
+
+// Converting tx aggregate to graphQL compatible data structure
+func getGraphQLCompatibleTransactionAggregate(ctx context.Context, aggregate *data.TransactionAggregate) (*model.TransactionAggregate, error) {
+	if aggregate == nil {
+		return nil, errors.New("Failed to aggregate")
+	}
+
+	if err := doBookKeeping(ctx, aggregate.ToJSON()); err != nil {
+		return nil, errors.New("Book keeping failed")
+	}
+
+	return &model.TransactionAggregate{
+		Count:        int(aggregate.Count),
+		TotalValue:   string(aggregate.TotalValue),
+		AverageValue: string(aggregate.AverageValue),
+	}, nil
+}
+
+// Converting account summary to graphQL compatible data structure
+func getGraphQLCompatibleAccount(ctx context.Context, account *data.Account) (*model.Account, error) {
+	if account == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	if err := doBookKeeping(ctx, account.ToJSON()); err != nil {
+		return nil, errors.New("Book keeping failed")
+	}
+
+	// Optional numbers are kept nil, when absent
+	optional := func(v *uint64) *string {
+		if v == nil {
+			return nil
+		}
+
+		_v := fmt.Sprintf("%d", *v)
+		return &_v
+	}
+
+	return &model.Account{
+		Address:   account.Address,
+		FirstSeen: fmt.Sprintf("%d", account.FirstSeen),
+		LastSeen:  fmt.Sprintf("%d", account.LastSeen),
+		Sent:      fmt.Sprintf("%d", account.Sent),
+		Received:  fmt.Sprintf("%d", account.Received),
+		Deployed:  fmt.Sprintf("%d", account.Deployed),
+		Nonce:     optional(account.Nonce),
+		Contract:  account.Contract,
+		CreatedAt: optional(account.CreatedAt),
+		Emitted:   optional(account.Emitted),
+	}, nil
+}
+
+// Converting chain stats rollups to graphQL compatible data structure
+func getGraphQLCompatibleChainStats(ctx context.Context, stats *data.ChainStats) ([]*model.ChainStat, error) {
+	if stats == nil {
+		return nil, errors.New("Found nothing")
+	}
+
+	if !(len(stats.Stats) > 0) {
+		return nil, errors.New("Found nothing")
+	}
+
+	if err := doBookKeeping(ctx, stats.ToJSON()); err != nil {
+		return nil, errors.New("Book keeping failed")
+	}
+
+	_stats := make([]*model.ChainStat, len(stats.Stats))
+
+	for k, v := range stats.Stats {
+		var averageBaseFee *string
+		if v.AverageBaseFee != "" {
+			_v := string(v.AverageBaseFee)
+			averageBaseFee = &_v
+		}
+
+		_stats[k] = &model.ChainStat{
+			Time:            fmt.Sprintf("%d", v.Time),
+			Blocks:          fmt.Sprintf("%d", v.Blocks),
+			Transactions:    fmt.Sprintf("%d", v.Transactions),
+			GasUsed:         fmt.Sprintf("%d", v.GasUsed),
+			AverageGasPrice: string(v.AverageGasPrice),
+			AverageBaseFee:  averageBaseFee,
+			ActiveSenders:   fmt.Sprintf("%d", v.ActiveSenders),
+			Contracts:       fmt.Sprintf("%d", v.Contracts),
+			Events:          fmt.Sprintf("%d", v.Events),
+		}
+	}
+
+	return _stats, nil
+}
+
 // Converting event data to graphQL compatible data structure
 func getGraphQLCompatibleEvent(ctx context.Context, event *data.Event, bookKeeping bool) (*model.Event, error) {
 	if event == nil {
@@ -244,6 +408,7 @@ func getGraphQLCompatibleEvent(ctx context.Context, event *data.Event, bookKeepi
 		Data:      data,
 		TxHash:    event.TransactionHash,
 		BlockHash: event.BlockHash,
+		Cursor:    event.Cursor().String(),
 	}, nil
 }
 
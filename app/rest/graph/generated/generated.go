@@ -42,7 +42,21 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	Account struct {
+		Address   func(childComplexity int) int
+		Contract  func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Deployed  func(childComplexity int) int
+		Emitted   func(childComplexity int) int
+		FirstSeen func(childComplexity int) int
+		LastSeen  func(childComplexity int) int
+		Nonce     func(childComplexity int) int
+		Received  func(childComplexity int) int
+		Sent      func(childComplexity int) int
+	}
+
 	Block struct {
+		Cursor          func(childComplexity int) int
 		Difficulty      func(childComplexity int) int
 		ExtraData       func(childComplexity int) int
 		GasLimit        func(childComplexity int) int
@@ -60,8 +74,21 @@ type ComplexityRoot struct {
 		UncleHash       func(childComplexity int) int
 	}
 
+	ChainStat struct {
+		ActiveSenders   func(childComplexity int) int
+		AverageBaseFee  func(childComplexity int) int
+		AverageGasPrice func(childComplexity int) int
+		Blocks          func(childComplexity int) int
+		Contracts       func(childComplexity int) int
+		Events          func(childComplexity int) int
+		GasUsed         func(childComplexity int) int
+		Time            func(childComplexity int) int
+		Transactions    func(childComplexity int) int
+	}
+
 	Event struct {
 		BlockHash func(childComplexity int) int
+		Cursor    func(childComplexity int) int
 		Data      func(childComplexity int) int
 		Index     func(childComplexity int) int
 		Origin    func(childComplexity int) int
@@ -70,22 +97,29 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
+		Account                                      func(childComplexity int, address string) int
 		BlockByHash                                  func(childComplexity int, hash string) int
 		BlockByNumber                                func(childComplexity int, number string) int
-		BlocksByNumberRange                          func(childComplexity int, from string, to string) int
-		BlocksByTimeRange                            func(childComplexity int, from string, to string) int
-		ContractsCreatedFromAccountByNumberRange     func(childComplexity int, account string, from string, to string) int
-		ContractsCreatedFromAccountByTimeRange       func(childComplexity int, account string, from string, to string) int
+		BlockByTime                                  func(childComplexity int, at string, direction *string) int
+		BlocksByNumberRange                          func(childComplexity int, from string, to string, limit *int, cursor *string) int
+		BlocksByTimeRange                            func(childComplexity int, from string, to string, limit *int, cursor *string) int
+		ChainStats                                   func(childComplexity int, interval string, from string, to string) int
+		ContractsCreatedFromAccountByNumberRange     func(childComplexity int, account string, from string, to string, limit *int, cursor *string) int
+		ContractsCreatedFromAccountByTimeRange       func(childComplexity int, account string, from string, to string, limit *int, cursor *string) int
 		EventByBlockHashAndLogIndex                  func(childComplexity int, hash string, index string) int
 		EventByBlockNumberAndLogIndex                func(childComplexity int, number string, index string) int
-		EventsByBlockHash                            func(childComplexity int, hash string) int
-		EventsByTxHash                               func(childComplexity int, hash string) int
-		EventsFromContractByNumberRange              func(childComplexity int, contract string, from string, to string) int
-		EventsFromContractByTimeRange                func(childComplexity int, contract string, from string, to string) int
-		EventsFromContractWithTopicsByNumberRange    func(childComplexity int, contract string, from string, to string, topics []string) int
-		EventsFromContractWithTopicsByTimeRange      func(childComplexity int, contract string, from string, to string, topics []string) int
+		EventsByBlockHash                            func(childComplexity int, hash string, limit *int, cursor *string) int
+		EventsByNumberRange                          func(childComplexity int, from string, to string, contracts []string, topics [][]string, limit *int, cursor *string) int
+		EventsByTimeRange                            func(childComplexity int, from string, to string, contracts []string, topics [][]string, limit *int, cursor *string) int
+		EventsByTxHash                               func(childComplexity int, hash string, limit *int, cursor *string) int
+		EventsFromContractByNumberRange              func(childComplexity int, contract string, from string, to string, limit *int, cursor *string) int
+		EventsFromContractByTimeRange                func(childComplexity int, contract string, from string, to string, limit *int, cursor *string) int
+		EventsFromContractWithTopicsByNumberRange    func(childComplexity int, contract string, from string, to string, topics []string, limit *int, cursor *string) int
+		EventsFromContractWithTopicsByTimeRange      func(childComplexity int, contract string, from string, to string, topics []string, limit *int, cursor *string) int
 		LastXEventsFromContract                      func(childComplexity int, contract string, x int) int
 		Transaction                                  func(childComplexity int, hash string) int
+		TransactionAggregateByNumberRange            func(childComplexity int, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string) int
+		TransactionAggregateByTimeRange              func(childComplexity int, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string) int
 		TransactionCountBetweenAccountsByNumberRange func(childComplexity int, fromAccount string, toAccount string, from string, to string) int
 		TransactionCountBetweenAccountsByTimeRange   func(childComplexity int, fromAccount string, toAccount string, from string, to string) int
 		TransactionCountByBlockHash                  func(childComplexity int, hash string) int
@@ -95,20 +129,23 @@ type ComplexityRoot struct {
 		TransactionCountToAccountByNumberRange       func(childComplexity int, account string, from string, to string) int
 		TransactionCountToAccountByTimeRange         func(childComplexity int, account string, from string, to string) int
 		TransactionFromAccountWithNonce              func(childComplexity int, account string, nonce string) int
-		TransactionsBetweenAccountsByNumberRange     func(childComplexity int, fromAccount string, toAccount string, from string, to string) int
-		TransactionsBetweenAccountsByTimeRange       func(childComplexity int, fromAccount string, toAccount string, from string, to string) int
-		TransactionsByBlockHash                      func(childComplexity int, hash string) int
-		TransactionsByBlockNumber                    func(childComplexity int, number string) int
-		TransactionsFromAccountByNumberRange         func(childComplexity int, account string, from string, to string) int
-		TransactionsFromAccountByTimeRange           func(childComplexity int, account string, from string, to string) int
-		TransactionsToAccountByNumberRange           func(childComplexity int, account string, from string, to string) int
-		TransactionsToAccountByTimeRange             func(childComplexity int, account string, from string, to string) int
+		TransactionsBetweenAccountsByNumberRange     func(childComplexity int, fromAccount string, toAccount string, from string, to string, limit *int, cursor *string) int
+		TransactionsBetweenAccountsByTimeRange       func(childComplexity int, fromAccount string, toAccount string, from string, to string, limit *int, cursor *string) int
+		TransactionsByBlockHash                      func(childComplexity int, hash string, limit *int, cursor *string) int
+		TransactionsByBlockNumber                    func(childComplexity int, number string, limit *int, cursor *string) int
+		TransactionsByNumberRange                    func(childComplexity int, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string, limit *int, cursor *string) int
+		TransactionsByTimeRange                      func(childComplexity int, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string, limit *int, cursor *string) int
+		TransactionsFromAccountByNumberRange         func(childComplexity int, account string, from string, to string, limit *int, cursor *string) int
+		TransactionsFromAccountByTimeRange           func(childComplexity int, account string, from string, to string, limit *int, cursor *string) int
+		TransactionsToAccountByNumberRange           func(childComplexity int, account string, from string, to string, limit *int, cursor *string) int
+		TransactionsToAccountByTimeRange             func(childComplexity int, account string, from string, to string, limit *int, cursor *string) int
 	}
 
 	Transaction struct {
 		BlockHash func(childComplexity int) int
 		Contract  func(childComplexity int) int
 		Cost      func(childComplexity int) int
+		Cursor    func(childComplexity int) int
 		Data      func(childComplexity int) int
 		From      func(childComplexity int) int
 		Gas       func(childComplexity int) int
@@ -119,42 +156,57 @@ type ComplexityRoot struct {
 		To        func(childComplexity int) int
 		Value     func(childComplexity int) int
 	}
+
+	TransactionAggregate struct {
+		AverageValue func(childComplexity int) int
+		Count        func(childComplexity int) int
+		TotalValue   func(childComplexity int) int
+	}
 }
 
 type QueryResolver interface {
 	BlockByHash(ctx context.Context, hash string) (*model.Block, error)
 	BlockByNumber(ctx context.Context, number string) (*model.Block, error)
-	BlocksByNumberRange(ctx context.Context, from string, to string) ([]*model.Block, error)
-	BlocksByTimeRange(ctx context.Context, from string, to string) ([]*model.Block, error)
+	BlocksByNumberRange(ctx context.Context, from string, to string, limit *int, cursor *string) ([]*model.Block, error)
+	BlocksByTimeRange(ctx context.Context, from string, to string, limit *int, cursor *string) ([]*model.Block, error)
+	BlockByTime(ctx context.Context, at string, direction *string) (*model.Block, error)
 	Transaction(ctx context.Context, hash string) (*model.Transaction, error)
 	TransactionCountByBlockHash(ctx context.Context, hash string) (int, error)
-	TransactionsByBlockHash(ctx context.Context, hash string) ([]*model.Transaction, error)
+	TransactionsByBlockHash(ctx context.Context, hash string, limit *int, cursor *string) ([]*model.Transaction, error)
 	TransactionCountByBlockNumber(ctx context.Context, number string) (int, error)
-	TransactionsByBlockNumber(ctx context.Context, number string) ([]*model.Transaction, error)
+	TransactionsByBlockNumber(ctx context.Context, number string, limit *int, cursor *string) ([]*model.Transaction, error)
 	TransactionCountFromAccountByNumberRange(ctx context.Context, account string, from string, to string) (int, error)
-	TransactionsFromAccountByNumberRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error)
+	TransactionsFromAccountByNumberRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error)
 	TransactionCountFromAccountByTimeRange(ctx context.Context, account string, from string, to string) (int, error)
-	TransactionsFromAccountByTimeRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error)
+	TransactionsFromAccountByTimeRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error)
 	TransactionCountToAccountByNumberRange(ctx context.Context, account string, from string, to string) (int, error)
-	TransactionsToAccountByNumberRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error)
+	TransactionsToAccountByNumberRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error)
 	TransactionCountToAccountByTimeRange(ctx context.Context, account string, from string, to string) (int, error)
-	TransactionsToAccountByTimeRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error)
+	TransactionsToAccountByTimeRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error)
 	TransactionCountBetweenAccountsByNumberRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) (int, error)
-	TransactionsBetweenAccountsByNumberRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) ([]*model.Transaction, error)
+	TransactionsBetweenAccountsByNumberRange(ctx context.Context, fromAccount string, toAccount string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error)
 	TransactionCountBetweenAccountsByTimeRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) (int, error)
-	TransactionsBetweenAccountsByTimeRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) ([]*model.Transaction, error)
-	ContractsCreatedFromAccountByNumberRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error)
-	ContractsCreatedFromAccountByTimeRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error)
+	TransactionsBetweenAccountsByTimeRange(ctx context.Context, fromAccount string, toAccount string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error)
+	ContractsCreatedFromAccountByNumberRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error)
+	ContractsCreatedFromAccountByTimeRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error)
 	TransactionFromAccountWithNonce(ctx context.Context, account string, nonce string) (*model.Transaction, error)
-	EventsFromContractByNumberRange(ctx context.Context, contract string, from string, to string) ([]*model.Event, error)
-	EventsFromContractByTimeRange(ctx context.Context, contract string, from string, to string) ([]*model.Event, error)
-	EventsByBlockHash(ctx context.Context, hash string) ([]*model.Event, error)
-	EventsByTxHash(ctx context.Context, hash string) ([]*model.Event, error)
-	EventsFromContractWithTopicsByNumberRange(ctx context.Context, contract string, from string, to string, topics []string) ([]*model.Event, error)
-	EventsFromContractWithTopicsByTimeRange(ctx context.Context, contract string, from string, to string, topics []string) ([]*model.Event, error)
+	TransactionsByNumberRange(ctx context.Context, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string, limit *int, cursor *string) ([]*model.Transaction, error)
+	TransactionsByTimeRange(ctx context.Context, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string, limit *int, cursor *string) ([]*model.Transaction, error)
+	TransactionAggregateByNumberRange(ctx context.Context, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string) (*model.TransactionAggregate, error)
+	TransactionAggregateByTimeRange(ctx context.Context, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string) (*model.TransactionAggregate, error)
+	EventsFromContractByNumberRange(ctx context.Context, contract string, from string, to string, limit *int, cursor *string) ([]*model.Event, error)
+	EventsFromContractByTimeRange(ctx context.Context, contract string, from string, to string, limit *int, cursor *string) ([]*model.Event, error)
+	EventsByBlockHash(ctx context.Context, hash string, limit *int, cursor *string) ([]*model.Event, error)
+	EventsByTxHash(ctx context.Context, hash string, limit *int, cursor *string) ([]*model.Event, error)
+	EventsFromContractWithTopicsByNumberRange(ctx context.Context, contract string, from string, to string, topics []string, limit *int, cursor *string) ([]*model.Event, error)
+	EventsFromContractWithTopicsByTimeRange(ctx context.Context, contract string, from string, to string, topics []string, limit *int, cursor *string) ([]*model.Event, error)
+	EventsByNumberRange(ctx context.Context, from string, to string, contracts []string, topics [][]string, limit *int, cursor *string) ([]*model.Event, error)
+	EventsByTimeRange(ctx context.Context, from string, to string, contracts []string, topics [][]string, limit *int, cursor *string) ([]*model.Event, error)
 	LastXEventsFromContract(ctx context.Context, contract string, x int) ([]*model.Event, error)
 	EventByBlockHashAndLogIndex(ctx context.Context, hash string, index string) (*model.Event, error)
 	EventByBlockNumberAndLogIndex(ctx context.Context, number string, index string) (*model.Event, error)
+	Account(ctx context.Context, address string) (*model.Account, error)
+	ChainStats(ctx context.Context, interval string, from string, to string) ([]*model.ChainStat, error)
 }
 
 type executableSchema struct {
@@ -172,6 +224,83 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "Account.address":
+		if e.complexity.Account.Address == nil {
+			break
+		}
+
+		return e.complexity.Account.Address(childComplexity), true
+
+	case "Account.contract":
+		if e.complexity.Account.Contract == nil {
+			break
+		}
+
+		return e.complexity.Account.Contract(childComplexity), true
+
+	case "Account.createdAt":
+		if e.complexity.Account.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Account.CreatedAt(childComplexity), true
+
+	case "Account.deployed":
+		if e.complexity.Account.Deployed == nil {
+			break
+		}
+
+		return e.complexity.Account.Deployed(childComplexity), true
+
+	case "Account.emitted":
+		if e.complexity.Account.Emitted == nil {
+			break
+		}
+
+		return e.complexity.Account.Emitted(childComplexity), true
+
+	case "Account.firstSeen":
+		if e.complexity.Account.FirstSeen == nil {
+			break
+		}
+
+		return e.complexity.Account.FirstSeen(childComplexity), true
+
+	case "Account.lastSeen":
+		if e.complexity.Account.LastSeen == nil {
+			break
+		}
+
+		return e.complexity.Account.LastSeen(childComplexity), true
+
+	case "Account.nonce":
+		if e.complexity.Account.Nonce == nil {
+			break
+		}
+
+		return e.complexity.Account.Nonce(childComplexity), true
+
+	case "Account.received":
+		if e.complexity.Account.Received == nil {
+			break
+		}
+
+		return e.complexity.Account.Received(childComplexity), true
+
+	case "Account.sent":
+		if e.complexity.Account.Sent == nil {
+			break
+		}
+
+		return e.complexity.Account.Sent(childComplexity), true
+
+	case "Block.cursor":
+		if e.complexity.Block.Cursor == nil {
+			break
+		}
+
+		return e.complexity.Block.Cursor(childComplexity), true
+
 	case "Block.difficulty":
 		if e.complexity.Block.Difficulty == nil {
 			break
@@ -277,6 +406,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Block.UncleHash(childComplexity), true
 
+	case "ChainStat.activeSenders":
+		if e.complexity.ChainStat.ActiveSenders == nil {
+			break
+		}
+
+		return e.complexity.ChainStat.ActiveSenders(childComplexity), true
+
+	case "ChainStat.averageBaseFee":
+		if e.complexity.ChainStat.AverageBaseFee == nil {
+			break
+		}
+
+		return e.complexity.ChainStat.AverageBaseFee(childComplexity), true
+
+	case "ChainStat.averageGasPrice":
+		if e.complexity.ChainStat.AverageGasPrice == nil {
+			break
+		}
+
+		return e.complexity.ChainStat.AverageGasPrice(childComplexity), true
+
+	case "ChainStat.blocks":
+		if e.complexity.ChainStat.Blocks == nil {
+			break
+		}
+
+		return e.complexity.ChainStat.Blocks(childComplexity), true
+
+	case "ChainStat.contracts":
+		if e.complexity.ChainStat.Contracts == nil {
+			break
+		}
+
+		return e.complexity.ChainStat.Contracts(childComplexity), true
+
+	case "ChainStat.events":
+		if e.complexity.ChainStat.Events == nil {
+			break
+		}
+
+		return e.complexity.ChainStat.Events(childComplexity), true
+
+	case "ChainStat.gasUsed":
+		if e.complexity.ChainStat.GasUsed == nil {
+			break
+		}
+
+		return e.complexity.ChainStat.GasUsed(childComplexity), true
+
+	case "ChainStat.time":
+		if e.complexity.ChainStat.Time == nil {
+			break
+		}
+
+		return e.complexity.ChainStat.Time(childComplexity), true
+
+	case "ChainStat.transactions":
+		if e.complexity.ChainStat.Transactions == nil {
+			break
+		}
+
+		return e.complexity.ChainStat.Transactions(childComplexity), true
+
 	case "Event.blockHash":
 		if e.complexity.Event.BlockHash == nil {
 			break
@@ -284,6 +476,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Event.BlockHash(childComplexity), true
 
+	case "Event.cursor":
+		if e.complexity.Event.Cursor == nil {
+			break
+		}
+
+		return e.complexity.Event.Cursor(childComplexity), true
+
 	case "Event.data":
 		if e.complexity.Event.Data == nil {
 			break
@@ -319,6 +518,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Event.TxHash(childComplexity), true
 
+	case "Query.account":
+		if e.complexity.Query.Account == nil {
+			break
+		}
+
+		args, err := ec.field_Query_account_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Account(childComplexity, args["address"].(string)), true
+
 	case "Query.blockByHash":
 		if e.complexity.Query.BlockByHash == nil {
 			break
@@ -343,6 +554,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.BlockByNumber(childComplexity, args["number"].(string)), true
 
+	case "Query.blockByTime":
+		if e.complexity.Query.BlockByTime == nil {
+			break
+		}
+
+		args, err := ec.field_Query_blockByTime_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BlockByTime(childComplexity, args["at"].(string), args["direction"].(*string)), true
+
 	case "Query.blocksByNumberRange":
 		if e.complexity.Query.BlocksByNumberRange == nil {
 			break
@@ -353,7 +576,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.BlocksByNumberRange(childComplexity, args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.BlocksByNumberRange(childComplexity, args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.blocksByTimeRange":
 		if e.complexity.Query.BlocksByTimeRange == nil {
@@ -365,7 +588,19 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.BlocksByTimeRange(childComplexity, args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.BlocksByTimeRange(childComplexity, args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
+
+	case "Query.chainStats":
+		if e.complexity.Query.ChainStats == nil {
+			break
+		}
+
+		args, err := ec.field_Query_chainStats_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ChainStats(childComplexity, args["interval"].(string), args["from"].(string), args["to"].(string)), true
 
 	case "Query.contractsCreatedFromAccountByNumberRange":
 		if e.complexity.Query.ContractsCreatedFromAccountByNumberRange == nil {
@@ -377,7 +612,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.ContractsCreatedFromAccountByNumberRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.ContractsCreatedFromAccountByNumberRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.contractsCreatedFromAccountByTimeRange":
 		if e.complexity.Query.ContractsCreatedFromAccountByTimeRange == nil {
@@ -389,7 +624,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.ContractsCreatedFromAccountByTimeRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.ContractsCreatedFromAccountByTimeRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.eventByBlockHashAndLogIndex":
 		if e.complexity.Query.EventByBlockHashAndLogIndex == nil {
@@ -425,7 +660,31 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.EventsByBlockHash(childComplexity, args["hash"].(string)), true
+		return e.complexity.Query.EventsByBlockHash(childComplexity, args["hash"].(string), args["limit"].(*int), args["cursor"].(*string)), true
+
+	case "Query.eventsByNumberRange":
+		if e.complexity.Query.EventsByNumberRange == nil {
+			break
+		}
+
+		args, err := ec.field_Query_eventsByNumberRange_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.EventsByNumberRange(childComplexity, args["from"].(string), args["to"].(string), args["contracts"].([]string), args["topics"].([][]string), args["limit"].(*int), args["cursor"].(*string)), true
+
+	case "Query.eventsByTimeRange":
+		if e.complexity.Query.EventsByTimeRange == nil {
+			break
+		}
+
+		args, err := ec.field_Query_eventsByTimeRange_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.EventsByTimeRange(childComplexity, args["from"].(string), args["to"].(string), args["contracts"].([]string), args["topics"].([][]string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.eventsByTxHash":
 		if e.complexity.Query.EventsByTxHash == nil {
@@ -437,7 +696,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.EventsByTxHash(childComplexity, args["hash"].(string)), true
+		return e.complexity.Query.EventsByTxHash(childComplexity, args["hash"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.eventsFromContractByNumberRange":
 		if e.complexity.Query.EventsFromContractByNumberRange == nil {
@@ -449,7 +708,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.EventsFromContractByNumberRange(childComplexity, args["contract"].(string), args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.EventsFromContractByNumberRange(childComplexity, args["contract"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.eventsFromContractByTimeRange":
 		if e.complexity.Query.EventsFromContractByTimeRange == nil {
@@ -461,7 +720,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.EventsFromContractByTimeRange(childComplexity, args["contract"].(string), args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.EventsFromContractByTimeRange(childComplexity, args["contract"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.eventsFromContractWithTopicsByNumberRange":
 		if e.complexity.Query.EventsFromContractWithTopicsByNumberRange == nil {
@@ -473,7 +732,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.EventsFromContractWithTopicsByNumberRange(childComplexity, args["contract"].(string), args["from"].(string), args["to"].(string), args["topics"].([]string)), true
+		return e.complexity.Query.EventsFromContractWithTopicsByNumberRange(childComplexity, args["contract"].(string), args["from"].(string), args["to"].(string), args["topics"].([]string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.eventsFromContractWithTopicsByTimeRange":
 		if e.complexity.Query.EventsFromContractWithTopicsByTimeRange == nil {
@@ -485,7 +744,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.EventsFromContractWithTopicsByTimeRange(childComplexity, args["contract"].(string), args["from"].(string), args["to"].(string), args["topics"].([]string)), true
+		return e.complexity.Query.EventsFromContractWithTopicsByTimeRange(childComplexity, args["contract"].(string), args["from"].(string), args["to"].(string), args["topics"].([]string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.lastXEventsFromContract":
 		if e.complexity.Query.LastXEventsFromContract == nil {
@@ -511,6 +770,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Transaction(childComplexity, args["hash"].(string)), true
 
+	case "Query.transactionAggregateByNumberRange":
+		if e.complexity.Query.TransactionAggregateByNumberRange == nil {
+			break
+		}
+
+		args, err := ec.field_Query_transactionAggregateByNumberRange_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TransactionAggregateByNumberRange(childComplexity, args["from"].(string), args["to"].(string), args["fromAccount"].(*string), args["toAccount"].(*string), args["minValue"].(*string), args["maxValue"].(*string)), true
+
+	case "Query.transactionAggregateByTimeRange":
+		if e.complexity.Query.TransactionAggregateByTimeRange == nil {
+			break
+		}
+
+		args, err := ec.field_Query_transactionAggregateByTimeRange_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TransactionAggregateByTimeRange(childComplexity, args["from"].(string), args["to"].(string), args["fromAccount"].(*string), args["toAccount"].(*string), args["minValue"].(*string), args["maxValue"].(*string)), true
+
 	case "Query.transactionCountBetweenAccountsByNumberRange":
 		if e.complexity.Query.TransactionCountBetweenAccountsByNumberRange == nil {
 			break
@@ -629,7 +912,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.TransactionsBetweenAccountsByNumberRange(childComplexity, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.TransactionsBetweenAccountsByNumberRange(childComplexity, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.transactionsBetweenAccountsByTimeRange":
 		if e.complexity.Query.TransactionsBetweenAccountsByTimeRange == nil {
@@ -641,7 +924,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.TransactionsBetweenAccountsByTimeRange(childComplexity, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.TransactionsBetweenAccountsByTimeRange(childComplexity, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.transactionsByBlockHash":
 		if e.complexity.Query.TransactionsByBlockHash == nil {
@@ -653,7 +936,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.TransactionsByBlockHash(childComplexity, args["hash"].(string)), true
+		return e.complexity.Query.TransactionsByBlockHash(childComplexity, args["hash"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.transactionsByBlockNumber":
 		if e.complexity.Query.TransactionsByBlockNumber == nil {
@@ -665,7 +948,31 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.TransactionsByBlockNumber(childComplexity, args["number"].(string)), true
+		return e.complexity.Query.TransactionsByBlockNumber(childComplexity, args["number"].(string), args["limit"].(*int), args["cursor"].(*string)), true
+
+	case "Query.transactionsByNumberRange":
+		if e.complexity.Query.TransactionsByNumberRange == nil {
+			break
+		}
+
+		args, err := ec.field_Query_transactionsByNumberRange_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TransactionsByNumberRange(childComplexity, args["from"].(string), args["to"].(string), args["fromAccount"].(*string), args["toAccount"].(*string), args["minValue"].(*string), args["maxValue"].(*string), args["limit"].(*int), args["cursor"].(*string)), true
+
+	case "Query.transactionsByTimeRange":
+		if e.complexity.Query.TransactionsByTimeRange == nil {
+			break
+		}
+
+		args, err := ec.field_Query_transactionsByTimeRange_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TransactionsByTimeRange(childComplexity, args["from"].(string), args["to"].(string), args["fromAccount"].(*string), args["toAccount"].(*string), args["minValue"].(*string), args["maxValue"].(*string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.transactionsFromAccountByNumberRange":
 		if e.complexity.Query.TransactionsFromAccountByNumberRange == nil {
@@ -677,7 +984,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.TransactionsFromAccountByNumberRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.TransactionsFromAccountByNumberRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.transactionsFromAccountByTimeRange":
 		if e.complexity.Query.TransactionsFromAccountByTimeRange == nil {
@@ -689,7 +996,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.TransactionsFromAccountByTimeRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.TransactionsFromAccountByTimeRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.transactionsToAccountByNumberRange":
 		if e.complexity.Query.TransactionsToAccountByNumberRange == nil {
@@ -701,7 +1008,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.TransactionsToAccountByNumberRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.TransactionsToAccountByNumberRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Query.transactionsToAccountByTimeRange":
 		if e.complexity.Query.TransactionsToAccountByTimeRange == nil {
@@ -713,7 +1020,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.TransactionsToAccountByTimeRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string)), true
+		return e.complexity.Query.TransactionsToAccountByTimeRange(childComplexity, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string)), true
 
 	case "Transaction.blockHash":
 		if e.complexity.Transaction.BlockHash == nil {
@@ -736,6 +1043,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Transaction.Cost(childComplexity), true
 
+	case "Transaction.cursor":
+		if e.complexity.Transaction.Cursor == nil {
+			break
+		}
+
+		return e.complexity.Transaction.Cursor(childComplexity), true
+
 	case "Transaction.data":
 		if e.complexity.Transaction.Data == nil {
 			break
@@ -799,6 +1113,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Transaction.Value(childComplexity), true
 
+	case "TransactionAggregate.averageValue":
+		if e.complexity.TransactionAggregate.AverageValue == nil {
+			break
+		}
+
+		return e.complexity.TransactionAggregate.AverageValue(childComplexity), true
+
+	case "TransactionAggregate.count":
+		if e.complexity.TransactionAggregate.Count == nil {
+			break
+		}
+
+		return e.complexity.TransactionAggregate.Count(childComplexity), true
+
+	case "TransactionAggregate.totalValue":
+		if e.complexity.TransactionAggregate.TotalValue == nil {
+			break
+		}
+
+		return e.complexity.TransactionAggregate.TotalValue(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -865,6 +1200,8 @@ var sources = []*ast.Source{
   txRootHash: String!
   receiptRootHash: String!
   extraData: String!
+  # opaque position, to be passed as ` + "`" + `cursor` + "`" + ` for fetching next page
+  cursor: String!
 }
 
 type Transaction {
@@ -880,6 +1217,13 @@ type Transaction {
   nonce: String!
   state: String!
   blockHash: String!
+  cursor: String!
+}
+
+type TransactionAggregate {
+  count: Int!
+  totalValue: String!
+  averageValue: String!
 }
 
 type Event {
@@ -889,55 +1233,105 @@ type Event {
   data: String!
   txHash: String!
   blockHash: String!
+  cursor: String!
+}
+
+# nonce is null when account has never sent any tx, while ` + "`" + `createdAt` + "`" + ` & ` + "`" + `emitted` + "`" + `
+# are null, unless it's a contract
+type Account {
+  address: String!
+  firstSeen: String!
+  lastSeen: String!
+  sent: String!
+  received: String!
+  deployed: String!
+  nonce: String
+  contract: Boolean!
+  createdAt: String
+  emitted: String
+}
+
+# rollup of chain activity during one hour/ day, starting at ` + "`" + `time` + "`" + `, where
+# ` + "`" + `averageBaseFee` + "`" + ` is null, when none of the blocks carried base fee
+type ChainStat {
+  time: String!
+  blocks: String!
+  transactions: String!
+  gasUsed: String!
+  averageGasPrice: String!
+  averageBaseFee: String
+  activeSenders: String!
+  contracts: String!
+  events: String!
 }
 
+# list queries are paginated when ` + "`" + `limit` + "`" + ` and/ or ` + "`" + `cursor` + "`" + ` is given, then range
+# width isn't limited, rather at max ` + "`" + `limit` + "`" + ` results are returned, in chain order
 type Query {
   blockByHash(hash: String!): Block!
   blockByNumber(number: String!): Block!
-  blocksByNumberRange(from: String!, to: String!): [Block!]!
-  blocksByTimeRange(from: String!, to: String!): [Block!]!
+  blocksByNumberRange(from: String!, to: String!, limit: Int, cursor: String): [Block!]!
+  blocksByTimeRange(from: String!, to: String!, limit: Int, cursor: String): [Block!]!
+  # first block mined at or after ` + "`" + `at` + "`" + `, when direction is ` + "`" + `after` + "`" + ` ( default ), otherwise
+  # last one mined at or before it, fails with ` + "`" + `Not yet indexed` + "`" + `, if ` + "`" + `at` + "`" + ` is past synced head
+  blockByTime(at: String!, direction: String): Block!
 
   # -- transaction related methods, start
   transaction(hash: String!): Transaction!
   
   transactionCountByBlockHash(hash: String!): Int!
-  transactionsByBlockHash(hash: String!): [Transaction!]!
+  transactionsByBlockHash(hash: String!, limit: Int, cursor: String): [Transaction!]!
   
   transactionCountByBlockNumber(number: String!): Int!
-  transactionsByBlockNumber(number: String!): [Transaction!]!
+  transactionsByBlockNumber(number: String!, limit: Int, cursor: String): [Transaction!]!
   
   transactionCountFromAccountByNumberRange(account: String!, from: String!, to: String!): Int!
-  transactionsFromAccountByNumberRange(account: String!, from: String!, to: String!): [Transaction!]!
+  transactionsFromAccountByNumberRange(account: String!, from: String!, to: String!, limit: Int, cursor: String): [Transaction!]!
   
   transactionCountFromAccountByTimeRange(account: String!, from: String!, to: String!): Int!
-  transactionsFromAccountByTimeRange(account: String!, from: String!, to: String!): [Transaction!]!
+  transactionsFromAccountByTimeRange(account: String!, from: String!, to: String!, limit: Int, cursor: String): [Transaction!]!
   
   transactionCountToAccountByNumberRange(account: String!, from: String!, to: String!): Int!
-  transactionsToAccountByNumberRange(account: String!, from: String!, to: String!): [Transaction!]!
+  transactionsToAccountByNumberRange(account: String!, from: String!, to: String!, limit: Int, cursor: String): [Transaction!]!
 
   transactionCountToAccountByTimeRange(account: String!, from: String!, to: String!): Int!
-  transactionsToAccountByTimeRange(account: String!, from: String!, to: String!): [Transaction!]!
+  transactionsToAccountByTimeRange(account: String!, from: String!, to: String!, limit: Int, cursor: String): [Transaction!]!
 
   transactionCountBetweenAccountsByNumberRange(fromAccount: String!, toAccount: String!, from: String!, to: String!): Int!
-  transactionsBetweenAccountsByNumberRange(fromAccount: String!, toAccount: String!, from: String!, to: String!): [Transaction!]!
+  transactionsBetweenAccountsByNumberRange(fromAccount: String!, toAccount: String!, from: String!, to: String!, limit: Int, cursor: String): [Transaction!]!
 
   transactionCountBetweenAccountsByTimeRange(fromAccount: String!, toAccount: String!, from: String!, to: String!): Int!
-  transactionsBetweenAccountsByTimeRange(fromAccount: String!, toAccount: String!, from: String!, to: String!): [Transaction!]!
+  transactionsBetweenAccountsByTimeRange(fromAccount: String!, toAccount: String!, from: String!, to: String!, limit: Int, cursor: String): [Transaction!]!
 
-  contractsCreatedFromAccountByNumberRange(account: String!, from: String!, to: String!): [Transaction!]!
-  contractsCreatedFromAccountByTimeRange(account: String!, from: String!, to: String!): [Transaction!]!
+  contractsCreatedFromAccountByNumberRange(account: String!, from: String!, to: String!, limit: Int, cursor: String): [Transaction!]!
+  contractsCreatedFromAccountByTimeRange(account: String!, from: String!, to: String!, limit: Int, cursor: String): [Transaction!]!
   transactionFromAccountWithNonce(account: String!, nonce: String!): Transaction!
+
+  # all filters are optional, where values are in wei
+  transactionsByNumberRange(from: String!, to: String!, fromAccount: String, toAccount: String, minValue: String, maxValue: String, limit: Int, cursor: String): [Transaction!]!
+  transactionsByTimeRange(from: String!, to: String!, fromAccount: String, toAccount: String, minValue: String, maxValue: String, limit: Int, cursor: String): [Transaction!]!
+  transactionAggregateByNumberRange(from: String!, to: String!, fromAccount: String, toAccount: String, minValue: String, maxValue: String): TransactionAggregate!
+  transactionAggregateByTimeRange(from: String!, to: String!, fromAccount: String, toAccount: String, minValue: String, maxValue: String): TransactionAggregate!
   # transaction related methods, end
 
-  eventsFromContractByNumberRange(contract: String!, from: String!, to: String!): [Event!]!
-  eventsFromContractByTimeRange(contract: String!, from: String!, to: String!): [Event!]!
-  eventsByBlockHash(hash: String!): [Event!]!
-  eventsByTxHash(hash: String!): [Event!]!
-  eventsFromContractWithTopicsByNumberRange(contract: String!, from: String!, to: String!, topics: [String!]!): [Event!]!
-  eventsFromContractWithTopicsByTimeRange(contract: String!, from: String!, to: String!, topics: [String!]!): [Event!]!
+  eventsFromContractByNumberRange(contract: String!, from: String!, to: String!, limit: Int, cursor: String): [Event!]!
+  eventsFromContractByTimeRange(contract: String!, from: String!, to: String!, limit: Int, cursor: String): [Event!]!
+  eventsByBlockHash(hash: String!, limit: Int, cursor: String): [Event!]!
+  eventsByTxHash(hash: String!, limit: Int, cursor: String): [Event!]!
+  eventsFromContractWithTopicsByNumberRange(contract: String!, from: String!, to: String!, topics: [String!]!, limit: Int, cursor: String): [Event!]!
+  eventsFromContractWithTopicsByTimeRange(contract: String!, from: String!, to: String!, topics: [String!]!, limit: Int, cursor: String): [Event!]!
+  # events emitted by any of ` + "`" + `contracts` + "`" + `, with topic at each position being any of respective
+  # set, as in ` + "`" + `eth_getLogs` + "`" + `, where omitted/ null ones match anything
+  eventsByNumberRange(from: String!, to: String!, contracts: [String!], topics: [[String!]], limit: Int, cursor: String): [Event!]!
+  eventsByTimeRange(from: String!, to: String!, contracts: [String!], topics: [[String!]], limit: Int, cursor: String): [Event!]!
   lastXEventsFromContract(contract: String!, x: Int!): [Event!]!
   eventByBlockHashAndLogIndex(hash: String!, index: String!): Event!
   eventByBlockNumberAndLogIndex(number: String!, index: String!): Event!
+
+  account(address: String!): Account!
+
+  # interval is either ` + "`" + `hour` + "`" + ` or ` + "`" + `day` + "`" + `
+  chainStats(interval: String!, from: String!, to: String!): [ChainStat!]!
 }
 `, BuiltIn: false},
 }
@@ -962,6 +1356,21 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_account_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["address"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["address"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_blockByHash_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -992,6 +1401,30 @@ func (ec *executionContext) field_Query_blockByNumber_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_blockByTime_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["at"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("at"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["at"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["direction"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["direction"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_blocksByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1013,6 +1446,24 @@ func (ec *executionContext) field_Query_blocksByNumberRange_args(ctx context.Con
 		}
 	}
 	args["to"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg3
 	return args, nil
 }
 
@@ -1037,21 +1488,39 @@ func (ec *executionContext) field_Query_blocksByTimeRange_args(ctx context.Conte
 		}
 	}
 	args["to"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg3
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_contractsCreatedFromAccountByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_chainStats_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["account"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("account"))
+	if tmp, ok := rawArgs["interval"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("interval"))
 		arg0, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["account"] = arg0
+	args["interval"] = arg0
 	var arg1 string
 	if tmp, ok := rawArgs["from"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
@@ -1073,7 +1542,7 @@ func (ec *executionContext) field_Query_contractsCreatedFromAccountByNumberRange
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_contractsCreatedFromAccountByTimeRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_contractsCreatedFromAccountByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -1103,38 +1572,107 @@ func (ec *executionContext) field_Query_contractsCreatedFromAccountByTimeRange_a
 		}
 	}
 	args["to"] = arg2
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_eventByBlockHashAndLogIndex_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["hash"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hash"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	var arg3 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["hash"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["index"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("index"))
-		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+	args["limit"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["index"] = arg1
+	args["cursor"] = arg4
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_eventByBlockNumberAndLogIndex_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_contractsCreatedFromAccountByTimeRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["number"]; ok {
+	if tmp, ok := rawArgs["account"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("account"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["account"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_eventByBlockHashAndLogIndex_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["hash"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hash"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["hash"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["index"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("index"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["index"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_eventByBlockNumberAndLogIndex_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["number"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("number"))
 		arg0, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
@@ -1166,6 +1704,144 @@ func (ec *executionContext) field_Query_eventsByBlockHash_args(ctx context.Conte
 		}
 	}
 	args["hash"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_eventsByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg1
+	var arg2 []string
+	if tmp, ok := rawArgs["contracts"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("contracts"))
+		arg2, err = ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["contracts"] = arg2
+	var arg3 [][]string
+	if tmp, ok := rawArgs["topics"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("topics"))
+		arg3, err = ec.unmarshalOString2ᚕᚕstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["topics"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_eventsByTimeRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg1
+	var arg2 []string
+	if tmp, ok := rawArgs["contracts"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("contracts"))
+		arg2, err = ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["contracts"] = arg2
+	var arg3 [][]string
+	if tmp, ok := rawArgs["topics"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("topics"))
+		arg3, err = ec.unmarshalOString2ᚕᚕstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["topics"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg5
 	return args, nil
 }
 
@@ -1181,6 +1857,24 @@ func (ec *executionContext) field_Query_eventsByTxHash_args(ctx context.Context,
 		}
 	}
 	args["hash"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg2
 	return args, nil
 }
 
@@ -1214,6 +1908,24 @@ func (ec *executionContext) field_Query_eventsFromContractByNumberRange_args(ctx
 		}
 	}
 	args["to"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg4
 	return args, nil
 }
 
@@ -1247,6 +1959,24 @@ func (ec *executionContext) field_Query_eventsFromContractByTimeRange_args(ctx c
 		}
 	}
 	args["to"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg4
 	return args, nil
 }
 
@@ -1289,6 +2019,24 @@ func (ec *executionContext) field_Query_eventsFromContractWithTopicsByNumberRang
 		}
 	}
 	args["topics"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg5
 	return args, nil
 }
 
@@ -1331,6 +2079,24 @@ func (ec *executionContext) field_Query_eventsFromContractWithTopicsByTimeRange_
 		}
 	}
 	args["topics"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg5
 	return args, nil
 }
 
@@ -1358,103 +2124,223 @@ func (ec *executionContext) field_Query_lastXEventsFromContract_args(ctx context
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_transactionCountBetweenAccountsByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_transactionAggregateByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["fromAccount"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromAccount"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
-	}
-	args["fromAccount"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["toAccount"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toAccount"))
-		arg1, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
-	}
-	args["toAccount"] = arg1
-	var arg2 string
 	if tmp, ok := rawArgs["from"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
-		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["from"] = arg2
-	var arg3 string
+	args["from"] = arg0
+	var arg1 string
 	if tmp, ok := rawArgs["to"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
-		arg3, err = ec.unmarshalNString2string(ctx, tmp)
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["to"] = arg3
-	return args, nil
-}
-
-func (ec *executionContext) field_Query_transactionCountBetweenAccountsByTimeRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
+	args["to"] = arg1
+	var arg2 *string
 	if tmp, ok := rawArgs["fromAccount"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromAccount"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["fromAccount"] = arg0
-	var arg1 string
+	args["fromAccount"] = arg2
+	var arg3 *string
 	if tmp, ok := rawArgs["toAccount"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toAccount"))
-		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["toAccount"] = arg1
-	var arg2 string
-	if tmp, ok := rawArgs["from"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
-		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+	args["toAccount"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["minValue"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minValue"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["from"] = arg2
-	var arg3 string
-	if tmp, ok := rawArgs["to"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
-		arg3, err = ec.unmarshalNString2string(ctx, tmp)
+	args["minValue"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["maxValue"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxValue"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["to"] = arg3
+	args["maxValue"] = arg5
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_transactionCountByBlockHash_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_transactionAggregateByTimeRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["hash"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hash"))
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
 		arg0, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["hash"] = arg0
-	return args, nil
+	args["from"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["fromAccount"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromAccount"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["fromAccount"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["toAccount"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toAccount"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["toAccount"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["minValue"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minValue"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["minValue"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["maxValue"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxValue"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["maxValue"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_transactionCountBetweenAccountsByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["fromAccount"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromAccount"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["fromAccount"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["toAccount"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toAccount"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["toAccount"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg2
+	var arg3 string
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg3, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_transactionCountBetweenAccountsByTimeRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["fromAccount"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromAccount"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["fromAccount"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["toAccount"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toAccount"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["toAccount"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg2
+	var arg3 string
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg3, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_transactionCountByBlockHash_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["hash"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hash"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["hash"] = arg0
+	return args, nil
 }
 
 func (ec *executionContext) field_Query_transactionCountByBlockNumber_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
@@ -1682,6 +2568,24 @@ func (ec *executionContext) field_Query_transactionsBetweenAccountsByNumberRange
 		}
 	}
 	args["to"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg5
 	return args, nil
 }
 
@@ -1724,6 +2628,24 @@ func (ec *executionContext) field_Query_transactionsBetweenAccountsByTimeRange_a
 		}
 	}
 	args["to"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg5
 	return args, nil
 }
 
@@ -1739,6 +2661,24 @@ func (ec *executionContext) field_Query_transactionsByBlockHash_args(ctx context
 		}
 	}
 	args["hash"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg2
 	return args, nil
 }
 
@@ -1754,76 +2694,184 @@ func (ec *executionContext) field_Query_transactionsByBlockNumber_args(ctx conte
 		}
 	}
 	args["number"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_transactionsFromAccountByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_transactionsByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["account"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("account"))
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
 		arg0, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["account"] = arg0
+	args["from"] = arg0
 	var arg1 string
-	if tmp, ok := rawArgs["from"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
 		arg1, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["from"] = arg1
-	var arg2 string
-	if tmp, ok := rawArgs["to"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
-		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+	args["to"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["fromAccount"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromAccount"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["to"] = arg2
+	args["fromAccount"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["toAccount"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toAccount"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["toAccount"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["minValue"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minValue"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["minValue"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["maxValue"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxValue"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["maxValue"] = arg5
+	var arg6 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg6, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg6
+	var arg7 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg7, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg7
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_transactionsFromAccountByTimeRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_transactionsByTimeRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["account"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("account"))
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
 		arg0, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["account"] = arg0
+	args["from"] = arg0
 	var arg1 string
-	if tmp, ok := rawArgs["from"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
 		arg1, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["from"] = arg1
-	var arg2 string
-	if tmp, ok := rawArgs["to"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
-		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+	args["to"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["fromAccount"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromAccount"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["to"] = arg2
+	args["fromAccount"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["toAccount"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toAccount"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["toAccount"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["minValue"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minValue"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["minValue"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["maxValue"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxValue"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["maxValue"] = arg5
+	var arg6 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg6, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg6
+	var arg7 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg7, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg7
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_transactionsToAccountByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_transactionsFromAccountByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -1853,81 +2901,1362 @@ func (ec *executionContext) field_Query_transactionsToAccountByNumberRange_args(
 		}
 	}
 	args["to"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg4
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_transactionsToAccountByTimeRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["account"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("account"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) field_Query_transactionsFromAccountByTimeRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["account"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("account"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["account"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_transactionsToAccountByNumberRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["account"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("account"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["account"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_transactionsToAccountByTimeRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["account"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("account"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["account"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["from"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["from"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["cursor"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cursor"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cursor"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _Account_address(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Address, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_firstSeen(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FirstSeen, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_lastSeen(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastSeen, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_sent(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Sent, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_received(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Received, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_deployed(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Deployed, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_nonce(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Nonce, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_contract(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Contract, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_emitted(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Account",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Emitted, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_hash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_number(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Number, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_time(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_parentHash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ParentHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_difficulty(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Difficulty, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_gasUsed(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GasUsed, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_gasLimit(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GasLimit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_nonce(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Nonce, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_miner(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Miner, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_size(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Size, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_stateRootHash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StateRootHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_uncleHash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UncleHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_txRootHash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TxRootHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_receiptRootHash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ReceiptRootHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_extraData(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExtraData, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Block_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Block",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ChainStat_time(ctx context.Context, field graphql.CollectedField, obj *model.ChainStat) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ChainStat",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ChainStat_blocks(ctx context.Context, field graphql.CollectedField, obj *model.ChainStat) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ChainStat",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Blocks, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ChainStat_transactions(ctx context.Context, field graphql.CollectedField, obj *model.ChainStat) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ChainStat",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Transactions, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ChainStat_gasUsed(ctx context.Context, field graphql.CollectedField, obj *model.ChainStat) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ChainStat",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GasUsed, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ChainStat_averageGasPrice(ctx context.Context, field graphql.CollectedField, obj *model.ChainStat) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ChainStat",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AverageGasPrice, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ChainStat_averageBaseFee(ctx context.Context, field graphql.CollectedField, obj *model.ChainStat) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ChainStat",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
 	}
-	args["account"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["from"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
-		arg1, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AverageBaseFee, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
 	}
-	args["from"] = arg1
-	var arg2 string
-	if tmp, ok := rawArgs["to"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
-		arg2, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
+	if resTmp == nil {
+		return graphql.Null
 	}
-	args["to"] = arg2
-	return args, nil
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+func (ec *executionContext) _ChainStat_activeSenders(ctx context.Context, field graphql.CollectedField, obj *model.ChainStat) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ChainStat",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ActiveSenders, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-// endregion ***************************** args.gotpl *****************************
-
-// region    ************************** directives.gotpl **************************
-
-// endregion ************************** directives.gotpl **************************
-
-// region    **************************** field.gotpl *****************************
-
-func (ec *executionContext) _Block_hash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChainStat_contracts(ctx context.Context, field graphql.CollectedField, obj *model.ChainStat) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1935,7 +4264,7 @@ func (ec *executionContext) _Block_hash(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "ChainStat",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -1945,7 +4274,7 @@ func (ec *executionContext) _Block_hash(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.Contracts, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1962,7 +4291,7 @@ func (ec *executionContext) _Block_hash(ctx context.Context, field graphql.Colle
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_number(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChainStat_events(ctx context.Context, field graphql.CollectedField, obj *model.ChainStat) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1970,7 +4299,7 @@ func (ec *executionContext) _Block_number(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "ChainStat",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -1980,7 +4309,7 @@ func (ec *executionContext) _Block_number(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Number, nil
+		return obj.Events, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1997,7 +4326,7 @@ func (ec *executionContext) _Block_number(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_time(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Event_origin(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2005,7 +4334,7 @@ func (ec *executionContext) _Block_time(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Event",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2015,7 +4344,7 @@ func (ec *executionContext) _Block_time(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Time, nil
+		return obj.Origin, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2032,7 +4361,7 @@ func (ec *executionContext) _Block_time(ctx context.Context, field graphql.Colle
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_parentHash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Event_index(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2040,7 +4369,7 @@ func (ec *executionContext) _Block_parentHash(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Event",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2050,7 +4379,7 @@ func (ec *executionContext) _Block_parentHash(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ParentHash, nil
+		return obj.Index, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2067,7 +4396,7 @@ func (ec *executionContext) _Block_parentHash(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_difficulty(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Event_topics(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2075,7 +4404,7 @@ func (ec *executionContext) _Block_difficulty(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Event",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2085,7 +4414,7 @@ func (ec *executionContext) _Block_difficulty(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Difficulty, nil
+		return obj.Topics, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2097,12 +4426,12 @@ func (ec *executionContext) _Block_difficulty(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_gasUsed(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Event_data(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2110,7 +4439,7 @@ func (ec *executionContext) _Block_gasUsed(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Event",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2120,7 +4449,7 @@ func (ec *executionContext) _Block_gasUsed(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.GasUsed, nil
+		return obj.Data, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2137,7 +4466,7 @@ func (ec *executionContext) _Block_gasUsed(ctx context.Context, field graphql.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_gasLimit(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Event_txHash(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2145,7 +4474,7 @@ func (ec *executionContext) _Block_gasLimit(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Event",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2155,7 +4484,7 @@ func (ec *executionContext) _Block_gasLimit(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.GasLimit, nil
+		return obj.TxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2172,7 +4501,7 @@ func (ec *executionContext) _Block_gasLimit(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_nonce(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Event_blockHash(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2180,7 +4509,7 @@ func (ec *executionContext) _Block_nonce(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Event",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2190,7 +4519,7 @@ func (ec *executionContext) _Block_nonce(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Nonce, nil
+		return obj.BlockHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2207,7 +4536,7 @@ func (ec *executionContext) _Block_nonce(ctx context.Context, field graphql.Coll
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_miner(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Event_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2215,7 +4544,7 @@ func (ec *executionContext) _Block_miner(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Event",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2225,7 +4554,7 @@ func (ec *executionContext) _Block_miner(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Miner, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2242,7 +4571,7 @@ func (ec *executionContext) _Block_miner(ctx context.Context, field graphql.Coll
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_size(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_blockByHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2250,17 +4579,24 @@ func (ec *executionContext) _Block_size(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_blockByHash_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Size, nil
+		return ec.resolvers.Query().BlockByHash(rctx, args["hash"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2272,12 +4608,12 @@ func (ec *executionContext) _Block_size(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*model.Block)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNBlock2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐBlock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_stateRootHash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_blockByNumber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2285,17 +4621,66 @@ func (ec *executionContext) _Block_stateRootHash(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_blockByNumber_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().BlockByNumber(rctx, args["number"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Block)
+	fc.Result = res
+	return ec.marshalNBlock2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐBlock(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_blocksByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_blocksByNumberRange_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StateRootHash, nil
+		return ec.resolvers.Query().BlocksByNumberRange(rctx, args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2307,12 +4692,12 @@ func (ec *executionContext) _Block_stateRootHash(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Block)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBlock2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐBlockᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_uncleHash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_blocksByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2320,17 +4705,24 @@ func (ec *executionContext) _Block_uncleHash(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_blocksByTimeRange_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
 	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UncleHash, nil
+		return ec.resolvers.Query().BlocksByTimeRange(rctx, args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2342,12 +4734,12 @@ func (ec *executionContext) _Block_uncleHash(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Block)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBlock2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐBlockᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_txRootHash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_blockByTime(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2355,17 +4747,24 @@ func (ec *executionContext) _Block_txRootHash(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_blockByTime_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxRootHash, nil
+		return ec.resolvers.Query().BlockByTime(rctx, args["at"].(string), args["direction"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2377,12 +4776,12 @@ func (ec *executionContext) _Block_txRootHash(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Block)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBlock2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐBlock(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_receiptRootHash(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transaction(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2390,17 +4789,24 @@ func (ec *executionContext) _Block_receiptRootHash(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_transaction_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ReceiptRootHash, nil
+		return ec.resolvers.Query().Transaction(rctx, args["hash"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2412,12 +4818,12 @@ func (ec *executionContext) _Block_receiptRootHash(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Transaction)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTransaction2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Block_extraData(ctx context.Context, field graphql.CollectedField, obj *model.Block) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionCountByBlockHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2425,17 +4831,24 @@ func (ec *executionContext) _Block_extraData(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Block",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_transactionCountByBlockHash_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExtraData, nil
+		return ec.resolvers.Query().TransactionCountByBlockHash(rctx, args["hash"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2447,12 +4860,12 @@ func (ec *executionContext) _Block_extraData(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Event_origin(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionsByBlockHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2460,17 +4873,24 @@ func (ec *executionContext) _Event_origin(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_transactionsByBlockHash_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Origin, nil
+		return ec.resolvers.Query().TransactionsByBlockHash(rctx, args["hash"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2482,12 +4902,12 @@ func (ec *executionContext) _Event_origin(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Transaction)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Event_index(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionCountByBlockNumber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2495,17 +4915,24 @@ func (ec *executionContext) _Event_index(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_transactionCountByBlockNumber_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Index, nil
+		return ec.resolvers.Query().TransactionCountByBlockNumber(rctx, args["number"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2517,12 +4944,12 @@ func (ec *executionContext) _Event_index(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Event_topics(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionsByBlockNumber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2530,17 +4957,24 @@ func (ec *executionContext) _Event_topics(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_transactionsByBlockNumber_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Topics, nil
+		return ec.resolvers.Query().TransactionsByBlockNumber(rctx, args["number"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2552,12 +4986,12 @@ func (ec *executionContext) _Event_topics(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.([]*model.Transaction)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Event_data(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionCountFromAccountByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2565,17 +4999,24 @@ func (ec *executionContext) _Event_data(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_transactionCountFromAccountByNumberRange_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
+		return ec.resolvers.Query().TransactionCountFromAccountByNumberRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2587,12 +5028,12 @@ func (ec *executionContext) _Event_data(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Event_txHash(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionsFromAccountByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2600,17 +5041,24 @@ func (ec *executionContext) _Event_txHash(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_transactionsFromAccountByNumberRange_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxHash, nil
+		return ec.resolvers.Query().TransactionsFromAccountByNumberRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2622,12 +5070,12 @@ func (ec *executionContext) _Event_txHash(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Transaction)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Event_blockHash(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionCountFromAccountByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2635,17 +5083,24 @@ func (ec *executionContext) _Event_blockHash(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_transactionCountFromAccountByTimeRange_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BlockHash, nil
+		return ec.resolvers.Query().TransactionCountFromAccountByTimeRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2657,12 +5112,12 @@ func (ec *executionContext) _Event_blockHash(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_blockByHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionsFromAccountByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2679,7 +5134,7 @@ func (ec *executionContext) _Query_blockByHash(ctx context.Context, field graphq
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_blockByHash_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionsFromAccountByTimeRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -2687,7 +5142,7 @@ func (ec *executionContext) _Query_blockByHash(ctx context.Context, field graphq
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().BlockByHash(rctx, args["hash"].(string))
+		return ec.resolvers.Query().TransactionsFromAccountByTimeRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2699,12 +5154,12 @@ func (ec *executionContext) _Query_blockByHash(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Block)
+	res := resTmp.([]*model.Transaction)
 	fc.Result = res
-	return ec.marshalNBlock2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐBlock(ctx, field.Selections, res)
+	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_blockByNumber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionCountToAccountByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2721,7 +5176,7 @@ func (ec *executionContext) _Query_blockByNumber(ctx context.Context, field grap
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_blockByNumber_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionCountToAccountByNumberRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -2729,7 +5184,7 @@ func (ec *executionContext) _Query_blockByNumber(ctx context.Context, field grap
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().BlockByNumber(rctx, args["number"].(string))
+		return ec.resolvers.Query().TransactionCountToAccountByNumberRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2741,12 +5196,12 @@ func (ec *executionContext) _Query_blockByNumber(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Block)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBlock2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐBlock(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_blocksByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionsToAccountByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2763,7 +5218,7 @@ func (ec *executionContext) _Query_blocksByNumberRange(ctx context.Context, fiel
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_blocksByNumberRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionsToAccountByNumberRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -2771,7 +5226,7 @@ func (ec *executionContext) _Query_blocksByNumberRange(ctx context.Context, fiel
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().BlocksByNumberRange(rctx, args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().TransactionsToAccountByNumberRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2783,12 +5238,12 @@ func (ec *executionContext) _Query_blocksByNumberRange(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Block)
+	res := resTmp.([]*model.Transaction)
 	fc.Result = res
-	return ec.marshalNBlock2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐBlockᚄ(ctx, field.Selections, res)
+	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_blocksByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionCountToAccountByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2805,7 +5260,7 @@ func (ec *executionContext) _Query_blocksByTimeRange(ctx context.Context, field
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_blocksByTimeRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionCountToAccountByTimeRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -2813,7 +5268,7 @@ func (ec *executionContext) _Query_blocksByTimeRange(ctx context.Context, field
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().BlocksByTimeRange(rctx, args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().TransactionCountToAccountByTimeRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2825,12 +5280,12 @@ func (ec *executionContext) _Query_blocksByTimeRange(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Block)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBlock2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐBlockᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transaction(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionsToAccountByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2847,7 +5302,7 @@ func (ec *executionContext) _Query_transaction(ctx context.Context, field graphq
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transaction_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionsToAccountByTimeRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -2855,7 +5310,7 @@ func (ec *executionContext) _Query_transaction(ctx context.Context, field graphq
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Transaction(rctx, args["hash"].(string))
+		return ec.resolvers.Query().TransactionsToAccountByTimeRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2867,12 +5322,12 @@ func (ec *executionContext) _Query_transaction(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Transaction)
+	res := resTmp.([]*model.Transaction)
 	fc.Result = res
-	return ec.marshalNTransaction2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
+	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionCountByBlockHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionCountBetweenAccountsByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2889,7 +5344,7 @@ func (ec *executionContext) _Query_transactionCountByBlockHash(ctx context.Conte
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionCountByBlockHash_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionCountBetweenAccountsByNumberRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -2897,7 +5352,7 @@ func (ec *executionContext) _Query_transactionCountByBlockHash(ctx context.Conte
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionCountByBlockHash(rctx, args["hash"].(string))
+		return ec.resolvers.Query().TransactionCountBetweenAccountsByNumberRange(rctx, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2914,7 +5369,7 @@ func (ec *executionContext) _Query_transactionCountByBlockHash(ctx context.Conte
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionsByBlockHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionsBetweenAccountsByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2931,7 +5386,7 @@ func (ec *executionContext) _Query_transactionsByBlockHash(ctx context.Context,
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionsByBlockHash_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionsBetweenAccountsByNumberRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -2939,7 +5394,7 @@ func (ec *executionContext) _Query_transactionsByBlockHash(ctx context.Context,
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionsByBlockHash(rctx, args["hash"].(string))
+		return ec.resolvers.Query().TransactionsBetweenAccountsByNumberRange(rctx, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2956,7 +5411,7 @@ func (ec *executionContext) _Query_transactionsByBlockHash(ctx context.Context,
 	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionCountByBlockNumber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionCountBetweenAccountsByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2973,7 +5428,7 @@ func (ec *executionContext) _Query_transactionCountByBlockNumber(ctx context.Con
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionCountByBlockNumber_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionCountBetweenAccountsByTimeRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -2981,7 +5436,7 @@ func (ec *executionContext) _Query_transactionCountByBlockNumber(ctx context.Con
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionCountByBlockNumber(rctx, args["number"].(string))
+		return ec.resolvers.Query().TransactionCountBetweenAccountsByTimeRange(rctx, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2998,7 +5453,7 @@ func (ec *executionContext) _Query_transactionCountByBlockNumber(ctx context.Con
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionsByBlockNumber(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionsBetweenAccountsByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3015,7 +5470,7 @@ func (ec *executionContext) _Query_transactionsByBlockNumber(ctx context.Context
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionsByBlockNumber_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionsBetweenAccountsByTimeRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3023,7 +5478,7 @@ func (ec *executionContext) _Query_transactionsByBlockNumber(ctx context.Context
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionsByBlockNumber(rctx, args["number"].(string))
+		return ec.resolvers.Query().TransactionsBetweenAccountsByTimeRange(rctx, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3040,7 +5495,7 @@ func (ec *executionContext) _Query_transactionsByBlockNumber(ctx context.Context
 	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionCountFromAccountByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_contractsCreatedFromAccountByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3057,7 +5512,7 @@ func (ec *executionContext) _Query_transactionCountFromAccountByNumberRange(ctx
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionCountFromAccountByNumberRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_contractsCreatedFromAccountByNumberRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3065,7 +5520,7 @@ func (ec *executionContext) _Query_transactionCountFromAccountByNumberRange(ctx
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionCountFromAccountByNumberRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().ContractsCreatedFromAccountByNumberRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3077,12 +5532,12 @@ func (ec *executionContext) _Query_transactionCountFromAccountByNumberRange(ctx
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*model.Transaction)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionsFromAccountByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_contractsCreatedFromAccountByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3099,7 +5554,7 @@ func (ec *executionContext) _Query_transactionsFromAccountByNumberRange(ctx cont
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionsFromAccountByNumberRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_contractsCreatedFromAccountByTimeRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3107,7 +5562,7 @@ func (ec *executionContext) _Query_transactionsFromAccountByNumberRange(ctx cont
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionsFromAccountByNumberRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().ContractsCreatedFromAccountByTimeRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3124,7 +5579,7 @@ func (ec *executionContext) _Query_transactionsFromAccountByNumberRange(ctx cont
 	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionCountFromAccountByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionFromAccountWithNonce(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3141,7 +5596,7 @@ func (ec *executionContext) _Query_transactionCountFromAccountByTimeRange(ctx co
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionCountFromAccountByTimeRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionFromAccountWithNonce_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3149,7 +5604,7 @@ func (ec *executionContext) _Query_transactionCountFromAccountByTimeRange(ctx co
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionCountFromAccountByTimeRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().TransactionFromAccountWithNonce(rctx, args["account"].(string), args["nonce"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3161,12 +5616,12 @@ func (ec *executionContext) _Query_transactionCountFromAccountByTimeRange(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.Transaction)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTransaction2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionsFromAccountByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionsByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3183,7 +5638,7 @@ func (ec *executionContext) _Query_transactionsFromAccountByTimeRange(ctx contex
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionsFromAccountByTimeRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionsByNumberRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3191,7 +5646,7 @@ func (ec *executionContext) _Query_transactionsFromAccountByTimeRange(ctx contex
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionsFromAccountByTimeRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().TransactionsByNumberRange(rctx, args["from"].(string), args["to"].(string), args["fromAccount"].(*string), args["toAccount"].(*string), args["minValue"].(*string), args["maxValue"].(*string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3208,7 +5663,7 @@ func (ec *executionContext) _Query_transactionsFromAccountByTimeRange(ctx contex
 	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionCountToAccountByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionsByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3225,7 +5680,7 @@ func (ec *executionContext) _Query_transactionCountToAccountByNumberRange(ctx co
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionCountToAccountByNumberRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionsByTimeRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3233,7 +5688,7 @@ func (ec *executionContext) _Query_transactionCountToAccountByNumberRange(ctx co
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionCountToAccountByNumberRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().TransactionsByTimeRange(rctx, args["from"].(string), args["to"].(string), args["fromAccount"].(*string), args["toAccount"].(*string), args["minValue"].(*string), args["maxValue"].(*string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3245,12 +5700,12 @@ func (ec *executionContext) _Query_transactionCountToAccountByNumberRange(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*model.Transaction)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionsToAccountByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionAggregateByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3267,7 +5722,7 @@ func (ec *executionContext) _Query_transactionsToAccountByNumberRange(ctx contex
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionsToAccountByNumberRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionAggregateByNumberRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3275,7 +5730,7 @@ func (ec *executionContext) _Query_transactionsToAccountByNumberRange(ctx contex
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionsToAccountByNumberRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().TransactionAggregateByNumberRange(rctx, args["from"].(string), args["to"].(string), args["fromAccount"].(*string), args["toAccount"].(*string), args["minValue"].(*string), args["maxValue"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3287,12 +5742,12 @@ func (ec *executionContext) _Query_transactionsToAccountByNumberRange(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Transaction)
+	res := resTmp.(*model.TransactionAggregate)
 	fc.Result = res
-	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
+	return ec.marshalNTransactionAggregate2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionAggregate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionCountToAccountByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transactionAggregateByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3309,7 +5764,7 @@ func (ec *executionContext) _Query_transactionCountToAccountByTimeRange(ctx cont
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionCountToAccountByTimeRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_transactionAggregateByTimeRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3317,7 +5772,7 @@ func (ec *executionContext) _Query_transactionCountToAccountByTimeRange(ctx cont
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionCountToAccountByTimeRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().TransactionAggregateByTimeRange(rctx, args["from"].(string), args["to"].(string), args["fromAccount"].(*string), args["toAccount"].(*string), args["minValue"].(*string), args["maxValue"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3329,12 +5784,12 @@ func (ec *executionContext) _Query_transactionCountToAccountByTimeRange(ctx cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.TransactionAggregate)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTransactionAggregate2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionAggregate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionsToAccountByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_eventsFromContractByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3351,7 +5806,7 @@ func (ec *executionContext) _Query_transactionsToAccountByTimeRange(ctx context.
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionsToAccountByTimeRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_eventsFromContractByNumberRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3359,7 +5814,7 @@ func (ec *executionContext) _Query_transactionsToAccountByTimeRange(ctx context.
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionsToAccountByTimeRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().EventsFromContractByNumberRange(rctx, args["contract"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3371,12 +5826,12 @@ func (ec *executionContext) _Query_transactionsToAccountByTimeRange(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Transaction)
+	res := resTmp.([]*model.Event)
 	fc.Result = res
-	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
+	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionCountBetweenAccountsByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_eventsFromContractByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3393,7 +5848,7 @@ func (ec *executionContext) _Query_transactionCountBetweenAccountsByNumberRange(
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionCountBetweenAccountsByNumberRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_eventsFromContractByTimeRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3401,7 +5856,7 @@ func (ec *executionContext) _Query_transactionCountBetweenAccountsByNumberRange(
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionCountBetweenAccountsByNumberRange(rctx, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().EventsFromContractByTimeRange(rctx, args["contract"].(string), args["from"].(string), args["to"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3413,12 +5868,12 @@ func (ec *executionContext) _Query_transactionCountBetweenAccountsByNumberRange(
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*model.Event)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionsBetweenAccountsByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_eventsByBlockHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3435,7 +5890,7 @@ func (ec *executionContext) _Query_transactionsBetweenAccountsByNumberRange(ctx
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionsBetweenAccountsByNumberRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_eventsByBlockHash_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3443,7 +5898,7 @@ func (ec *executionContext) _Query_transactionsBetweenAccountsByNumberRange(ctx
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionsBetweenAccountsByNumberRange(rctx, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().EventsByBlockHash(rctx, args["hash"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3455,12 +5910,12 @@ func (ec *executionContext) _Query_transactionsBetweenAccountsByNumberRange(ctx
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Transaction)
+	res := resTmp.([]*model.Event)
 	fc.Result = res
-	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
+	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionCountBetweenAccountsByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_eventsByTxHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3477,7 +5932,7 @@ func (ec *executionContext) _Query_transactionCountBetweenAccountsByTimeRange(ct
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionCountBetweenAccountsByTimeRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_eventsByTxHash_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3485,7 +5940,7 @@ func (ec *executionContext) _Query_transactionCountBetweenAccountsByTimeRange(ct
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionCountBetweenAccountsByTimeRange(rctx, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().EventsByTxHash(rctx, args["hash"].(string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3497,12 +5952,12 @@ func (ec *executionContext) _Query_transactionCountBetweenAccountsByTimeRange(ct
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*model.Event)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionsBetweenAccountsByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_eventsFromContractWithTopicsByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3519,7 +5974,7 @@ func (ec *executionContext) _Query_transactionsBetweenAccountsByTimeRange(ctx co
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionsBetweenAccountsByTimeRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_eventsFromContractWithTopicsByNumberRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3527,7 +5982,7 @@ func (ec *executionContext) _Query_transactionsBetweenAccountsByTimeRange(ctx co
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionsBetweenAccountsByTimeRange(rctx, args["fromAccount"].(string), args["toAccount"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().EventsFromContractWithTopicsByNumberRange(rctx, args["contract"].(string), args["from"].(string), args["to"].(string), args["topics"].([]string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3539,12 +5994,12 @@ func (ec *executionContext) _Query_transactionsBetweenAccountsByTimeRange(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Transaction)
+	res := resTmp.([]*model.Event)
 	fc.Result = res
-	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
+	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_contractsCreatedFromAccountByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_eventsFromContractWithTopicsByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3561,7 +6016,7 @@ func (ec *executionContext) _Query_contractsCreatedFromAccountByNumberRange(ctx
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_contractsCreatedFromAccountByNumberRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_eventsFromContractWithTopicsByTimeRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3569,7 +6024,7 @@ func (ec *executionContext) _Query_contractsCreatedFromAccountByNumberRange(ctx
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ContractsCreatedFromAccountByNumberRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().EventsFromContractWithTopicsByTimeRange(rctx, args["contract"].(string), args["from"].(string), args["to"].(string), args["topics"].([]string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3581,12 +6036,12 @@ func (ec *executionContext) _Query_contractsCreatedFromAccountByNumberRange(ctx
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Transaction)
+	res := resTmp.([]*model.Event)
 	fc.Result = res
-	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
+	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_contractsCreatedFromAccountByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_eventsByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3603,7 +6058,7 @@ func (ec *executionContext) _Query_contractsCreatedFromAccountByTimeRange(ctx co
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_contractsCreatedFromAccountByTimeRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_eventsByNumberRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3611,7 +6066,7 @@ func (ec *executionContext) _Query_contractsCreatedFromAccountByTimeRange(ctx co
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ContractsCreatedFromAccountByTimeRange(rctx, args["account"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().EventsByNumberRange(rctx, args["from"].(string), args["to"].(string), args["contracts"].([]string), args["topics"].([][]string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3623,12 +6078,12 @@ func (ec *executionContext) _Query_contractsCreatedFromAccountByTimeRange(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Transaction)
+	res := resTmp.([]*model.Event)
 	fc.Result = res
-	return ec.marshalNTransaction2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionᚄ(ctx, field.Selections, res)
+	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transactionFromAccountWithNonce(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_eventsByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3645,7 +6100,7 @@ func (ec *executionContext) _Query_transactionFromAccountWithNonce(ctx context.C
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transactionFromAccountWithNonce_args(ctx, rawArgs)
+	args, err := ec.field_Query_eventsByTimeRange_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3653,7 +6108,7 @@ func (ec *executionContext) _Query_transactionFromAccountWithNonce(ctx context.C
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TransactionFromAccountWithNonce(rctx, args["account"].(string), args["nonce"].(string))
+		return ec.resolvers.Query().EventsByTimeRange(rctx, args["from"].(string), args["to"].(string), args["contracts"].([]string), args["topics"].([][]string), args["limit"].(*int), args["cursor"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3665,12 +6120,12 @@ func (ec *executionContext) _Query_transactionFromAccountWithNonce(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Transaction)
+	res := resTmp.([]*model.Event)
 	fc.Result = res
-	return ec.marshalNTransaction2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
+	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_eventsFromContractByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_lastXEventsFromContract(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3687,7 +6142,7 @@ func (ec *executionContext) _Query_eventsFromContractByNumberRange(ctx context.C
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_eventsFromContractByNumberRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_lastXEventsFromContract_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3695,7 +6150,7 @@ func (ec *executionContext) _Query_eventsFromContractByNumberRange(ctx context.C
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().EventsFromContractByNumberRange(rctx, args["contract"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().LastXEventsFromContract(rctx, args["contract"].(string), args["x"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3712,7 +6167,7 @@ func (ec *executionContext) _Query_eventsFromContractByNumberRange(ctx context.C
 	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_eventsFromContractByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_eventByBlockHashAndLogIndex(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3729,7 +6184,7 @@ func (ec *executionContext) _Query_eventsFromContractByTimeRange(ctx context.Con
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_eventsFromContractByTimeRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_eventByBlockHashAndLogIndex_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3737,7 +6192,7 @@ func (ec *executionContext) _Query_eventsFromContractByTimeRange(ctx context.Con
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().EventsFromContractByTimeRange(rctx, args["contract"].(string), args["from"].(string), args["to"].(string))
+		return ec.resolvers.Query().EventByBlockHashAndLogIndex(rctx, args["hash"].(string), args["index"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3749,12 +6204,12 @@ func (ec *executionContext) _Query_eventsFromContractByTimeRange(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Event)
+	res := resTmp.(*model.Event)
 	fc.Result = res
-	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
+	return ec.marshalNEvent2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEvent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_eventsByBlockHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_eventByBlockNumberAndLogIndex(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3771,7 +6226,7 @@ func (ec *executionContext) _Query_eventsByBlockHash(ctx context.Context, field
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_eventsByBlockHash_args(ctx, rawArgs)
+	args, err := ec.field_Query_eventByBlockNumberAndLogIndex_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3779,7 +6234,7 @@ func (ec *executionContext) _Query_eventsByBlockHash(ctx context.Context, field
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().EventsByBlockHash(rctx, args["hash"].(string))
+		return ec.resolvers.Query().EventByBlockNumberAndLogIndex(rctx, args["number"].(string), args["index"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3791,12 +6246,12 @@ func (ec *executionContext) _Query_eventsByBlockHash(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Event)
+	res := resTmp.(*model.Event)
 	fc.Result = res
-	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
+	return ec.marshalNEvent2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEvent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_eventsByTxHash(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_account(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3813,7 +6268,7 @@ func (ec *executionContext) _Query_eventsByTxHash(ctx context.Context, field gra
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_eventsByTxHash_args(ctx, rawArgs)
+	args, err := ec.field_Query_account_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3821,7 +6276,7 @@ func (ec *executionContext) _Query_eventsByTxHash(ctx context.Context, field gra
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().EventsByTxHash(rctx, args["hash"].(string))
+		return ec.resolvers.Query().Account(rctx, args["address"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3833,12 +6288,12 @@ func (ec *executionContext) _Query_eventsByTxHash(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Event)
+	res := resTmp.(*model.Account)
 	fc.Result = res
-	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
+	return ec.marshalNAccount2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐAccount(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_eventsFromContractWithTopicsByNumberRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_chainStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3855,7 +6310,7 @@ func (ec *executionContext) _Query_eventsFromContractWithTopicsByNumberRange(ctx
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_eventsFromContractWithTopicsByNumberRange_args(ctx, rawArgs)
+	args, err := ec.field_Query_chainStats_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3863,7 +6318,7 @@ func (ec *executionContext) _Query_eventsFromContractWithTopicsByNumberRange(ctx
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().EventsFromContractWithTopicsByNumberRange(rctx, args["contract"].(string), args["from"].(string), args["to"].(string), args["topics"].([]string))
+		return ec.resolvers.Query().ChainStats(rctx, args["interval"].(string), args["from"].(string), args["to"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3875,12 +6330,12 @@ func (ec *executionContext) _Query_eventsFromContractWithTopicsByNumberRange(ctx
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Event)
+	res := resTmp.([]*model.ChainStat)
 	fc.Result = res
-	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
+	return ec.marshalNChainStat2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐChainStatᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_eventsFromContractWithTopicsByTimeRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3892,12 +6347,12 @@ func (ec *executionContext) _Query_eventsFromContractWithTopicsByTimeRange(ctx c
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_eventsFromContractWithTopicsByTimeRange_args(ctx, rawArgs)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3905,24 +6360,21 @@ func (ec *executionContext) _Query_eventsFromContractWithTopicsByTimeRange(ctx c
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().EventsFromContractWithTopicsByTimeRange(rctx, args["contract"].(string), args["from"].(string), args["to"].(string), args["topics"].([]string))
+		return ec.introspectType(args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Event)
+	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_lastXEventsFromContract(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3934,37 +6386,27 @@ func (ec *executionContext) _Query_lastXEventsFromContract(ctx context.Context,
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_lastXEventsFromContract_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().LastXEventsFromContract(rctx, args["contract"].(string), args["x"].(int))
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Event)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalNEvent2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEventᚄ(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_eventByBlockHashAndLogIndex(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_hash(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3972,24 +6414,17 @@ func (ec *executionContext) _Query_eventByBlockHashAndLogIndex(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Transaction",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_eventByBlockHashAndLogIndex_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().EventByBlockHashAndLogIndex(rctx, args["hash"].(string), args["index"].(string))
+		return obj.Hash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4001,12 +6436,12 @@ func (ec *executionContext) _Query_eventByBlockHashAndLogIndex(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Event)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNEvent2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEvent(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_eventByBlockNumberAndLogIndex(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_from(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4014,24 +6449,17 @@ func (ec *executionContext) _Query_eventByBlockNumberAndLogIndex(ctx context.Con
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Transaction",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_eventByBlockNumberAndLogIndex_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().EventByBlockNumberAndLogIndex(rctx, args["number"].(string), args["index"].(string))
+		return obj.From, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4043,12 +6471,12 @@ func (ec *executionContext) _Query_eventByBlockNumberAndLogIndex(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Event)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNEvent2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEvent(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_to(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4056,38 +6484,34 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Transaction",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return obj.To, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_contract(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4095,31 +6519,34 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Transaction",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.Contract, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_hash(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_value(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4137,7 +6564,7 @@ func (ec *executionContext) _Transaction_hash(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4154,7 +6581,7 @@ func (ec *executionContext) _Transaction_hash(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_from(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_data(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4172,7 +6599,7 @@ func (ec *executionContext) _Transaction_from(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.From, nil
+		return obj.Data, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4189,7 +6616,7 @@ func (ec *executionContext) _Transaction_from(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_to(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_gas(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4207,7 +6634,7 @@ func (ec *executionContext) _Transaction_to(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.To, nil
+		return obj.Gas, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4224,7 +6651,7 @@ func (ec *executionContext) _Transaction_to(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_contract(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_gasPrice(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4242,7 +6669,7 @@ func (ec *executionContext) _Transaction_contract(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Contract, nil
+		return obj.GasPrice, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4259,7 +6686,7 @@ func (ec *executionContext) _Transaction_contract(ctx context.Context, field gra
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_value(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_cost(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4277,7 +6704,7 @@ func (ec *executionContext) _Transaction_value(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Cost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4294,7 +6721,7 @@ func (ec *executionContext) _Transaction_value(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_data(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_nonce(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4312,7 +6739,7 @@ func (ec *executionContext) _Transaction_data(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
+		return obj.Nonce, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4329,7 +6756,7 @@ func (ec *executionContext) _Transaction_data(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_gas(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_state(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4347,7 +6774,7 @@ func (ec *executionContext) _Transaction_gas(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Gas, nil
+		return obj.State, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4364,7 +6791,7 @@ func (ec *executionContext) _Transaction_gas(ctx context.Context, field graphql.
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_gasPrice(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_blockHash(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4382,7 +6809,7 @@ func (ec *executionContext) _Transaction_gasPrice(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.GasPrice, nil
+		return obj.BlockHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4399,7 +6826,7 @@ func (ec *executionContext) _Transaction_gasPrice(ctx context.Context, field gra
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_cost(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4417,7 +6844,7 @@ func (ec *executionContext) _Transaction_cost(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cost, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4434,7 +6861,7 @@ func (ec *executionContext) _Transaction_cost(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_nonce(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransactionAggregate_count(ctx context.Context, field graphql.CollectedField, obj *model.TransactionAggregate) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4442,7 +6869,7 @@ func (ec *executionContext) _Transaction_nonce(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "TransactionAggregate",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4452,7 +6879,7 @@ func (ec *executionContext) _Transaction_nonce(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Nonce, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4464,12 +6891,12 @@ func (ec *executionContext) _Transaction_nonce(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_state(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransactionAggregate_totalValue(ctx context.Context, field graphql.CollectedField, obj *model.TransactionAggregate) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4477,7 +6904,7 @@ func (ec *executionContext) _Transaction_state(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "TransactionAggregate",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4487,7 +6914,7 @@ func (ec *executionContext) _Transaction_state(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.State, nil
+		return obj.TotalValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4504,7 +6931,7 @@ func (ec *executionContext) _Transaction_state(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_blockHash(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _TransactionAggregate_averageValue(ctx context.Context, field graphql.CollectedField, obj *model.TransactionAggregate) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4512,7 +6939,7 @@ func (ec *executionContext) _Transaction_blockHash(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Transaction",
+		Object:     "TransactionAggregate",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4522,7 +6949,7 @@ func (ec *executionContext) _Transaction_blockHash(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BlockHash, nil
+		return obj.AverageValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5634,6 +8061,69 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 
 // region    **************************** object.gotpl ****************************
 
+var accountImplementors = []string{"Account"}
+
+func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet, obj *model.Account) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, accountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Account")
+		case "address":
+			out.Values[i] = ec._Account_address(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "firstSeen":
+			out.Values[i] = ec._Account_firstSeen(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lastSeen":
+			out.Values[i] = ec._Account_lastSeen(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sent":
+			out.Values[i] = ec._Account_sent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "received":
+			out.Values[i] = ec._Account_received(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deployed":
+			out.Values[i] = ec._Account_deployed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "nonce":
+			out.Values[i] = ec._Account_nonce(ctx, field, obj)
+		case "contract":
+			out.Values[i] = ec._Account_contract(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Account_createdAt(ctx, field, obj)
+		case "emitted":
+			out.Values[i] = ec._Account_emitted(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var blockImplementors = []string{"Block"}
 
 func (ec *executionContext) _Block(ctx context.Context, sel ast.SelectionSet, obj *model.Block) graphql.Marshaler {
@@ -5720,6 +8210,75 @@ func (ec *executionContext) _Block(ctx context.Context, sel ast.SelectionSet, ob
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "cursor":
+			out.Values[i] = ec._Block_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var chainStatImplementors = []string{"ChainStat"}
+
+func (ec *executionContext) _ChainStat(ctx context.Context, sel ast.SelectionSet, obj *model.ChainStat) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chainStatImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChainStat")
+		case "time":
+			out.Values[i] = ec._ChainStat_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "blocks":
+			out.Values[i] = ec._ChainStat_blocks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "transactions":
+			out.Values[i] = ec._ChainStat_transactions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "gasUsed":
+			out.Values[i] = ec._ChainStat_gasUsed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "averageGasPrice":
+			out.Values[i] = ec._ChainStat_averageGasPrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "averageBaseFee":
+			out.Values[i] = ec._ChainStat_averageBaseFee(ctx, field, obj)
+		case "activeSenders":
+			out.Values[i] = ec._ChainStat_activeSenders(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "contracts":
+			out.Values[i] = ec._ChainStat_contracts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "events":
+			out.Values[i] = ec._ChainStat_events(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5772,6 +8331,11 @@ func (ec *executionContext) _Event(ctx context.Context, sel ast.SelectionSet, ob
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "cursor":
+			out.Values[i] = ec._Event_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5854,6 +8418,20 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				}
 				return res
 			})
+		case "blockByTime":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_blockByTime(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "transaction":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -5904,13 +8482,125 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionCountByBlockNumber(ctx, field)
+				res = ec._Query_transactionCountByBlockNumber(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "transactionsByBlockNumber":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_transactionsByBlockNumber(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "transactionCountFromAccountByNumberRange":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_transactionCountFromAccountByNumberRange(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "transactionsFromAccountByNumberRange":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_transactionsFromAccountByNumberRange(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "transactionCountFromAccountByTimeRange":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_transactionCountFromAccountByTimeRange(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "transactionsFromAccountByTimeRange":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_transactionsFromAccountByTimeRange(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "transactionCountToAccountByNumberRange":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_transactionCountToAccountByNumberRange(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "transactionsToAccountByNumberRange":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_transactionsToAccountByNumberRange(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "transactionCountToAccountByTimeRange":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_transactionCountToAccountByTimeRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionsByBlockNumber":
+		case "transactionsToAccountByTimeRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -5918,13 +8608,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionsByBlockNumber(ctx, field)
+				res = ec._Query_transactionsToAccountByTimeRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionCountFromAccountByNumberRange":
+		case "transactionCountBetweenAccountsByNumberRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -5932,13 +8622,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionCountFromAccountByNumberRange(ctx, field)
+				res = ec._Query_transactionCountBetweenAccountsByNumberRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionsFromAccountByNumberRange":
+		case "transactionsBetweenAccountsByNumberRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -5946,13 +8636,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionsFromAccountByNumberRange(ctx, field)
+				res = ec._Query_transactionsBetweenAccountsByNumberRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionCountFromAccountByTimeRange":
+		case "transactionCountBetweenAccountsByTimeRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -5960,13 +8650,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionCountFromAccountByTimeRange(ctx, field)
+				res = ec._Query_transactionCountBetweenAccountsByTimeRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionsFromAccountByTimeRange":
+		case "transactionsBetweenAccountsByTimeRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -5974,13 +8664,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionsFromAccountByTimeRange(ctx, field)
+				res = ec._Query_transactionsBetweenAccountsByTimeRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionCountToAccountByNumberRange":
+		case "contractsCreatedFromAccountByNumberRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -5988,13 +8678,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionCountToAccountByNumberRange(ctx, field)
+				res = ec._Query_contractsCreatedFromAccountByNumberRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionsToAccountByNumberRange":
+		case "contractsCreatedFromAccountByTimeRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6002,13 +8692,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionsToAccountByNumberRange(ctx, field)
+				res = ec._Query_contractsCreatedFromAccountByTimeRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionCountToAccountByTimeRange":
+		case "transactionFromAccountWithNonce":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6016,13 +8706,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionCountToAccountByTimeRange(ctx, field)
+				res = ec._Query_transactionFromAccountWithNonce(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionsToAccountByTimeRange":
+		case "transactionsByNumberRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6030,13 +8720,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionsToAccountByTimeRange(ctx, field)
+				res = ec._Query_transactionsByNumberRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionCountBetweenAccountsByNumberRange":
+		case "transactionsByTimeRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6044,13 +8734,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionCountBetweenAccountsByNumberRange(ctx, field)
+				res = ec._Query_transactionsByTimeRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionsBetweenAccountsByNumberRange":
+		case "transactionAggregateByNumberRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6058,13 +8748,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionsBetweenAccountsByNumberRange(ctx, field)
+				res = ec._Query_transactionAggregateByNumberRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionCountBetweenAccountsByTimeRange":
+		case "transactionAggregateByTimeRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6072,13 +8762,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionCountBetweenAccountsByTimeRange(ctx, field)
+				res = ec._Query_transactionAggregateByTimeRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionsBetweenAccountsByTimeRange":
+		case "eventsFromContractByNumberRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6086,13 +8776,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionsBetweenAccountsByTimeRange(ctx, field)
+				res = ec._Query_eventsFromContractByNumberRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "contractsCreatedFromAccountByNumberRange":
+		case "eventsFromContractByTimeRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6100,13 +8790,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_contractsCreatedFromAccountByNumberRange(ctx, field)
+				res = ec._Query_eventsFromContractByTimeRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "contractsCreatedFromAccountByTimeRange":
+		case "eventsByBlockHash":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6114,13 +8804,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_contractsCreatedFromAccountByTimeRange(ctx, field)
+				res = ec._Query_eventsByBlockHash(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "transactionFromAccountWithNonce":
+		case "eventsByTxHash":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6128,13 +8818,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_transactionFromAccountWithNonce(ctx, field)
+				res = ec._Query_eventsByTxHash(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "eventsFromContractByNumberRange":
+		case "eventsFromContractWithTopicsByNumberRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6142,13 +8832,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_eventsFromContractByNumberRange(ctx, field)
+				res = ec._Query_eventsFromContractWithTopicsByNumberRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "eventsFromContractByTimeRange":
+		case "eventsFromContractWithTopicsByTimeRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6156,13 +8846,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_eventsFromContractByTimeRange(ctx, field)
+				res = ec._Query_eventsFromContractWithTopicsByTimeRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "eventsByBlockHash":
+		case "eventsByNumberRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6170,13 +8860,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_eventsByBlockHash(ctx, field)
+				res = ec._Query_eventsByNumberRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "eventsByTxHash":
+		case "eventsByTimeRange":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6184,13 +8874,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_eventsByTxHash(ctx, field)
+				res = ec._Query_eventsByTimeRange(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "eventsFromContractWithTopicsByNumberRange":
+		case "lastXEventsFromContract":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6198,13 +8888,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_eventsFromContractWithTopicsByNumberRange(ctx, field)
+				res = ec._Query_lastXEventsFromContract(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "eventsFromContractWithTopicsByTimeRange":
+		case "eventByBlockHashAndLogIndex":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6212,13 +8902,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_eventsFromContractWithTopicsByTimeRange(ctx, field)
+				res = ec._Query_eventByBlockHashAndLogIndex(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "lastXEventsFromContract":
+		case "eventByBlockNumberAndLogIndex":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6226,13 +8916,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_lastXEventsFromContract(ctx, field)
+				res = ec._Query_eventByBlockNumberAndLogIndex(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "eventByBlockHashAndLogIndex":
+		case "account":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6240,13 +8930,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_eventByBlockHashAndLogIndex(ctx, field)
+				res = ec._Query_account(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "eventByBlockNumberAndLogIndex":
+		case "chainStats":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -6254,7 +8944,7 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_eventByBlockNumberAndLogIndex(ctx, field)
+				res = ec._Query_chainStats(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -6346,6 +9036,48 @@ func (ec *executionContext) _Transaction(ctx context.Context, sel ast.SelectionS
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "cursor":
+			out.Values[i] = ec._Transaction_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var transactionAggregateImplementors = []string{"TransactionAggregate"}
+
+func (ec *executionContext) _TransactionAggregate(ctx context.Context, sel ast.SelectionSet, obj *model.TransactionAggregate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, transactionAggregateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TransactionAggregate")
+		case "count":
+			out.Values[i] = ec._TransactionAggregate_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "totalValue":
+			out.Values[i] = ec._TransactionAggregate_totalValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "averageValue":
+			out.Values[i] = ec._TransactionAggregate_averageValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -6602,6 +9334,20 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNAccount2githubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐAccount(ctx context.Context, sel ast.SelectionSet, v model.Account) graphql.Marshaler {
+	return ec._Account(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAccount2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐAccount(ctx context.Context, sel ast.SelectionSet, v *model.Account) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Account(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNBlock2githubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐBlock(ctx context.Context, sel ast.SelectionSet, v model.Block) graphql.Marshaler {
 	return ec._Block(ctx, sel, &v)
 }
@@ -6668,6 +9414,53 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) marshalNChainStat2ᚕᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐChainStatᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ChainStat) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNChainStat2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐChainStat(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNChainStat2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐChainStat(ctx context.Context, sel ast.SelectionSet, v *model.ChainStat) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ChainStat(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNEvent2githubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐEvent(ctx context.Context, sel ast.SelectionSet, v model.Event) graphql.Marshaler {
 	return ec._Event(ctx, sel, &v)
 }
@@ -6845,6 +9638,20 @@ func (ec *executionContext) marshalNTransaction2ᚖgithubᚗcomᚋitzmeanjanᚋe
 	return ec._Transaction(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNTransactionAggregate2githubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionAggregate(ctx context.Context, sel ast.SelectionSet, v model.TransactionAggregate) graphql.Marshaler {
+	return ec._TransactionAggregate(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNTransactionAggregate2ᚖgithubᚗcomᚋitzmeanjanᚋetteᚋappᚋrestᚋgraphᚋmodelᚐTransactionAggregate(ctx context.Context, sel ast.SelectionSet, v *model.TransactionAggregate) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._TransactionAggregate(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -7098,6 +9905,21 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return graphql.MarshalBoolean(*v)
 }
 
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*v)
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -7107,6 +9929,78 @@ func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.S
 	return graphql.MarshalString(v)
 }
 
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOString2ᚕᚕstring(ctx context.Context, v interface{}) ([][]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([][]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalOString2ᚕstringᚄ(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕᚕstring(ctx context.Context, sel ast.SelectionSet, v [][]string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalOString2ᚕstringᚄ(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -2,6 +2,19 @@
 
 package model
 
+type Account struct {
+	Address   string  `json:"address"`
+	FirstSeen string  `json:"firstSeen"`
+	LastSeen  string  `json:"lastSeen"`
+	Sent      string  `json:"sent"`
+	Received  string  `json:"received"`
+	Deployed  string  `json:"deployed"`
+	Nonce     *string `json:"nonce"`
+	Contract  bool    `json:"contract"`
+	CreatedAt *string `json:"createdAt"`
+	Emitted   *string `json:"emitted"`
+}
+
 type Block struct {
 	Hash            string  `json:"hash"`
 	Number          string  `json:"number"`
@@ -18,6 +31,19 @@ type Block struct {
 	TxRootHash      string  `json:"txRootHash"`
 	ReceiptRootHash string  `json:"receiptRootHash"`
 	ExtraData       string  `json:"extraData"`
+	Cursor          string  `json:"cursor"`
+}
+
+type ChainStat struct {
+	Time            string  `json:"time"`
+	Blocks          string  `json:"blocks"`
+	Transactions    string  `json:"transactions"`
+	GasUsed         string  `json:"gasUsed"`
+	AverageGasPrice string  `json:"averageGasPrice"`
+	AverageBaseFee  *string `json:"averageBaseFee"`
+	ActiveSenders   string  `json:"activeSenders"`
+	Contracts       string  `json:"contracts"`
+	Events          string  `json:"events"`
 }
 
 type Event struct {
@@ -27,6 +53,7 @@ type Event struct {
 	Data      string   `json:"data"`
 	TxHash    string   `json:"txHash"`
 	BlockHash string   `json:"blockHash"`
+	Cursor    string   `json:"cursor"`
 }
 
 type Transaction struct {
@@ -42,4 +69,11 @@ type Transaction struct {
 	Nonce     string `json:"nonce"`
 	State     string `json:"state"`
 	BlockHash string `json:"blockHash"`
+	Cursor    string `json:"cursor"`
+}
+
+type TransactionAggregate struct {
+	Count        int    `json:"count"`
+	TotalValue   string `json:"totalValue"`
+	AverageValue string `json:"averageValue"`
 }
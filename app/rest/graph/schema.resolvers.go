@@ -13,7 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	cmn "github.com/itzmeanjan/ette/app/common"
 	cfg "github.com/itzmeanjan/ette/app/config"
-	_db "github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/rest/graph/generated"
 	"github.com/itzmeanjan/ette/app/rest/graph/model"
 )
@@ -23,7 +23,7 @@ func (r *queryResolver) BlockByHash(ctx context.Context, hash string) (*model.Bl
 		return nil, errors.New("Bad Block Hash")
 	}
 
-	return getGraphQLCompatibleBlock(ctx, _db.GetBlockByHash(db, common.HexToHash(hash)), true)
+	return getGraphQLCompatibleBlock(ctx, db.GetBlockByHash(common.HexToHash(hash)), true)
 }
 
 func (r *queryResolver) BlockByNumber(ctx context.Context, number string) (*model.Block, error) {
@@ -32,25 +32,58 @@ func (r *queryResolver) BlockByNumber(ctx context.Context, number string) (*mode
 		return nil, errors.New("Bad Block Number")
 	}
 
-	return getGraphQLCompatibleBlock(ctx, _db.GetBlockByNumber(db, _number), true)
+	return getGraphQLCompatibleBlock(ctx, db.GetBlockByNumber(_number), true)
 }
 
-func (r *queryResolver) BlocksByNumberRange(ctx context.Context, from string, to string) ([]*model.Block, error) {
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+func (r *queryResolver) BlocksByNumberRange(ctx context.Context, from string, to string, limit *int, cursor *string) ([]*model.Block, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetBlockNumberRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleBlocks(ctx, _db.GetBlocksByNumberRange(db, _from, _to))
+	return getGraphQLCompatibleBlocks(ctx, db.GetBlocksByNumberRange(_from, _to, page))
 }
 
-func (r *queryResolver) BlocksByTimeRange(ctx context.Context, from string, to string) ([]*model.Block, error) {
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+func (r *queryResolver) BlocksByTimeRange(ctx context.Context, from string, to string, limit *int, cursor *string) ([]*model.Block, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetTimeRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleBlocks(ctx, _db.GetBlocksByTimeRange(db, _from, _to))
+	return getGraphQLCompatibleBlocks(ctx, db.GetBlocksByTimeRange(_from, _to, page))
+}
+
+func (r *queryResolver) BlockByTime(ctx context.Context, at string, direction *string) (*model.Block, error) {
+	_at, err := cmn.ParseNumber(at)
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp")
+	}
+
+	after := true
+	if direction != nil {
+		if !(*direction == "after" || *direction == "before") {
+			return nil, errors.New("Bad Direction")
+		}
+
+		after = *direction == "after"
+	}
+
+	block, err := db.GetBlockByTime(_at, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return getGraphQLCompatibleBlock(ctx, block, true)
 }
 
 func (r *queryResolver) Transaction(ctx context.Context, hash string) (*model.Transaction, error) {
@@ -58,7 +91,7 @@ func (r *queryResolver) Transaction(ctx context.Context, hash string) (*model.Tr
 		return nil, errors.New("Bad Transaction Hash")
 	}
 
-	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionByHash(db, common.HexToHash(hash)), true)
+	return getGraphQLCompatibleTransaction(ctx, db.GetTransactionByHash(common.HexToHash(hash)), true)
 }
 
 func (r *queryResolver) TransactionCountByBlockHash(ctx context.Context, hash string) (int, error) {
@@ -66,7 +99,7 @@ func (r *queryResolver) TransactionCountByBlockHash(ctx context.Context, hash st
 		return 0, errors.New("Bad Block Hash")
 	}
 
-	count := int(_db.GetTransactionCountByBlockHash(db, common.HexToHash(hash)))
+	count := int(db.GetTransactionCountByBlockHash(common.HexToHash(hash)))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -81,12 +114,17 @@ func (r *queryResolver) TransactionCountByBlockHash(ctx context.Context, hash st
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsByBlockHash(ctx context.Context, hash string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsByBlockHash(ctx context.Context, hash string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return nil, errors.New("Bad Block Hash")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsByBlockHash(db, common.HexToHash(hash)))
+	return getGraphQLCompatibleTransactions(ctx, db.GetTransactionsByBlockHash(common.HexToHash(hash), page))
 }
 
 func (r *queryResolver) TransactionCountByBlockNumber(ctx context.Context, number string) (int, error) {
@@ -95,7 +133,7 @@ func (r *queryResolver) TransactionCountByBlockNumber(ctx context.Context, numbe
 		return 0, errors.New("Bad Block Number")
 	}
 
-	count := int(_db.GetTransactionCountByBlockNumber(db, _number))
+	count := int(db.GetTransactionCountByBlockNumber(_number))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -110,13 +148,18 @@ func (r *queryResolver) TransactionCountByBlockNumber(ctx context.Context, numbe
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsByBlockNumber(ctx context.Context, number string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsByBlockNumber(ctx context.Context, number string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	_number, err := strconv.ParseUint(number, 10, 64)
 	if err != nil {
 		return nil, errors.New("Bad Block Number")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsByBlockNumber(db, _number))
+	return getGraphQLCompatibleTransactions(ctx, db.GetTransactionsByBlockNumber(_number, page))
 }
 
 func (r *queryResolver) TransactionCountFromAccountByNumberRange(ctx context.Context, account string, from string, to string) (int, error) {
@@ -129,7 +172,7 @@ func (r *queryResolver) TransactionCountFromAccountByNumberRange(ctx context.Con
 		return 0, errors.New("Bad Block Number Range")
 	}
 
-	count := int(_db.GetTransactionCountFromAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	count := int(db.GetTransactionCountFromAccountByBlockNumberRange(common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -144,17 +187,22 @@ func (r *queryResolver) TransactionCountFromAccountByNumberRange(ctx context.Con
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsFromAccountByNumberRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsFromAccountByNumberRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetBlockNumberRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsFromAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, db.GetTransactionsFromAccountByBlockNumberRange(common.HexToAddress(account), _from, _to, page))
 }
 
 func (r *queryResolver) TransactionCountFromAccountByTimeRange(ctx context.Context, account string, from string, to string) (int, error) {
@@ -167,7 +215,7 @@ func (r *queryResolver) TransactionCountFromAccountByTimeRange(ctx context.Conte
 		return 0, errors.New("Bad Block Timestamp Range")
 	}
 
-	count := int(_db.GetTransactionCountFromAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	count := int(db.GetTransactionCountFromAccountByBlockTimeRange(common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -182,17 +230,22 @@ func (r *queryResolver) TransactionCountFromAccountByTimeRange(ctx context.Conte
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsFromAccountByTimeRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsFromAccountByTimeRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetTimeRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsFromAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, db.GetTransactionsFromAccountByBlockTimeRange(common.HexToAddress(account), _from, _to, page))
 }
 
 func (r *queryResolver) TransactionCountToAccountByNumberRange(ctx context.Context, account string, from string, to string) (int, error) {
@@ -205,7 +258,7 @@ func (r *queryResolver) TransactionCountToAccountByNumberRange(ctx context.Conte
 		return 0, errors.New("Bad Block Number Range")
 	}
 
-	count := int(_db.GetTransactionCountToAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	count := int(db.GetTransactionCountToAccountByBlockNumberRange(common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -220,17 +273,22 @@ func (r *queryResolver) TransactionCountToAccountByNumberRange(ctx context.Conte
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsToAccountByNumberRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsToAccountByNumberRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetBlockNumberRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsToAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, db.GetTransactionsToAccountByBlockNumberRange(common.HexToAddress(account), _from, _to, page))
 }
 
 func (r *queryResolver) TransactionCountToAccountByTimeRange(ctx context.Context, account string, from string, to string) (int, error) {
@@ -243,7 +301,7 @@ func (r *queryResolver) TransactionCountToAccountByTimeRange(ctx context.Context
 		return 0, errors.New("Bad Block Timestamp Range")
 	}
 
-	count := int(_db.GetTransactionCountToAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	count := int(db.GetTransactionCountToAccountByBlockTimeRange(common.HexToAddress(account), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -258,17 +316,22 @@ func (r *queryResolver) TransactionCountToAccountByTimeRange(ctx context.Context
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsToAccountByTimeRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsToAccountByTimeRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetTimeRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsToAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, db.GetTransactionsToAccountByBlockTimeRange(common.HexToAddress(account), _from, _to, page))
 }
 
 func (r *queryResolver) TransactionCountBetweenAccountsByNumberRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) (int, error) {
@@ -285,7 +348,7 @@ func (r *queryResolver) TransactionCountBetweenAccountsByNumberRange(ctx context
 		return 0, errors.New("Bad Block Number Range")
 	}
 
-	count := int(_db.GetTransactionCountBetweenAccountsByBlockNumberRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	count := int(db.GetTransactionCountBetweenAccountsByBlockNumberRange(common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -300,7 +363,12 @@ func (r *queryResolver) TransactionCountBetweenAccountsByNumberRange(ctx context
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsBetweenAccountsByNumberRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsBetweenAccountsByNumberRange(ctx context.Context, fromAccount string, toAccount string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(fromAccount, "0x") && len(fromAccount) == 42) {
 		return nil, errors.New("Bad From Account Address")
 	}
@@ -309,12 +377,12 @@ func (r *queryResolver) TransactionsBetweenAccountsByNumberRange(ctx context.Con
 		return nil, errors.New("Bad To Account Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetBlockNumberRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsBetweenAccountsByBlockNumberRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, db.GetTransactionsBetweenAccountsByBlockNumberRange(common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to, page))
 }
 
 func (r *queryResolver) TransactionCountBetweenAccountsByTimeRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) (int, error) {
@@ -331,7 +399,7 @@ func (r *queryResolver) TransactionCountBetweenAccountsByTimeRange(ctx context.C
 		return 0, errors.New("Bad Block Timestamp Range")
 	}
 
-	count := int(_db.GetTransactionCountBetweenAccountsByBlockTimeRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	count := int(db.GetTransactionCountBetweenAccountsByBlockTimeRange(common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
 
 	// Attempting to calculate byte form of number
 	// so that we can keep track of how much data was transferred
@@ -346,7 +414,12 @@ func (r *queryResolver) TransactionCountBetweenAccountsByTimeRange(ctx context.C
 	return count, nil
 }
 
-func (r *queryResolver) TransactionsBetweenAccountsByTimeRange(ctx context.Context, fromAccount string, toAccount string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) TransactionsBetweenAccountsByTimeRange(ctx context.Context, fromAccount string, toAccount string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(fromAccount, "0x") && len(fromAccount) == 42) {
 		return nil, errors.New("Bad From Account Address")
 	}
@@ -355,38 +428,48 @@ func (r *queryResolver) TransactionsBetweenAccountsByTimeRange(ctx context.Conte
 		return nil, errors.New("Bad To Account Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetTimeRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetTransactionsBetweenAccountsByBlockTimeRange(db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, db.GetTransactionsBetweenAccountsByBlockTimeRange(common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _from, _to, page))
 }
 
-func (r *queryResolver) ContractsCreatedFromAccountByNumberRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) ContractsCreatedFromAccountByNumberRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetBlockNumberRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetContractCreationTransactionsFromAccountByBlockNumberRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, db.GetContractCreationTransactionsFromAccountByBlockNumberRange(common.HexToAddress(account), _from, _to, page))
 }
 
-func (r *queryResolver) ContractsCreatedFromAccountByTimeRange(ctx context.Context, account string, from string, to string) ([]*model.Transaction, error) {
+func (r *queryResolver) ContractsCreatedFromAccountByTimeRange(ctx context.Context, account string, from string, to string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
 		return nil, errors.New("Bad Account Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetTimeRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleTransactions(ctx, _db.GetContractCreationTransactionsFromAccountByBlockTimeRange(db, common.HexToAddress(account), _from, _to))
+	return getGraphQLCompatibleTransactions(ctx, db.GetContractCreationTransactionsFromAccountByBlockTimeRange(common.HexToAddress(account), _from, _to, page))
 }
 
 func (r *queryResolver) TransactionFromAccountWithNonce(ctx context.Context, account string, nonce string) (*model.Transaction, error) {
@@ -399,75 +482,209 @@ func (r *queryResolver) TransactionFromAccountWithNonce(ctx context.Context, acc
 		return nil, errors.New("Bad Account Nonce")
 	}
 
-	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionFromAccountWithNonce(db, common.HexToAddress(account), _nonce), true)
+	return getGraphQLCompatibleTransaction(ctx, db.GetTransactionFromAccountWithNonce(common.HexToAddress(account), _nonce), true)
+}
+
+func (r *queryResolver) TransactionsByNumberRange(ctx context.Context, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := buildTransactionFilter(fromAccount, toAccount, minValue, maxValue)
+	if err != nil {
+		return nil, err
+	}
+
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetBlockNumberRange(), page != nil)
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleTransactions(ctx, db.GetTransactionsByBlockNumberRangeWithFilter(filter, _from, _to, page))
 }
 
-func (r *queryResolver) EventsFromContractByNumberRange(ctx context.Context, contract string, from string, to string) ([]*model.Event, error) {
+func (r *queryResolver) TransactionsByTimeRange(ctx context.Context, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string, limit *int, cursor *string) ([]*model.Transaction, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := buildTransactionFilter(fromAccount, toAccount, minValue, maxValue)
+	if err != nil {
+		return nil, err
+	}
+
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetTimeRange(), page != nil)
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleTransactions(ctx, db.GetTransactionsByBlockTimeRangeWithFilter(filter, _from, _to, page))
+}
+
+func (r *queryResolver) TransactionAggregateByNumberRange(ctx context.Context, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string) (*model.TransactionAggregate, error) {
+	filter, err := buildTransactionFilter(fromAccount, toAccount, minValue, maxValue)
+	if err != nil {
+		return nil, err
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleTransactionAggregate(ctx, db.GetTransactionAggregateByBlockNumberRange(filter, _from, _to))
+}
+
+func (r *queryResolver) TransactionAggregateByTimeRange(ctx context.Context, from string, to string, fromAccount *string, toAccount *string, minValue *string, maxValue *string) (*model.TransactionAggregate, error) {
+	filter, err := buildTransactionFilter(fromAccount, toAccount, minValue, maxValue)
+	if err != nil {
+		return nil, err
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleTransactionAggregate(ctx, db.GetTransactionAggregateByBlockTimeRange(filter, _from, _to))
+}
+
+func (r *queryResolver) EventsFromContractByNumberRange(ctx context.Context, contract string, from string, to string, limit *int, cursor *string) ([]*model.Event, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(contract, "0x") && len(contract) == 42) {
 		return nil, errors.New("Bad Contract Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetBlockNumberRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractByBlockNumberRange(db, common.HexToAddress(contract), _from, _to))
+	return getGraphQLCompatibleEvents(ctx, db.GetEventsFromContractByBlockNumberRange(common.HexToAddress(contract), _from, _to, page))
 }
 
-func (r *queryResolver) EventsFromContractByTimeRange(ctx context.Context, contract string, from string, to string) ([]*model.Event, error) {
+func (r *queryResolver) EventsFromContractByTimeRange(ctx context.Context, contract string, from string, to string, limit *int, cursor *string) ([]*model.Event, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(contract, "0x") && len(contract) == 42) {
 		return nil, errors.New("Bad Contract Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetTimeRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractByBlockTimeRange(db, common.HexToAddress(contract), _from, _to))
+	return getGraphQLCompatibleEvents(ctx, db.GetEventsFromContractByBlockTimeRange(common.HexToAddress(contract), _from, _to, page))
 }
 
-func (r *queryResolver) EventsByBlockHash(ctx context.Context, hash string) ([]*model.Event, error) {
+func (r *queryResolver) EventsByBlockHash(ctx context.Context, hash string, limit *int, cursor *string) ([]*model.Event, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return nil, errors.New("Bad Block Hash")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsByBlockHash(db, common.HexToHash(hash)))
+	return getGraphQLCompatibleEvents(ctx, db.GetEventsByBlockHash(common.HexToHash(hash), page))
 }
 
-func (r *queryResolver) EventsByTxHash(ctx context.Context, hash string) ([]*model.Event, error) {
+func (r *queryResolver) EventsByTxHash(ctx context.Context, hash string, limit *int, cursor *string) ([]*model.Event, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(hash, "0x") && len(hash) == 66) {
 		return nil, errors.New("Bad Transaction Hash")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsByTransactionHash(db, common.HexToHash(hash)))
+	return getGraphQLCompatibleEvents(ctx, db.GetEventsByTransactionHash(common.HexToHash(hash), page))
 }
 
-func (r *queryResolver) EventsFromContractWithTopicsByNumberRange(ctx context.Context, contract string, from string, to string, topics []string) ([]*model.Event, error) {
+func (r *queryResolver) EventsFromContractWithTopicsByNumberRange(ctx context.Context, contract string, from string, to string, topics []string, limit *int, cursor *string) ([]*model.Event, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(contract, "0x") && len(contract) == 42) {
 		return nil, errors.New("Bad Contract Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetBlockNumberRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetBlockNumberRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Number Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractWithTopicsByBlockNumberRange(db, common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics))))
+	return getGraphQLCompatibleEvents(ctx, db.GetEventsFromContractWithTopicsByBlockNumberRange(common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics)), page))
 }
 
-func (r *queryResolver) EventsFromContractWithTopicsByTimeRange(ctx context.Context, contract string, from string, to string, topics []string) ([]*model.Event, error) {
+func (r *queryResolver) EventsFromContractWithTopicsByTimeRange(ctx context.Context, contract string, from string, to string, topics []string, limit *int, cursor *string) ([]*model.Event, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	if !(strings.HasPrefix(contract, "0x") && len(contract) == 42) {
 		return nil, errors.New("Bad Contract Address")
 	}
 
-	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetTimeRange())
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetTimeRange(), page != nil)
 	if err != nil {
 		return nil, errors.New("Bad Block Timestamp Range")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetEventsFromContractWithTopicsByBlockTimeRange(db, common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics))))
+	return getGraphQLCompatibleEvents(ctx, db.GetEventsFromContractWithTopicsByBlockTimeRange(common.HexToAddress(contract), _from, _to, cmn.CreateEventTopicMap(FillUpTopicArray(topics)), page))
+}
+
+func (r *queryResolver) EventsByNumberRange(ctx context.Context, from string, to string, contracts []string, topics [][]string, limit *int, cursor *string) ([]*model.Event, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := cmn.ParseEventFilter(contracts, topics)
+	if err != nil {
+		return nil, errors.New("Bad Event Filter")
+	}
+
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetBlockNumberRange(), page != nil)
+	if err != nil {
+		return nil, errors.New("Bad Block Number Range")
+	}
+
+	return getGraphQLCompatibleEvents(ctx, db.GetEventsByBlockNumberRangeWithFilter(filter, _from, _to, page))
+}
+
+func (r *queryResolver) EventsByTimeRange(ctx context.Context, from string, to string, contracts []string, topics [][]string, limit *int, cursor *string) ([]*model.Event, error) {
+	page, err := buildPage(limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := cmn.ParseEventFilter(contracts, topics)
+	if err != nil {
+		return nil, errors.New("Bad Event Filter")
+	}
+
+	_from, _to, err := cmn.PagedRangeChecker(from, to, cfg.GetTimeRange(), page != nil)
+	if err != nil {
+		return nil, errors.New("Bad Block Timestamp Range")
+	}
+
+	return getGraphQLCompatibleEvents(ctx, db.GetEventsByBlockTimeRangeWithFilter(filter, _from, _to, page))
 }
 
 func (r *queryResolver) LastXEventsFromContract(ctx context.Context, contract string, x int) ([]*model.Event, error) {
@@ -479,7 +696,7 @@ func (r *queryResolver) LastXEventsFromContract(ctx context.Context, contract st
 		return nil, errors.New("Too Many Events Requested")
 	}
 
-	return getGraphQLCompatibleEvents(ctx, _db.GetLastXEventsFromContract(db, common.HexToAddress(contract), x))
+	return getGraphQLCompatibleEvents(ctx, db.GetLastXEventsFromContract(common.HexToAddress(contract), x))
 }
 
 func (r *queryResolver) EventByBlockHashAndLogIndex(ctx context.Context, hash string, index string) (*model.Event, error) {
@@ -492,7 +709,7 @@ func (r *queryResolver) EventByBlockHashAndLogIndex(ctx context.Context, hash st
 		return nil, errors.New("Bad Log Index")
 	}
 
-	return getGraphQLCompatibleEvent(ctx, _db.GetEventByBlockHashAndLogIndex(db, common.HexToHash(hash), uint(_index)), true)
+	return getGraphQLCompatibleEvent(ctx, db.GetEventByBlockHashAndLogIndex(common.HexToHash(hash), uint(_index)), true)
 }
 
 func (r *queryResolver) EventByBlockNumberAndLogIndex(ctx context.Context, number string, index string) (*model.Event, error) {
@@ -506,7 +723,29 @@ func (r *queryResolver) EventByBlockNumberAndLogIndex(ctx context.Context, numbe
 		return nil, errors.New("Bad Log Index")
 	}
 
-	return getGraphQLCompatibleEvent(ctx, _db.GetEventByBlockNumberAndLogIndex(db, _number, uint(_index)), true)
+	return getGraphQLCompatibleEvent(ctx, db.GetEventByBlockNumberAndLogIndex(_number, uint(_index)), true)
+}
+
+func (r *queryResolver) Account(ctx context.Context, address string) (*model.Account, error) {
+	if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+		return nil, errors.New("Bad Account Address")
+	}
+
+	return getGraphQLCompatibleAccount(ctx, db.GetAccount(common.HexToAddress(address)))
+}
+
+func (r *queryResolver) ChainStats(ctx context.Context, interval string, from string, to string) ([]*model.ChainStat, error) {
+	width, ok := data.ChainStatIntervals[interval]
+	if !ok {
+		return nil, errors.New("Bad Interval")
+	}
+
+	_from, _to, err := cmn.RangeChecker(from, to, cfg.GetMaxPageSize()*width)
+	if err != nil {
+		return nil, errors.New("Bad Timestamp Range")
+	}
+
+	return getGraphQLCompatibleChainStats(ctx, db.GetChainStats(interval, _from, _to))
 }
 
 // Query returns generated.QueryResolver implementation.
@@ -525,5 +764,5 @@ func (r *queryResolver) TransactionByHash(ctx context.Context, hash string) (*mo
 		return nil, errors.New("Bad Transaction Hash")
 	}
 
-	return getGraphQLCompatibleTransaction(ctx, _db.GetTransactionByHash(db, common.HexToHash(hash)), true)
+	return getGraphQLCompatibleTransaction(ctx, db.GetTransactionByHash(common.HexToHash(hash)), true)
 }
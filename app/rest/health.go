@@ -0,0 +1,136 @@
+package rest
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+)
+
+// How long each dependency is given to respond, while checking readiness
+const readinessCheckTimeout = 2 * time.Second
+
+// check - Outcome of checking one dependency/ condition `ette` relies on
+type check struct {
+	OK      bool   `json:"ok"`
+	Latency string `json:"latency,omitempty"`
+	Error   string `json:"error,omitempty"`
+
+	// Only for blockchain node, head of node & latest block
+	// seen by `ette`, along with how far behind it is
+	Head *uint64 `json:"head,omitempty"`
+	Seen *uint64 `json:"seen,omitempty"`
+	Lag  *uint64 `json:"lag,omitempty"`
+
+	// Only for sync progress, how many blocks are yet to be synced
+	Remaining *uint64 `json:"remaining,omitempty"`
+}
+
+// readiness - Outcome of all checks, where `ette` is ready to serve
+// clients only when each of them has passed
+type readiness struct {
+	Ready  bool              `json:"ready"`
+	Checks map[string]*check `json:"checks"`
+}
+
+// timed - Runs check with timeout, recording how long it took
+func timed(ctx context.Context, fn func(context.Context) error) *check {
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := fn(ctx)
+
+	_check := &check{OK: err == nil, Latency: time.Since(start).String()}
+	if err != nil {
+		_check.Error = err.Error()
+	}
+
+	return _check
+}
+
+// checkReadiness - Checks whether database & redis are reachable, blockchain
+// node is reachable & latest block seen by `ette` isn't lagging behind node's
+// head by more than `MaxBlockLag` blocks
+//
+// When historical data query is enabled, sync progress is also checked i.e.
+// at max `MaxBlockLag` blocks are yet to be synced
+func checkReadiness(ctx context.Context, _db db.Store, _redisClient *redis.Client, _connection *d.BlockChainNodeConnection, _status *d.StatusHolder) *readiness {
+
+	maxLag := cfg.GetMaxBlockLag()
+	seen := _status.GetLatestBlockNumber()
+
+	result := &readiness{Checks: make(map[string]*check)}
+
+	result.Checks["database"] = timed(ctx, _db.Ping)
+
+	result.Checks["redis"] = timed(ctx, func(ctx context.Context) error {
+		return _redisClient.Ping(ctx).Err()
+	})
+
+	var head uint64
+	node := timed(ctx, func(ctx context.Context) error {
+		var err error
+		head, err = _connection.RPC.BlockNumber(ctx)
+		if err != nil {
+			metrics.RPCFailed("eth_blockNumber")
+		}
+
+		return err
+	})
+
+	if node.OK {
+		// Node's head can be behind latest block seen by `ette`, when
+		// it's served by load balanced set of nodes
+		var lag uint64
+		if head > seen {
+			lag = head - seen
+		}
+
+		node.Head, node.Seen, node.Lag = &head, &seen, &lag
+
+		switch {
+		case seen == 0:
+			node.OK = false
+			node.Error = "Waiting for first block"
+		case lag > maxLag:
+			node.OK = false
+			node.Error = "Lagging behind node"
+		}
+	}
+
+	result.Checks["node"] = node
+
+	if cfg.Current().HistoricalModeEnabled() && seen != 0 {
+		// When pruning is enabled, blocks older than oldest one
+		// present in database aren't to be synced anymore
+		var expected uint64
+		if oldest := _db.GetCurrentOldestBlockNumber(); oldest <= seen {
+			expected = seen - oldest + 1
+		}
+
+		var remaining uint64
+		if count := _status.BlockCountInDB(); expected > count {
+			remaining = expected - count
+		}
+
+		progress := &check{OK: remaining <= maxLag, Remaining: &remaining}
+		if !progress.OK {
+			progress.Error = "Syncing"
+		}
+
+		result.Checks["sync"] = progress
+	}
+
+	result.Ready = true
+	for _, v := range result.Checks {
+		result.Ready = result.Ready && v.OK
+	}
+
+	return result
+
+}
@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/itzmeanjan/ette/app/metrics"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// Header carrying request identifier, which is taken from client/ proxy
+	// in front of `ette`, when present, & always sent back in response
+	requestIDHeader = "X-Request-ID"
+	// Keys request identifier & address of user, who owns API key/ session
+	// used for making request, are kept under, in request context
+	requestIDKey = "requestID"
+	userKey      = "user"
+)
+
+// setUser - Remembers address of user, who owns API key/ session used for
+// making request, so that it shows up in access log & request scoped logs
+func setUser(c *gin.Context, address string) {
+	c.Set(userKey, address)
+}
+
+// requestLogger - Returns logger carrying request identifier, trace identifier
+// & address of user making request, when known, so that logs can be correlated
+// with access log entry & trace
+func requestLogger(c *gin.Context) *log.Entry {
+	fields := log.Fields{"request_id": c.GetString(requestIDKey)}
+
+	if user := c.GetString(userKey); user != "" {
+		fields["user"] = user
+	}
+
+	if span := trace.SpanContextFromContext(c.Request.Context()); span.IsValid() {
+		fields["trace_id"] = span.TraceID.String()
+	}
+
+	return log.WithFields(fields)
+}
+
+// accessLog - Assigns identifier to each request & logs it, once served, in
+// same format as everything else, while recording it as metric, by route
+// template, so that path params don't blow up number of series
+func accessLog(c *gin.Context) {
+	start := time.Now()
+
+	id := c.GetHeader(requestIDHeader)
+	if id == "" || len(id) > 128 {
+		id = uuid.New().String()
+	}
+
+	c.Set(requestIDKey, id)
+	c.Header(requestIDHeader, id)
+
+	c.Next()
+
+	took := time.Since(start)
+	status := c.Writer.Status()
+
+	route := c.FullPath()
+	if route == "" {
+		route = "unmatched"
+	}
+
+	metrics.RequestServed(c.Request.Method, route, strconv.Itoa(status), took)
+
+	// Nothing being written in response body is reported as -1
+	size := c.Writer.Size()
+	if size < 0 {
+		size = 0
+	}
+
+	entry := requestLogger(c).WithFields(log.Fields{
+		"method": c.Request.Method,
+		"path":   c.Request.URL.Path,
+		"route":  route,
+		"status": status,
+		"bytes":  size,
+		"took":   took.String(),
+		"ip":     c.ClientIP(),
+	})
+
+	if len(c.Errors) != 0 {
+		entry = entry.WithField("error", c.Errors.String())
+	}
+
+	if status >= 500 {
+		entry.Error("Served request")
+		return
+	}
+
+	entry.Info("Served request")
+}
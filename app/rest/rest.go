@@ -4,11 +4,12 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
-	"log"
+	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/foolin/goview"
@@ -17,23 +18,47 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
-	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/websocket"
 	cmn "github.com/itzmeanjan/ette/app/common"
 	cfg "github.com/itzmeanjan/ette/app/config"
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
 	ps "github.com/itzmeanjan/ette/app/pubsub"
 	"github.com/itzmeanjan/ette/app/rest/graph/generated"
-	"gorm.io/gorm"
+	wh "github.com/itzmeanjan/ette/app/webhook"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/itzmeanjan/ette/app/rest/graph"
+	log "github.com/sirupsen/logrus"
 )
 
 // RunHTTPServer - Holds definition for all REST API(s) to be exposed
-func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Client) {
+//
+// `_reload` is invoked when admin asks `ette` to re-read subscription plans &
+// reloadable configuration, returning list of changes applied
+func RunHTTPServer(_db db.Store, _status *d.StatusHolder, _redisInfo *d.RedisInfo, _connection *d.BlockChainNodeConnection, _reload func() ([]string, error)) {
+
+	_redisClient := _redisInfo.Client
+
+	// Endpoint, data delivered over which is being logged for
+	// implementing rate limiting, empty when it's not to be logged
+	deliveryEndpoint := func(uri string) string {
+		switch {
+		case strings.HasPrefix(uri, "/v1/block"):
+			return "/v1/block"
+		case strings.HasPrefix(uri, "/v1/transaction"):
+			return "/v1/transaction"
+		case strings.HasPrefix(uri, "/v1/event"):
+			return "/v1/event"
+		case strings.HasPrefix(uri, "/v1/rpc"):
+			return "/v1/rpc"
+		}
+
+		return ""
+	}
 
 	respondWithJSON := func(data []byte, c *gin.Context) {
 
@@ -42,7 +67,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		// API key based client identification
 		//
 		// Data delivery being logged for implementing rate limiting
-		user := db.GetUserFromAPIKey(_db, c.GetHeader("APIKey"))
+		user := _db.GetUserFromAPIKey(c.GetHeader("APIKey"))
 		if user == nil {
 			c.JSON(http.StatusUnauthorized, gin.H{
 				"msg": "Bad API Key",
@@ -53,13 +78,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		if data != nil {
 			c.Data(http.StatusOK, "application/json", data)
 
-			switch {
-			case strings.HasPrefix(uri, "/v1/block"):
-				db.PutDataDeliveryInfo(_db, user.Address, "/v1/block", uint64(len(data)))
-			case strings.HasPrefix(uri, "/v1/transaction"):
-				db.PutDataDeliveryInfo(_db, user.Address, "/v1/transaction", uint64(len(data)))
-			case strings.HasPrefix(uri, "/v1/event"):
-				db.PutDataDeliveryInfo(_db, user.Address, "/v1/event", uint64(len(data)))
+			if endpoint := deliveryEndpoint(uri); endpoint != "" {
+				_db.PutDataDeliveryInfo(user.Address, endpoint, uint64(len(data)))
 			}
 
 			return
@@ -71,6 +91,39 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		return
 	}
 
+	// Streams rows, handed over by `stream`, to client in asked format, recording
+	// data deliveries against endpoint being served
+	respondWithRows := func(c *gin.Context, format string, stream func(write func(row) error) error) {
+		respondWithStream(_db, c, deliveryEndpoint(c.Request.RequestURI), format, stream)
+	}
+
+	// Delivers query result as single JSON document or row by row, when client
+	// asks for NDJSON/ CSV using `Accept` header, where cursor to next page,
+	// if any, is sent in `X-Next-Cursor` header
+	respondWith := func(result interface{ ToJSON() []byte }, c *gin.Context) {
+
+		format := streamFormat(c)
+		if format == "" {
+			respondWithJSON(result.ToJSON(), c)
+			return
+		}
+
+		if next := nextCursorOf(result); next != "" {
+			c.Header("X-Next-Cursor", next)
+		}
+
+		respondWithRows(c, format, func(write func(row) error) error {
+			for _, v := range rowsOf(result) {
+				if err := write(v); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+
+	}
+
 	// Validates sessionId, which is passed as cookie for
 	// `/v1/dashboard/*` endpoints
 	//
@@ -86,9 +139,37 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			return ""
 		}
 
+		// Sessions of banned users are no more honoured
+		if _db.IsBanned(common.HexToAddress(address)) {
+			return ""
+		}
+
+		setUser(c, address)
 		return address
 	}
 
+	// Validates sessionId, same as `validateSessionID`, but
+	// only lets request pass through if logged in user is
+	// `Admin`, as set in `.env`
+	validateAdminSessionID := func(c *gin.Context) {
+		address := validateSessionID(c)
+		if address == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"msg": "Login Required",
+			})
+			return
+		}
+
+		if !common.IsHexAddress(cfg.Current().Admin) || common.HexToAddress(address) != common.HexToAddress(cfg.Current().Admin) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"msg": "Admin Only",
+			})
+			return
+		}
+
+		c.Next()
+	}
+
 	// For any historical query request
 	// APIKey needs to be delivered in header
 	//
@@ -105,7 +186,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			return
 		}
 
-		user := db.GetUserFromAPIKey(_db, apiKey)
+		user := _db.GetUserFromAPIKey(apiKey)
 		if user == nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
 				"msg": "Bad API Key",
@@ -113,6 +194,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			return
 		}
 
+		setUser(c, user.Address)
+
 		// Checking if user has kept this APIKey enabled or not
 		if !user.Enabled {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
@@ -123,7 +206,9 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 		// Checking if user has crossed allowed rate limit or not
 		// If yes, we're dropping request
-		if !db.IsUnderRateLimit(_db, user.Address) {
+		if !_db.IsUnderRateLimit(user.Address) {
+			metrics.RateLimited("rest")
+
 			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
 				"msg": "Crossed Allowed Rate Limit",
 			})
@@ -134,10 +219,96 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 	}
 
+	// Builds tx filter from `fromAccount`, `toAccount`, `minValue` & `maxValue`
+	// query params, where all of them are optional & values are in wei
+	parseTransactionFilter := func(c *gin.Context) (*d.TransactionFilter, error) {
+		filter := &d.TransactionFilter{}
+
+		for _, v := range []struct {
+			param   string
+			address **common.Address
+		}{{"fromAccount", &filter.From}, {"toAccount", &filter.To}} {
+			account := c.Query(v.param)
+			if account == "" {
+				continue
+			}
+
+			if !(strings.HasPrefix(account, "0x") && len(account) == 42) {
+				return nil, fmt.Errorf("Bad %s", v.param)
+			}
+
+			_account := common.HexToAddress(account)
+			*v.address = &_account
+		}
+
+		for _, v := range []struct {
+			param string
+			value **big.Int
+		}{{"minValue", &filter.MinValue}, {"maxValue", &filter.MaxValue}} {
+			value := c.Query(v.param)
+			if value == "" {
+				continue
+			}
+
+			_value, err := cmn.ParseValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("Bad %s", v.param)
+			}
+
+			*v.value = _value
+		}
+
+		return filter, nil
+	}
+
+	// Builds event filter from `contracts` i.e. comma separated contract addresses
+	// & `topics` i.e. JSON array holding set of topics for each position, as in
+	// `eth_getLogs`, where both of them are optional
+	//
+	// When those are absent, single `contract` & `topic0` ... `topic3` are used
+	parseEventFilter := func(c *gin.Context) (*d.EventFilter, error) {
+		var contracts []string
+		if v := c.Query("contracts"); v != "" {
+			contracts = strings.Split(v, ",")
+		} else if v := c.Query("contract"); v != "" {
+			contracts = []string{v}
+		}
+
+		var topics [][]string
+		if v := c.Query("topics"); v != "" {
+			_topics, err := cmn.ParseEventTopics([]byte(v))
+			if err != nil {
+				return nil, err
+			}
+
+			topics = _topics
+		} else {
+			for i, v := range []string{c.Query("topic0"), c.Query("topic1"), c.Query("topic2"), c.Query("topic3")} {
+				if v == "" {
+					continue
+				}
+
+				for len(topics) <= i {
+					topics = append(topics, nil)
+				}
+
+				topics[i] = []string{v}
+			}
+		}
+
+		return cmn.ParseEventFilter(contracts, topics)
+	}
+
+	// Builds page from optional `limit` & `cursor` query params, which
+	// is nil when results aren't to be paginated
+	parsePage := func(c *gin.Context) (*d.Page, error) {
+		return cmn.ParsePage(c.Query("limit"), c.Query("cursor"), cfg.GetMaxPageSize())
+	}
+
 	// Checking whether this `ette` instance support
 	// historical data query or not
 	checkEtteHistoricalMode := func(c *gin.Context) {
-		if !(cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "3") {
+		if !cfg.Current().HistoricalModeEnabled() {
 			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
 				"msg": "Disabled Feature",
 			})
@@ -151,9 +322,9 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 	// real-time data delivery or not, if not letting client know
 	// about it & closing connection
 	checkEtteRealTimeMode := func(conn *websocket.Conn) bool {
-		if !(cfg.Get("EtteMode") == "2" || cfg.Get("EtteMode") == "3") {
+		if !cfg.Current().RealtimeModeEnabled() {
 			if err := conn.WriteJSON(&ps.SubscriptionResponse{Code: 0, Message: "Disabled Feature"}); err != nil {
-				log.Printf("[!] Failed to write message : %s\n", err.Error())
+				log.WithError(err).Warn("Failed to write message")
 			}
 			return false
 		}
@@ -163,7 +334,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 	// Checking if user has asked to run webserver in production mode or not
 	checkIfInProduction := func() bool {
-		return strings.ToLower(cfg.Get("Production")) == "yes"
+		return cfg.Current().IsProduction()
 	}
 
 	// Running in production/ debug mode depending upon
@@ -174,9 +345,21 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		gin.SetMode(gin.DebugMode)
 	}
 
-	router := gin.Default()
+	router := gin.New()
 	activeSubscriptions := d.ActiveSubscriptions{Count: 0}
 
+	// Real-time data published on Redis is listened to only once, for
+	// all SSE clients, keeping recent ones for letting them resume
+	var sse *sseHub
+	if cfg.Current().RealtimeModeEnabled() {
+		sse = newSSEHub()
+		go sse.Listen(context.Background(), _redisInfo)
+	}
+
+	// Tracing & logging each request served in same format as
+	// everything else, along with recovering from panics, if any
+	router.Use(traceRequest, accessLog, gin.Recovery())
+
 	// enabled cors
 	router.Use(cors.Default())
 
@@ -199,6 +382,31 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		c.Data(http.StatusOK, "image/x-icon", data)
 	})
 
+	// Metrics to be scraped by prometheus
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	// Liveness probe, answered as long as `ette` is able to
+	// serve http requests, without touching any dependency
+	router.GET("/healthz", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"alive": true,
+		})
+	})
+
+	// Readiness probe, responded with 503, when any of dependencies
+	// isn't reachable or `ette` is lagging behind blockchain node, so
+	// that it can be taken out of rotation
+	router.GET("/readyz", func(c *gin.Context) {
+		result := checkReadiness(c.Request.Context(), _db, _redisClient, _connection, _status)
+
+		code := http.StatusOK
+		if !result.Ready {
+			code = http.StatusServiceUnavailable
+		}
+
+		c.JSON(code, result)
+	})
+
 	grp := router.Group("/v1")
 
 	{
@@ -230,6 +438,13 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				return
 			}
 
+			if _db.IsBanned(payload.Message.Address) {
+				c.JSON(http.StatusForbidden, gin.H{
+					"msg": "Banned",
+				})
+				return
+			}
+
 			if _, err := _redisClient.Set(context.Background(), payload.Signature, payload.Message.Address.Hex(), time.Duration(3600)*time.Second).Result(); err != nil {
 				c.JSON(http.StatusInternalServerError, gin.H{
 					"msg": "Something went wrong",
@@ -237,7 +452,10 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				return
 			}
 
-			c.SetCookie("SessionID", payload.Signature, 3600, "/v1/dashboard", cfg.Get("Domain"), false, false)
+			// Session cookie authenticates admin mutations too, so it's never sent along
+			// with cross site requests, while in production it's sent only over https
+			c.SetSameSite(http.SameSiteStrictMode)
+			c.SetCookie("SessionID", payload.Signature, 3600, "/v1", cfg.Current().Domain, cfg.Current().IsProduction(), true)
 
 			c.JSON(http.StatusOK, gin.H{
 				"msg": "Success",
@@ -275,7 +493,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				return
 			}
 
-			if apps := db.GetAppsByUserAddress(_db, common.HexToAddress(address)); apps != nil {
+			if apps := _db.GetAppsByUserAddress(common.HexToAddress(address)); apps != nil {
 				c.JSON(http.StatusOK, gin.H{
 					"apps": apps,
 				})
@@ -326,7 +544,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				return
 			}
 
-			if !db.RegisterNewApp(_db, common.HexToAddress(address)) {
+			if !_db.RegisterNewApp(common.HexToAddress(address)) {
 				c.JSON(http.StatusInternalServerError, gin.H{
 					"msg": "Failed to register app",
 				})
@@ -356,7 +574,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				return
 			}
 
-			if !db.ToggleAPIKeyState(_db, apiKey.APIKey.Hex()) {
+			if !_db.ToggleAPIKeyState(apiKey.APIKey.Hex()) {
 				c.JSON(http.StatusInternalServerError, gin.H{
 					"msg": "Failed to toggle app state",
 				})
@@ -369,6 +587,166 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 		})
 
+		grp.GET("/dashboard/webhooks", func(c *gin.Context) {
+
+			address := validateSessionID(c)
+			if address == "" {
+				c.Redirect(http.StatusTemporaryRedirect, "/v1/login")
+				return
+			}
+
+			if webhooks := _db.GetWebhooksByUserAddress(common.HexToAddress(address)); webhooks != nil {
+				c.JSON(http.StatusOK, gin.H{
+					"webhooks": webhooks,
+				})
+				return
+			}
+
+			c.JSON(http.StatusNoContent, gin.H{
+				"msg": "No webhooks registered yet",
+			})
+
+		})
+
+		// Registers webhook against one of API keys created by user, where
+		// real-time data matching subscription name is to be POST-ed
+		grp.POST("/dashboard/newWebhook", func(c *gin.Context) {
+
+			address := validateSessionID(c)
+			if address == "" {
+				c.Redirect(http.StatusTemporaryRedirect, "/v1/login")
+				return
+			}
+
+			var payload d.WebhookPayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Webhook Payload",
+				})
+				return
+			}
+
+			user := _db.GetUserFromAPIKey(payload.APIKey.Hex())
+			if user == nil || common.HexToAddress(user.Address) != common.HexToAddress(address) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad API Key",
+				})
+				return
+			}
+
+			if !(&ps.SubscriptionRequest{Name: payload.Name}).IsValidTopic() {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad subscription name",
+				})
+				return
+			}
+
+			if err := wh.ValidateURL(c.Request.Context(), payload.URL); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad webhook URL",
+				})
+				return
+			}
+
+			webhook := _db.RegisterWebhook(user.APIKey, payload.Name, payload.URL)
+			if webhook == nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to register webhook",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg":     "Success",
+				"webhook": webhook,
+			})
+
+		})
+
+		grp.POST("/dashboard/toggleWebhook", func(c *gin.Context) {
+
+			address := validateSessionID(c)
+			if address == "" {
+				c.Redirect(http.StatusTemporaryRedirect, "/v1/login")
+				return
+			}
+
+			var webhook d.WebhookID
+
+			if err := c.ShouldBindJSON(&webhook); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Webhook Payload",
+				})
+				return
+			}
+
+			if !_db.ToggleWebhookState(common.HexToAddress(address), webhook.ID) {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to toggle webhook state",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
+		grp.POST("/dashboard/deleteWebhook", func(c *gin.Context) {
+
+			address := validateSessionID(c)
+			if address == "" {
+				c.Redirect(http.StatusTemporaryRedirect, "/v1/login")
+				return
+			}
+
+			var webhook d.WebhookID
+
+			if err := c.ShouldBindJSON(&webhook); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Webhook Payload",
+				})
+				return
+			}
+
+			if !_db.DeleteWebhook(common.HexToAddress(address), webhook.ID) {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to delete webhook",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
+		// Recent deliveries made to webhook, along with outcome of
+		// last attempt of each, latest first
+		grp.GET("/dashboard/webhook/deliveries", func(c *gin.Context) {
+
+			address := validateSessionID(c)
+			if address == "" {
+				c.Redirect(http.StatusTemporaryRedirect, "/v1/login")
+				return
+			}
+
+			if deliveries := _db.GetWebhookDeliveries(common.HexToAddress(address), c.Query("id"), 100); deliveries != nil {
+				c.JSON(http.StatusOK, gin.H{
+					"deliveries": deliveries,
+				})
+				return
+			}
+
+			c.JSON(http.StatusNotFound, gin.H{
+				"msg": "Not found",
+			})
+
+		})
+
 		grp.GET("/dashboard/plans", func(c *gin.Context) {
 
 			address := validateSessionID(c)
@@ -377,7 +755,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				return
 			}
 
-			plans := db.GetAllSubscriptionPlans(_db)
+			plans := _db.GetAllSubscriptionPlans()
 			if plans == nil {
 				c.JSON(http.StatusInternalServerError, gin.H{
 					"msg": "Failed to fetch subscription plans",
@@ -399,7 +777,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				return
 			}
 
-			if plan := db.CheckSubscriptionPlanDetailsByAddress(_db, common.HexToAddress(address)); plan != nil {
+			if plan := _db.CheckSubscriptionPlanDetailsByAddress(common.HexToAddress(address)); plan != nil {
 				c.JSON(http.StatusOK, plan)
 				return
 			}
@@ -410,6 +788,232 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 		})
 
+		// Admin asking `ette` to re-read `.plans.json` & reloadable
+		// part of configuration, same as sending SIGHUP to process
+		grp.POST("/admin/reload", validateAdminSessionID, func(c *gin.Context) {
+
+			changes, err := _reload()
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": fmt.Sprintf("Failed to reload : %s", err.Error()),
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg":     "Success",
+				"changes": changes,
+			})
+
+		})
+
+		// All users, who've created at least one app, along with
+		// their apps, subscription plan & ban status
+		grp.GET("/admin/users", validateAdminSessionID, func(c *gin.Context) {
+
+			users := _db.GetAllUsers()
+			if users == nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to fetch users",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"users": users,
+			})
+
+		})
+
+		grp.GET("/admin/user", validateAdminSessionID, func(c *gin.Context) {
+
+			address := c.Query("address")
+			if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad address",
+				})
+				return
+			}
+
+			if user := _db.GetUserDetails(common.HexToAddress(address)); user != nil {
+				c.JSON(http.StatusOK, user)
+				return
+			}
+
+			c.JSON(http.StatusNotFound, gin.H{
+				"msg": "Not found",
+			})
+
+		})
+
+		// Deliveries made to user on this day, which are counted
+		// against plan's daily limit, broken down by endpoint
+		grp.GET("/admin/usage", validateAdminSessionID, func(c *gin.Context) {
+
+			address := c.Query("address")
+			if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad address",
+				})
+				return
+			}
+
+			usage := _db.GetUsageByAddress(common.HexToAddress(address))
+			if usage == nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to fetch usage",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, usage)
+
+		})
+
+		// Enabling/ disabling any API key, irrespective of who created it
+		setAppState := func(enabled bool) gin.HandlerFunc {
+			return func(c *gin.Context) {
+
+				var apiKey d.APIKey
+
+				if err := c.ShouldBindJSON(&apiKey); err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad APIKey Payload",
+					})
+					return
+				}
+
+				if !_db.SetAPIKeyState(apiKey.APIKey.Hex(), enabled) {
+					c.JSON(http.StatusNotFound, gin.H{
+						"msg": "Bad API Key",
+					})
+					return
+				}
+
+				requestLogger(c).WithFields(log.Fields{"apiKey": apiKey.APIKey.Hex(), "enabled": enabled}).Info("Changed API key state")
+
+				c.JSON(http.StatusOK, gin.H{
+					"msg": "Success",
+				})
+
+			}
+		}
+
+		grp.POST("/admin/enableApp", validateAdminSessionID, setAppState(true))
+		grp.POST("/admin/disableApp", validateAdminSessionID, setAppState(false))
+
+		// Subscribing address to plan, replacing existing one, if any,
+		// which can be done even before user creates first app
+		grp.POST("/admin/plan", validateAdminSessionID, func(c *gin.Context) {
+
+			var payload d.SubscriptionPlanPayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Subscription Plan Payload",
+				})
+				return
+			}
+
+			if !_db.IsValidSubscriptionPlan(payload.Plan) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad subscription plan",
+				})
+				return
+			}
+
+			if !_db.SetSubscriptionPlanForAddress(payload.Address, payload.Plan) {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to assign subscription plan",
+				})
+				return
+			}
+
+			requestLogger(c).WithFields(log.Fields{"address": payload.Address.Hex(), "plan": payload.Plan}).Info("Assigned subscription plan")
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
+		grp.GET("/admin/banned", validateAdminSessionID, func(c *gin.Context) {
+
+			banned := _db.GetBannedAddresses()
+			if banned == nil {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to fetch banned addresses",
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"banned": banned,
+			})
+
+		})
+
+		// Banned address can't log in & its API keys are rejected, by all
+		// of REST, GraphQL, websocket, SSE, gRPC & webhooks
+		grp.POST("/admin/ban", validateAdminSessionID, func(c *gin.Context) {
+
+			var payload d.BanPayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil || len(payload.Reason) > 200 {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Ban Payload",
+				})
+				return
+			}
+
+			if payload.Address == common.HexToAddress(cfg.Current().Admin) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Admin can't be banned",
+				})
+				return
+			}
+
+			if !_db.BanAddress(payload.Address, payload.Reason) {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to ban address",
+				})
+				return
+			}
+
+			requestLogger(c).WithFields(log.Fields{"address": payload.Address.Hex(), "reason": payload.Reason}).Info("Banned address")
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
+		grp.POST("/admin/unban", validateAdminSessionID, func(c *gin.Context) {
+
+			var payload d.BanPayload
+
+			if err := c.ShouldBindJSON(&payload); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Ban Payload",
+				})
+				return
+			}
+
+			if !_db.UnbanAddress(payload.Address) {
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not banned",
+				})
+				return
+			}
+
+			requestLogger(c).WithField("address", payload.Address.Hex()).Info("Unbanned address")
+
+			c.JSON(http.StatusOK, gin.H{
+				"msg": "Success",
+			})
+
+		})
+
 		// For checking `ette`'s syncing status
 		grp.GET("/synced", func(c *gin.Context) {
 
@@ -418,7 +1022,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			remaining := (currentBlockNumber + 1) - blockCountInDB
 			elapsed := _status.ElapsedTime()
 
-			if cfg.Get("EtteMode") == "2" {
+			if cfg.Current().EtteMode == 2 {
 				c.JSON(http.StatusOK, gin.H{
 					"processed": _status.Done(),
 					"elapsed":   elapsed.String(),
@@ -444,14 +1048,114 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		// Query block data using block hash/ number/ block number range ( 10 at max )
 		grp.GET("/block", checkEtteHistoricalMode, validateAPIKey, func(c *gin.Context) {
 
+			// When paginated, range width isn't limited, rather at max
+			// `limit` results are returned, in chain order
+			page, err := parsePage(c)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad page size or cursor",
+				})
+				return
+			}
+
 			hash := c.Query("hash")
 			number := c.Query("number")
 			tx := c.Query("tx")
+			at := c.Query("at")
+
+			// Given unix timestamp, finds out first block mined at or after it,
+			// or last one mined at or before it, depending upon direction
+			if at != "" {
+
+				_at, err := cmn.ParseNumber(at)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block timestamp",
+					})
+					return
+				}
+
+				direction := c.DefaultQuery("direction", "after")
+				if !(direction == "after" || direction == "before") {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad direction",
+					})
+					return
+				}
+
+				block, err := _db.GetBlockByTime(_at, direction == "after")
+				if err == db.ErrNotYetIndexed {
+					c.JSON(http.StatusNotFound, gin.H{
+						"msg": err.Error(),
+					})
+					return
+				}
+
+				if err != nil {
+					requestLogger(c).WithError(err).Error("Failed to look up block by time")
+
+					c.JSON(http.StatusInternalServerError, gin.H{
+						"msg": "Something went wrong",
+					})
+					return
+				}
+
+				if block != nil {
+					respondWith(block, c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+			}
+
+			// Blocks in number/ time range, streamed row by row, as soon as they're
+			// read from database, when client asks for NDJSON/ CSV, in which case
+			// range width is limited by `ExportBlockRange`/ `ExportTimeRange`
+			if format := streamFormat(c); format != "" && page == nil && hash == "" && number == "" {
+
+				switch {
+
+				case c.Query("fromBlock") != "" && c.Query("toBlock") != "":
+
+					_fromBlock, _toBlock, err := cmn.RangeChecker(c.Query("fromBlock"), c.Query("toBlock"), cfg.GetExportBlockRange())
+					if err != nil {
+						c.JSON(http.StatusBadRequest, gin.H{
+							"msg": "Bad block number range",
+						})
+						return
+					}
+
+					respondWithRows(c, format, func(write func(row) error) error {
+						return _db.StreamBlocksByNumberRange(_fromBlock, _toBlock, func(b *d.Block) error { return write(b) })
+					})
+					return
+
+				case c.Query("fromTime") != "" && c.Query("toTime") != "":
+
+					_fromTime, _toTime, err := cmn.RangeChecker(c.Query("fromTime"), c.Query("toTime"), cfg.GetExportTimeRange())
+					if err != nil {
+						c.JSON(http.StatusBadRequest, gin.H{
+							"msg": "Bad block time range",
+						})
+						return
+					}
+
+					respondWithRows(c, format, func(write func(row) error) error {
+						return _db.StreamBlocksByTimeRange(_fromTime, _toTime, func(b *d.Block) error { return write(b) })
+					})
+					return
+
+				}
+
+			}
 
 			// Block hash based all tx retrieval request handler
 			if strings.HasPrefix(hash, "0x") && len(hash) == 66 && tx == "yes" {
-				if tx := db.GetTransactionsByBlockHash(_db, common.HexToHash(hash)); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetTransactionsByBlockHash(common.HexToHash(hash), page); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -472,8 +1176,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsByBlockNumber(_db, _num); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetTransactionsByBlockNumber(_num, page); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -485,8 +1189,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 			// Block hash based single block retrieval request handler
 			if strings.HasPrefix(hash, "0x") && len(hash) == 66 {
-				if block := db.GetBlockByHash(_db, common.HexToHash(hash)); block != nil {
-					respondWithJSON(block.ToJSON(), c)
+				if block := _db.GetBlockByHash(common.HexToHash(hash)); block != nil {
+					respondWith(block, c)
 					return
 				}
 
@@ -507,8 +1211,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if block := db.GetBlockByNumber(_db, _num); block != nil {
-					respondWithJSON(block.ToJSON(), c)
+				if block := _db.GetBlockByNumber(_num); block != nil {
+					respondWith(block, c)
 					return
 				}
 
@@ -525,7 +1229,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 			if fromBlock != "" && toBlock != "" {
 
-				_from, _to, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				_from, _to, err := cmn.PagedRangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block number range",
@@ -533,8 +1237,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if blocks := db.GetBlocksByNumberRange(_db, _from, _to); blocks != nil {
-					respondWithJSON(blocks.ToJSON(), c)
+				if blocks := _db.GetBlocksByNumberRange(_from, _to, page); blocks != nil {
+					respondWith(blocks, c)
 					return
 				}
 
@@ -551,7 +1255,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 			if fromTime != "" && toTime != "" {
 
-				_from, _to, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				_from, _to, err := cmn.PagedRangeChecker(fromTime, toTime, cfg.GetTimeRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block time range",
@@ -559,8 +1263,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if blocks := db.GetBlocksByTimeRange(_db, _from, _to); blocks != nil {
-					respondWithJSON(blocks.ToJSON(), c)
+				if blocks := _db.GetBlocksByTimeRange(_from, _to, page); blocks != nil {
+					respondWith(blocks, c)
 					return
 				}
 
@@ -579,12 +1283,22 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		// Transaction fetch ( by query params ) request handler
 		grp.GET("/transaction", checkEtteHistoricalMode, validateAPIKey, func(c *gin.Context) {
 
+			// When paginated, range width isn't limited, rather at max
+			// `limit` results are returned, in chain order
+			page, err := parsePage(c)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad page size or cursor",
+				})
+				return
+			}
+
 			hash := c.Query("hash")
 
 			// Simply returns single tx object, when queried using tx hash
 			if strings.HasPrefix(hash, "0x") && len(hash) == 66 {
-				if tx := db.GetTransactionByHash(_db, common.HexToHash(hash)); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetTransactionByHash(common.HexToHash(hash)); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -612,9 +1326,129 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			fromAccount := c.Query("fromAccount")
 			toAccount := c.Query("toAccount")
 
-			// Account nonce, to be used for finding
-			// tx, in combination with `fromAccount`
-			nonce := c.Query("nonce")
+			// Account nonce, to be used for finding
+			// tx, in combination with `fromAccount`
+			nonce := c.Query("nonce")
+
+			// Tx(s) in block number/ time range, streamed row by row, as soon as they're
+			// read from database, when client asks for NDJSON/ CSV, in which case range
+			// width is limited by `ExportBlockRange`/ `ExportTimeRange` & `fromAccount`, `toAccount`, `deployer`, `minValue`
+			// & `maxValue` can be combined for filtering them, while all being optional
+			if format := streamFormat(c); format != "" && page == nil && nonce == "" && ((fromBlock != "" && toBlock != "") || (fromTime != "" && toTime != "")) {
+
+				filter, err := parseTransactionFilter(c)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": err.Error(),
+					})
+					return
+				}
+
+				if deployer != "" {
+					if !(strings.HasPrefix(deployer, "0x") && len(deployer) == 42) || filter.From != nil {
+						c.JSON(http.StatusBadRequest, gin.H{
+							"msg": "Bad deployer",
+						})
+						return
+					}
+
+					_deployer := common.HexToAddress(deployer)
+					filter.From = &_deployer
+					filter.ContractCreation = true
+				}
+
+				if fromBlock != "" && toBlock != "" {
+
+					_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetExportBlockRange())
+					if err != nil {
+						c.JSON(http.StatusBadRequest, gin.H{
+							"msg": "Bad block number range",
+						})
+						return
+					}
+
+					respondWithRows(c, format, func(write func(row) error) error {
+						return _db.StreamTransactionsByBlockNumberRangeWithFilter(filter, _fromBlock, _toBlock, func(t *d.Transaction) error { return write(t) })
+					})
+					return
+
+				}
+
+				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetExportTimeRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block time range",
+					})
+					return
+				}
+
+				respondWithRows(c, format, func(write func(row) error) error {
+					return _db.StreamTransactionsByBlockTimeRangeWithFilter(filter, _fromTime, _toTime, func(t *d.Transaction) error { return write(t) })
+				})
+				return
+
+			}
+
+			// Value range in wei, when any end of it is specified, tx(s) are filtered
+			// by value, while `fromAccount` & `toAccount` become optional
+			if c.Query("minValue") != "" || c.Query("maxValue") != "" {
+
+				filter, err := parseTransactionFilter(c)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": err.Error(),
+					})
+					return
+				}
+
+				var tx *d.Transactions
+
+				switch {
+
+				case fromBlock != "" && toBlock != "":
+
+					_fromBlock, _toBlock, err := cmn.PagedRangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange(), page != nil)
+					if err != nil {
+						c.JSON(http.StatusBadRequest, gin.H{
+							"msg": "Bad block number range",
+						})
+						return
+					}
+
+					tx = _db.GetTransactionsByBlockNumberRangeWithFilter(filter, _fromBlock, _toBlock, page)
+
+				case fromTime != "" && toTime != "":
+
+					_fromTime, _toTime, err := cmn.PagedRangeChecker(fromTime, toTime, cfg.GetTimeRange(), page != nil)
+					if err != nil {
+						c.JSON(http.StatusBadRequest, gin.H{
+							"msg": "Bad block time range",
+						})
+						return
+					}
+
+					tx = _db.GetTransactionsByBlockTimeRangeWithFilter(filter, _fromTime, _toTime, page)
+
+				default:
+
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad query param(s)",
+					})
+					return
+
+				}
+
+				if tx != nil {
+					respondWith(tx, c)
+					return
+				}
+
+				c.JSON(http.StatusNotFound, gin.H{
+					"msg": "Not found",
+				})
+				return
+
+			}
 
 			// Responds with tx sent from account with specified nonce
 			if nonce != "" && strings.HasPrefix(fromAccount, "0x") && len(fromAccount) == 42 {
@@ -627,8 +1461,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionFromAccountWithNonce(_db, common.HexToAddress(fromAccount), _nonce); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetTransactionFromAccountWithNonce(common.HexToAddress(fromAccount), _nonce); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -643,7 +1477,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// ( i.e. deployer ) with in given block number range
 			if fromBlock != "" && toBlock != "" && strings.HasPrefix(deployer, "0x") && len(deployer) == 42 {
 
-				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				_fromBlock, _toBlock, err := cmn.PagedRangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block number range",
@@ -651,8 +1485,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetContractCreationTransactionsFromAccountByBlockNumberRange(_db, common.HexToAddress(deployer), _fromBlock, _toBlock); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetContractCreationTransactionsFromAccountByBlockNumberRange(common.HexToAddress(deployer), _fromBlock, _toBlock, page); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -667,7 +1501,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// ( i.e. deployer ) with in given time frame
 			if fromTime != "" && toTime != "" && strings.HasPrefix(deployer, "0x") && len(deployer) == 42 {
 
-				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				_fromTime, _toTime, err := cmn.PagedRangeChecker(fromTime, toTime, cfg.GetTimeRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block time range",
@@ -675,8 +1509,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetContractCreationTransactionsFromAccountByBlockTimeRange(_db, common.HexToAddress(deployer), _fromTime, _toTime); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetContractCreationTransactionsFromAccountByBlockTimeRange(common.HexToAddress(deployer), _fromTime, _toTime, page); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -691,7 +1525,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// between that pair, where `from` & `to` fields are fixed
 			if fromBlock != "" && toBlock != "" && strings.HasPrefix(fromAccount, "0x") && len(fromAccount) == 42 && strings.HasPrefix(toAccount, "0x") && len(toAccount) == 42 {
 
-				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				_fromBlock, _toBlock, err := cmn.PagedRangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block number range",
@@ -699,8 +1533,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsBetweenAccountsByBlockNumberRange(_db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromBlock, _toBlock); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetTransactionsBetweenAccountsByBlockNumberRange(common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromBlock, _toBlock, page); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -715,7 +1549,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// between that pair, where `from` & `to` fields are fixed
 			if fromTime != "" && toTime != "" && strings.HasPrefix(fromAccount, "0x") && len(fromAccount) == 42 && strings.HasPrefix(toAccount, "0x") && len(toAccount) == 42 {
 
-				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				_fromTime, _toTime, err := cmn.PagedRangeChecker(fromTime, toTime, cfg.GetTimeRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block time range",
@@ -723,8 +1557,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsBetweenAccountsByBlockTimeRange(_db, common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromTime, _toTime); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetTransactionsBetweenAccountsByBlockTimeRange(common.HexToAddress(fromAccount), common.HexToAddress(toAccount), _fromTime, _toTime, page); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -739,7 +1573,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// from account
 			if fromBlock != "" && toBlock != "" && strings.HasPrefix(fromAccount, "0x") && len(fromAccount) == 42 {
 
-				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				_fromBlock, _toBlock, err := cmn.PagedRangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block number range",
@@ -747,8 +1581,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsFromAccountByBlockNumberRange(_db, common.HexToAddress(fromAccount), _fromBlock, _toBlock); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetTransactionsFromAccountByBlockNumberRange(common.HexToAddress(fromAccount), _fromBlock, _toBlock, page); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -763,7 +1597,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// from this account in that given time span
 			if fromTime != "" && toTime != "" && strings.HasPrefix(fromAccount, "0x") && len(fromAccount) == 42 {
 
-				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				_fromTime, _toTime, err := cmn.PagedRangeChecker(fromTime, toTime, cfg.GetTimeRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block time range",
@@ -771,8 +1605,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsFromAccountByBlockTimeRange(_db, common.HexToAddress(fromAccount), _fromTime, _toTime); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetTransactionsFromAccountByBlockTimeRange(common.HexToAddress(fromAccount), _fromTime, _toTime, page); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -787,7 +1621,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// to this account in that block range
 			if fromBlock != "" && toBlock != "" && strings.HasPrefix(toAccount, "0x") && len(toAccount) == 42 {
 
-				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				_fromBlock, _toBlock, err := cmn.PagedRangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block number range",
@@ -795,8 +1629,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsToAccountByBlockNumberRange(_db, common.HexToAddress(toAccount), _fromBlock, _toBlock); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetTransactionsToAccountByBlockNumberRange(common.HexToAddress(toAccount), _fromBlock, _toBlock, page); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -811,7 +1645,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// to this account in that given time span
 			if fromTime != "" && toTime != "" && strings.HasPrefix(toAccount, "0x") && len(toAccount) == 42 {
 
-				_fromTime, _toTime, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetTimeRange())
+				_fromTime, _toTime, err := cmn.PagedRangeChecker(fromTime, toTime, cfg.GetTimeRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block time range",
@@ -819,8 +1653,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if tx := db.GetTransactionsToAccountByBlockTimeRange(_db, common.HexToAddress(toAccount), _fromTime, _toTime); tx != nil {
-					respondWithJSON(tx.ToJSON(), c)
+				if tx := _db.GetTransactionsToAccountByBlockTimeRange(common.HexToAddress(toAccount), _fromTime, _toTime, page); tx != nil {
+					respondWith(tx, c)
 					return
 				}
 
@@ -837,9 +1671,87 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 		})
 
+		// Count, total & average value of tx(s) in block number/ time range,
+		// optionally filtered by sender/ receiver account & value range
+		grp.GET("/transaction/aggregate", checkEtteHistoricalMode, validateAPIKey, func(c *gin.Context) {
+
+			filter, err := parseTransactionFilter(c)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": err.Error(),
+				})
+				return
+			}
+
+			fromBlock := c.Query("fromBlock")
+			toBlock := c.Query("toBlock")
+
+			fromTime := c.Query("fromTime")
+			toTime := c.Query("toTime")
+
+			if fromBlock != "" && toBlock != "" {
+
+				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block number range",
+					})
+					return
+				}
+
+				if aggregate := _db.GetTransactionAggregateByBlockNumberRange(filter, _fromBlock, _toBlock); aggregate != nil {
+					respondWithJSON(aggregate.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to aggregate",
+				})
+				return
+
+			}
+
+			if fromTime != "" && toTime != "" {
+
+				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block time range",
+					})
+					return
+				}
+
+				if aggregate := _db.GetTransactionAggregateByBlockTimeRange(filter, _fromTime, _toTime); aggregate != nil {
+					respondWithJSON(aggregate.ToJSON(), c)
+					return
+				}
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "Failed to aggregate",
+				})
+				return
+
+			}
+
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad query param(s)",
+			})
+
+		})
+
 		// Event(s) fetched by query params handler end point
 		grp.GET("/event", checkEtteHistoricalMode, validateAPIKey, func(c *gin.Context) {
 
+			// When paginated, range width isn't limited, rather at max
+			// `limit` results are returned, in chain order
+			page, err := parsePage(c)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad page size or cursor",
+				})
+				return
+			}
+
 			fromBlock := c.Query("fromBlock")
 			toBlock := c.Query("toBlock")
 
@@ -876,8 +1788,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventByBlockHashAndLogIndex(_db, common.HexToHash(blockHash), uint(_logIndex)); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := _db.GetEventByBlockHashAndLogIndex(common.HexToHash(blockHash), uint(_logIndex)); event != nil {
+					respondWith(event, c)
 					return
 				}
 
@@ -908,8 +1820,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventByBlockNumberAndLogIndex(_db, _blockNumber, uint(_logIndex)); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := _db.GetEventByBlockNumberAndLogIndex(_blockNumber, uint(_logIndex)); event != nil {
+					respondWith(event, c)
 					return
 				}
 
@@ -923,8 +1835,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// Given blockhash, retrieves all events emitted by tx present in block
 			if strings.HasPrefix(blockHash, "0x") && len(blockHash) == 66 {
 
-				if event := db.GetEventsByBlockHash(_db, common.HexToHash(blockHash)); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := _db.GetEventsByBlockHash(common.HexToHash(blockHash), page); event != nil {
+					respondWith(event, c)
 					return
 				}
 
@@ -938,8 +1850,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// Given txhash, retrieves all events emitted by that tx ( i.e. during tx execution )
 			if strings.HasPrefix(txHash, "0x") && len(txHash) == 66 {
 
-				if event := db.GetEventsByTransactionHash(_db, common.HexToHash(txHash)); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := _db.GetEventsByTransactionHash(common.HexToHash(txHash), page); event != nil {
+					respondWith(event, c)
 					return
 				}
 
@@ -950,6 +1862,111 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 			}
 
+			// Events in block number/ time range, streamed row by row, as soon as
+			// they're read from database, when client asks for NDJSON/ CSV, in which
+			// case range width is limited by `ExportBlockRange`/ `ExportTimeRange` &
+			// contract address(es), topics are optional filters
+			if format := streamFormat(c); format != "" && page == nil && count == "" && ((fromBlock != "" && toBlock != "") || (fromTime != "" && toTime != "")) {
+
+				filter, err := parseEventFilter(c)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad event filter",
+					})
+					return
+				}
+
+				if fromBlock != "" && toBlock != "" {
+
+					_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetExportBlockRange())
+					if err != nil {
+						c.JSON(http.StatusBadRequest, gin.H{
+							"msg": "Bad block number range",
+						})
+						return
+					}
+
+					respondWithRows(c, format, func(write func(row) error) error {
+						return _db.StreamEventsByBlockNumberRangeWithFilter(filter, _fromBlock, _toBlock, func(e *d.Event) error { return write(e) })
+					})
+					return
+
+				}
+
+				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetExportTimeRange())
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad block time range",
+					})
+					return
+				}
+
+				respondWithRows(c, format, func(write func(row) error) error {
+					return _db.StreamEventsByBlockTimeRangeWithFilter(filter, _fromTime, _toTime, func(e *d.Event) error { return write(e) })
+				})
+				return
+
+			}
+
+			// Given block number/ time range & filter, holding several contract
+			// addresses and/ or set of topics for each position, returns events
+			// satisfying it
+			if c.Query("contracts") != "" || c.Query("topics") != "" {
+
+				filter, err := parseEventFilter(c)
+				if err != nil {
+					c.JSON(http.StatusBadRequest, gin.H{
+						"msg": "Bad event filter",
+					})
+					return
+				}
+
+				if fromBlock != "" && toBlock != "" {
+
+					_fromBlock, _toBlock, err := cmn.PagedRangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange(), page != nil)
+					if err != nil {
+						c.JSON(http.StatusBadRequest, gin.H{
+							"msg": "Bad block number range",
+						})
+						return
+					}
+
+					if event := _db.GetEventsByBlockNumberRangeWithFilter(filter, _fromBlock, _toBlock, page); event != nil {
+						respondWith(event, c)
+						return
+					}
+
+					c.JSON(http.StatusNotFound, gin.H{
+						"msg": "Not found",
+					})
+					return
+
+				}
+
+				if fromTime != "" && toTime != "" {
+
+					_fromTime, _toTime, err := cmn.PagedRangeChecker(fromTime, toTime, cfg.GetTimeRange(), page != nil)
+					if err != nil {
+						c.JSON(http.StatusBadRequest, gin.H{
+							"msg": "Bad block time range",
+						})
+						return
+					}
+
+					if event := _db.GetEventsByBlockTimeRangeWithFilter(filter, _fromTime, _toTime, page); event != nil {
+						respondWith(event, c)
+						return
+					}
+
+					c.JSON(http.StatusNotFound, gin.H{
+						"msg": "Not found",
+					})
+					return
+
+				}
+
+			}
+
 			// Finds out last `x` events emitted by contract
 			if count != "" && strings.HasPrefix(contract, "0x") && len(contract) == 42 {
 
@@ -968,8 +1985,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetLastXEventsFromContract(_db, common.HexToAddress(contract), _count); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := _db.GetLastXEventsFromContract(common.HexToAddress(contract), _count); event != nil {
+					respondWith(event, c)
 					return
 				}
 
@@ -984,7 +2001,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// events satisfying criteria
 			if fromBlock != "" && toBlock != "" && strings.HasPrefix(contract, "0x") && len(contract) == 42 && ((strings.HasPrefix(topic0, "0x") && len(topic0) == 66) || (strings.HasPrefix(topic1, "0x") && len(topic1) == 66) || (strings.HasPrefix(topic2, "0x") && len(topic2) == 66) || (strings.HasPrefix(topic3, "0x") && len(topic3) == 66)) {
 
-				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				_fromBlock, _toBlock, err := cmn.PagedRangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange(), page != nil)
 				if err != nil {
 
 					c.JSON(http.StatusBadRequest, gin.H{
@@ -1004,9 +2021,9 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 				}
 
-				if event := db.GetEventsFromContractWithTopicsByBlockNumberRange(_db, common.HexToAddress(contract), _fromBlock, _toBlock, topics); event != nil {
+				if event := _db.GetEventsFromContractWithTopicsByBlockNumberRange(common.HexToAddress(contract), _fromBlock, _toBlock, topics, page); event != nil {
 
-					respondWithJSON(event.ToJSON(), c)
+					respondWith(event, c)
 					return
 
 				}
@@ -1022,7 +2039,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// events satisfying criteria
 			if fromTime != "" && toTime != "" && strings.HasPrefix(contract, "0x") && len(contract) == 42 && ((strings.HasPrefix(topic0, "0x") && len(topic0) == 66) || (strings.HasPrefix(topic1, "0x") && len(topic1) == 66) || (strings.HasPrefix(topic2, "0x") && len(topic2) == 66) || (strings.HasPrefix(topic3, "0x") && len(topic3) == 66)) {
 
-				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				_fromTime, _toTime, err := cmn.PagedRangeChecker(fromTime, toTime, cfg.GetTimeRange(), page != nil)
 				if err != nil {
 
 					c.JSON(http.StatusBadRequest, gin.H{
@@ -1042,9 +2059,9 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 				}
 
-				if event := db.GetEventsFromContractWithTopicsByBlockTimeRange(_db, common.HexToAddress(contract), _fromTime, _toTime, topics); event != nil {
+				if event := _db.GetEventsFromContractWithTopicsByBlockTimeRange(common.HexToAddress(contract), _fromTime, _toTime, topics, page); event != nil {
 
-					respondWithJSON(event.ToJSON(), c)
+					respondWith(event, c)
 					return
 
 				}
@@ -1059,7 +2076,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// Given block number range & contract address, finds out all events emitted by this contract
 			if fromBlock != "" && toBlock != "" && strings.HasPrefix(contract, "0x") && len(contract) == 42 {
 
-				_fromBlock, _toBlock, err := cmn.RangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange())
+				_fromBlock, _toBlock, err := cmn.PagedRangeChecker(fromBlock, toBlock, cfg.GetBlockNumberRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block number range",
@@ -1067,8 +2084,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventsFromContractByBlockNumberRange(_db, common.HexToAddress(contract), _fromBlock, _toBlock); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := _db.GetEventsFromContractByBlockNumberRange(common.HexToAddress(contract), _fromBlock, _toBlock, page); event != nil {
+					respondWith(event, c)
 					return
 				}
 
@@ -1083,7 +2100,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 			// events emitted by this contract during time span
 			if fromTime != "" && toTime != "" && strings.HasPrefix(contract, "0x") && len(contract) == 42 {
 
-				_fromTime, _toTime, err := cmn.RangeChecker(fromTime, toTime, cfg.GetTimeRange())
+				_fromTime, _toTime, err := cmn.PagedRangeChecker(fromTime, toTime, cfg.GetTimeRange(), page != nil)
 				if err != nil {
 					c.JSON(http.StatusBadRequest, gin.H{
 						"msg": "Bad block time range",
@@ -1091,8 +2108,8 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 					return
 				}
 
-				if event := db.GetEventsFromContractByBlockTimeRange(_db, common.HexToAddress(contract), _fromTime, _toTime); event != nil {
-					respondWithJSON(event.ToJSON(), c)
+				if event := _db.GetEventsFromContractByBlockTimeRange(common.HexToAddress(contract), _fromTime, _toTime, page); event != nil {
+					respondWith(event, c)
 					return
 				}
 
@@ -1109,6 +2126,103 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 		})
 
+		// Given address, returns summary of its activity i.e. where it was seen
+		// first & last, how many tx(s) it sent & received, how many contracts it
+		// deployed, latest nonce & events emitted, if it's a contract
+		grp.GET("/address/:address", checkEtteHistoricalMode, validateAPIKey, func(c *gin.Context) {
+
+			address := c.Param("address")
+
+			if !(strings.HasPrefix(address, "0x") && len(address) == 42) {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad address",
+				})
+				return
+			}
+
+			if account := _db.GetAccount(common.HexToAddress(address)); account != nil {
+				respondWithJSON(account.ToJSON(), c)
+				return
+			}
+
+			c.JSON(http.StatusNotFound, gin.H{
+				"msg": "Not found",
+			})
+
+		})
+
+		// Given interval i.e. hour/ day & unix time range, returns hourly/ daily
+		// rollups of chain activity, starting within that range, where number of
+		// intervals covered can't exceed `MaxPageSize`
+		grp.GET("/stats/chain", checkEtteHistoricalMode, validateAPIKey, func(c *gin.Context) {
+
+			interval := c.DefaultQuery("interval", "day")
+
+			width, ok := d.ChainStatIntervals[interval]
+			if !ok {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad interval",
+				})
+				return
+			}
+
+			from, to, err := cmn.RangeChecker(c.Query("from"), c.Query("to"), cfg.GetMaxPageSize()*width)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad time range",
+				})
+				return
+			}
+
+			if stats := _db.GetChainStats(interval, from, to); stats != nil {
+				respondWithJSON(stats.ToJSON(), c)
+				return
+			}
+
+			c.JSON(http.StatusNotFound, gin.H{
+				"msg": "Not found",
+			})
+
+		})
+
+		// Ethereum JSON-RPC compatible read only interface, serving block, tx,
+		// receipt & log lookups from indexed data, so that existing clients
+		// can be pointed to `ette` for historical data
+		//
+		// Each call of batch is counted as separate delivery
+		grp.POST("/rpc", checkEtteHistoricalMode, validateAPIKey, func(c *gin.Context) {
+
+			user := _db.GetUserFromAPIKey(c.GetHeader("APIKey"))
+			if user == nil {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"msg": "Bad API Key",
+				})
+				return
+			}
+
+			body, err := ioutil.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, rpcMaxBodySize))
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad request body",
+				})
+				return
+			}
+
+			data, lengths, err := handleRPC(_db, body)
+			if err != nil {
+				requestLogger(c).WithError(err).Error("Failed to encode JSON-RPC response")
+
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"msg": "JSON encoding failed",
+				})
+				return
+			}
+
+			c.Data(http.StatusOK, "application/json", data)
+			_db.PutDataDeliveryInfos(user.Address, "/v1/rpc", lengths)
+
+		})
+
 		// Returns how many clients are currently connected to
 		// `ette` over WS
 		grp.GET("/stat", func(c *gin.Context) {
@@ -1119,6 +2233,27 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 		})
 
+		// Returns hit/ miss statistics of query result cache
+		grp.GET("/stat/cache", func(c *gin.Context) {
+
+			stats := _db.CacheStats()
+			if stats == nil {
+				c.JSON(http.StatusOK, gin.H{
+					"enabled": false,
+				})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"enabled":       true,
+				"hits":          atomic.LoadUint64(&stats.Hits),
+				"misses":        atomic.LoadUint64(&stats.Misses),
+				"hitRatio":      stats.HitRatio(),
+				"invalidations": atomic.LoadUint64(&stats.Invalidations),
+			})
+
+		})
+
 	}
 
 	router.GET("/v1/ws", func(c *gin.Context) {
@@ -1132,7 +2267,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 		if err != nil {
 
-			log.Printf("[!] Failed to upgrade to websocket : %s\n", err.Error())
+			requestLogger(c).WithError(err).Warn("Failed to upgrade to websocket")
 			return
 
 		}
@@ -1147,9 +2282,11 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 		// Increment active WS connection count
 		activeSubscriptions.Increment(1)
+		metrics.Connected("websocket")
 		// Scheduling decrement to be invoked later
 		// when disconnecting client
 		defer activeSubscriptions.Decrement(1)
+		defer metrics.Disconnected("websocket")
 
 		// To be used for concurrent safe access of
 		// underlying network socket
@@ -1166,7 +2303,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 		// Log it when closing connection
 		defer func() {
 
-			log.Printf("[✅] Closing websocket connection [ Read : %d | Write : %d ]\n", sendReceiveCounter.Receive, sendReceiveCounter.Send)
+			requestLogger(c).WithFields(log.Fields{"read": sendReceiveCounter.Receive, "write": sendReceiveCounter.Send}).Info("Closing websocket connection")
 
 		}()
 
@@ -1203,7 +2340,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 			if err := conn.ReadJSON(&req); err != nil {
 
-				log.Printf("[!] Failed to read message : %s\n", err.Error())
+				requestLogger(c).WithError(err).Debug("Failed to read message")
 				break
 
 			}
@@ -1221,7 +2358,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				connLock.Lock()
 
 				if err := conn.WriteJSON(&ps.SubscriptionResponse{Code: 0, Message: "Bad API Key"}); err != nil {
-					log.Printf("[!] Failed to write message : %s\n", err.Error())
+					requestLogger(c).WithError(err).Warn("Failed to write message")
 				}
 
 				connLock.Unlock()
@@ -1237,7 +2374,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				connLock.Lock()
 
 				if err := conn.WriteJSON(&ps.SubscriptionResponse{Code: 0, Message: "Bad API Key"}); err != nil {
-					log.Printf("[!] Failed to write message : %s\n", err.Error())
+					requestLogger(c).WithError(err).Warn("Failed to write message")
 				}
 
 				connLock.Unlock()
@@ -1245,17 +2382,20 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				break
 			}
 
+			setUser(c, user.Address)
 			userAddress := common.HexToAddress(user.Address)
 
 			// Checking if client is under allowed rate limit or not
 			if !req.IsUnderRateLimit(_db, userAddress) {
+				metrics.RateLimited("websocket")
+
 				// -- Critical section of code begins
 				//
 				// Attempting to write to shared network connection
 				connLock.Lock()
 
 				if err := conn.WriteJSON(&ps.SubscriptionResponse{Code: 0, Message: "Crossed Allowed Rate Limit"}); err != nil {
-					log.Printf("[!] Failed to write message : %s\n", err.Error())
+					requestLogger(c).WithError(err).Warn("Failed to write message")
 				}
 
 				connLock.Unlock()
@@ -1271,7 +2411,7 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				connLock.Lock()
 
 				if err := conn.WriteJSON(&ps.SubscriptionResponse{Code: 0, Message: "Bad Payload"}); err != nil {
-					log.Printf("[!] Failed to write message : %s\n", err.Error())
+					requestLogger(c).WithError(err).Warn("Failed to write message")
 				}
 
 				connLock.Unlock()
@@ -1292,6 +2432,115 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 	})
 
+	// Real-time data delivered as Server-Sent Events, for clients which
+	// can't keep websocket connection open i.e. behind proxies
+	//
+	// Same topic names, as used over websocket, are accepted & data published
+	// after one identified by `Last-Event-ID` header is replayed first, if
+	// it's still kept around
+	router.GET("/v1/sse", validateAPIKey, func(c *gin.Context) {
+
+		if !cfg.Current().RealtimeModeEnabled() {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Disabled Feature",
+			})
+			return
+		}
+
+		request := &ps.SubscriptionRequest{Name: c.Query("topic")}
+		if !request.IsValidTopic() {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "Bad topic",
+			})
+			return
+		}
+
+		lastID := sse.Last()
+		if v := c.GetHeader("Last-Event-ID"); v != "" {
+			_lastID, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{
+					"msg": "Bad Last-Event-ID",
+				})
+				return
+			}
+
+			lastID = _lastID
+		}
+
+		user := _db.GetUserFromAPIKey(c.GetHeader("APIKey"))
+		if user == nil {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"msg": "Bad API Key",
+			})
+			return
+		}
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+		c.Writer.Flush()
+
+		activeSubscriptions.Increment(1)
+		metrics.Connected("sse")
+		defer activeSubscriptions.Decrement(1)
+		defer metrics.Disconnected("sse")
+
+		keepAlive := time.NewTicker(sseKeepAliveInterval)
+		defer keepAlive.Stop()
+
+		for {
+
+			events, notify := sse.After(lastID)
+
+			for _, v := range events {
+
+				lastID = v.id
+
+				if !v.matches(request) {
+					continue
+				}
+
+				// Checking rate limit before each delivery, same as
+				// it's done for websocket clients
+				if !_db.IsUnderRateLimit(user.Address) {
+					metrics.RateLimited("sse")
+
+					fmt.Fprintf(c.Writer, "event: error\ndata: Crossed Allowed Rate Limit\n\n")
+					c.Writer.Flush()
+					return
+				}
+
+				if _, err := fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", v.id, v.topic, v.payload); err != nil {
+					requestLogger(c).WithError(err).Warn("Failed to write SSE")
+					return
+				}
+
+				_db.PutDataDeliveryInfo(user.Address, fmt.Sprintf("/v1/sse/%s", v.topic), uint64(len(v.payload)))
+				metrics.Delivered("sse", v.topic)
+
+			}
+
+			c.Writer.Flush()
+
+			select {
+
+			case <-c.Request.Context().Done():
+				return
+
+			case <-notify:
+
+			case <-keepAlive.C:
+				fmt.Fprintf(c.Writer, ": keep-alive\n\n")
+				c.Writer.Flush()
+
+			}
+
+		}
+
+	})
+
 	router.POST("/v1/graphql", validateAPIKey,
 		// Attempting to pass router context, which holds `APIKey`
 		// to graphql handler, so that some accounting job can
@@ -1315,13 +2564,14 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 				return
 			}
 
+			gql.AroundResponses(traceGraphQL)
 			gql.ServeHTTP(c.Writer, c.Request)
 
 		})
 
 	router.GET("/v1/graphql-playground", func(c *gin.Context) {
 
-		if strings.ToLower(cfg.Get("EtteGraphQLPlayGround")) != "yes" {
+		if !cfg.Current().IsGraphQLPlayGroundEnabled() {
 
 			c.JSON(http.StatusOK, gin.H{
 				"msg": "GraphQL Playground disabled",
@@ -1343,5 +2593,5 @@ func RunHTTPServer(_db *gorm.DB, _status *d.StatusHolder, _redisClient *redis.Cl
 
 	})
 
-	router.Run(fmt.Sprintf(":%s", cfg.Get("PORT")))
+	router.Run(fmt.Sprintf(":%d", cfg.Current().Port))
 }
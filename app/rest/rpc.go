@@ -0,0 +1,574 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	cmn "github.com/itzmeanjan/ette/app/common"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+)
+
+const (
+	// Largest request body accepted, in bytes
+	rpcMaxBodySize = 1 << 20
+	// Largest number of calls accepted in single batch
+	rpcMaxBatchSize = 100
+)
+
+// Error codes, as defined in JSON-RPC 2.0 specification
+const (
+	rpcParseError     = -32700
+	rpcInvalidRequest = -32600
+	rpcMethodNotFound = -32601
+	rpcInvalidParams  = -32602
+	rpcInternalError  = -32603
+)
+
+// rpcRequest - Single JSON-RPC call, as sent by client
+type rpcRequest struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+// rpcError - Reason behind failure of JSON-RPC call
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// rpcResponse - Result of JSON-RPC call, where only one of result
+// or error is present
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcBlock - Block, as returned by `eth_getBlockBy*`, where `Transactions`
+// holds either tx hashes or full tx objects
+type rpcBlock struct {
+	Number           hexutil.Uint64 `json:"number"`
+	Hash             string         `json:"hash"`
+	ParentHash       string         `json:"parentHash"`
+	Nonce            string         `json:"nonce"`
+	Sha3Uncles       string         `json:"sha3Uncles"`
+	TransactionsRoot string         `json:"transactionsRoot"`
+	StateRoot        string         `json:"stateRoot"`
+	ReceiptsRoot     string         `json:"receiptsRoot"`
+	Miner            string         `json:"miner"`
+	Difficulty       *hexutil.Big   `json:"difficulty"`
+	ExtraData        hexutil.Bytes  `json:"extraData"`
+	Size             hexutil.Uint64 `json:"size"`
+	GasLimit         hexutil.Uint64 `json:"gasLimit"`
+	GasUsed          hexutil.Uint64 `json:"gasUsed"`
+	Timestamp        hexutil.Uint64 `json:"timestamp"`
+	BaseFeePerGas    *hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	Transactions     []interface{}  `json:"transactions"`
+	Uncles           []string       `json:"uncles"`
+}
+
+// rpcTransaction - Tx, as returned by `eth_getTransactionByHash`
+type rpcTransaction struct {
+	Hash             string         `json:"hash"`
+	Nonce            hexutil.Uint64 `json:"nonce"`
+	BlockHash        string         `json:"blockHash"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	From             string         `json:"from"`
+	To               *string        `json:"to"`
+	Value            *hexutil.Big   `json:"value"`
+	Gas              hexutil.Uint64 `json:"gas"`
+	GasPrice         *hexutil.Big   `json:"gasPrice"`
+	Input            hexutil.Bytes  `json:"input"`
+}
+
+// rpcLog - Event, as returned by `eth_getLogs` & as part of tx receipt
+type rpcLog struct {
+	Address          string         `json:"address"`
+	Topics           []string       `json:"topics"`
+	Data             hexutil.Bytes  `json:"data"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	BlockHash        string         `json:"blockHash"`
+	TransactionHash  string         `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	LogIndex         hexutil.Uint64 `json:"logIndex"`
+	Removed          bool           `json:"removed"`
+}
+
+// rpcReceipt - Tx receipt, as returned by `eth_getTransactionReceipt`
+type rpcReceipt struct {
+	TransactionHash   string         `json:"transactionHash"`
+	TransactionIndex  hexutil.Uint64 `json:"transactionIndex"`
+	BlockHash         string         `json:"blockHash"`
+	BlockNumber       hexutil.Uint64 `json:"blockNumber"`
+	From              string         `json:"from"`
+	To                *string        `json:"to"`
+	ContractAddress   *string        `json:"contractAddress"`
+	Status            hexutil.Uint64 `json:"status"`
+	EffectiveGasPrice *hexutil.Big   `json:"effectiveGasPrice"`
+	Logs              []*rpcLog      `json:"logs"`
+}
+
+// rpcFilter - Criteria for `eth_getLogs`, where either block hash or
+// block number range is given
+type rpcFilter struct {
+	BlockHash *common.Hash    `json:"blockHash"`
+	FromBlock string          `json:"fromBlock"`
+	ToBlock   string          `json:"toBlock"`
+	Address   json.RawMessage `json:"address"`
+	Topics    json.RawMessage `json:"topics"`
+}
+
+// rpcFailure - Builds error to be sent back, for failed JSON-RPC call
+func rpcFailure(code int, format string, args ...interface{}) *rpcError {
+	return &rpcError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// numericToHex - Decimal numeric value as hex encoded big integer, nil
+// when absent
+func numericToHex(value d.Numeric) *hexutil.Big {
+	if value == "" {
+		return nil
+	}
+
+	_value, ok := new(big.Int).SetString(string(value), 10)
+	if !ok {
+		return nil
+	}
+
+	return (*hexutil.Big)(_value)
+}
+
+// toRPCBlock - Converts block, along with its tx(s), into JSON-RPC form
+func toRPCBlock(block *d.Block, txs *d.Transactions, full bool) *rpcBlock {
+	// Nonce is persisted as hex encoded quantity, while 8 bytes
+	// long data is expected here
+	nonce, err := hexutil.DecodeUint64(block.Nonce)
+	if err != nil {
+		nonce = 0
+	}
+
+	difficulty := numericToHex(block.Difficulty)
+	if difficulty == nil {
+		difficulty = (*hexutil.Big)(new(big.Int))
+	}
+
+	_block := &rpcBlock{
+		Number:           hexutil.Uint64(block.Number),
+		Hash:             block.Hash,
+		ParentHash:       block.ParentHash,
+		Nonce:            fmt.Sprintf("0x%016x", nonce),
+		Sha3Uncles:       block.UncleHash,
+		TransactionsRoot: block.TransactionRootHash,
+		StateRoot:        block.StateRootHash,
+		ReceiptsRoot:     block.ReceiptRootHash,
+		Miner:            block.Miner,
+		Difficulty:       difficulty,
+		ExtraData:        hexutil.Bytes(block.ExtraData),
+		Size:             hexutil.Uint64(block.Size),
+		GasLimit:         hexutil.Uint64(block.GasLimit),
+		GasUsed:          hexutil.Uint64(block.GasUsed),
+		Timestamp:        hexutil.Uint64(block.Time),
+		BaseFeePerGas:    numericToHex(block.BaseFee),
+		Transactions:     make([]interface{}, 0),
+		Uncles:           make([]string, 0),
+	}
+
+	if txs == nil {
+		return _block
+	}
+
+	for _, t := range txs.Transactions {
+		if full {
+			_block.Transactions = append(_block.Transactions, toRPCTransaction(t))
+			continue
+		}
+
+		_block.Transactions = append(_block.Transactions, t.Hash)
+	}
+
+	return _block
+}
+
+// toRPCTransaction - Converts tx into JSON-RPC form, where recipient
+// is left empty for contract creation tx
+func toRPCTransaction(tx *d.Transaction) *rpcTransaction {
+	_tx := &rpcTransaction{
+		Hash:             tx.Hash,
+		Nonce:            hexutil.Uint64(tx.Nonce),
+		BlockHash:        tx.BlockHash,
+		BlockNumber:      hexutil.Uint64(tx.BlockNumber),
+		TransactionIndex: hexutil.Uint64(tx.Index),
+		From:             tx.From,
+		Value:            numericToHex(tx.Value),
+		Gas:              hexutil.Uint64(tx.Gas),
+		GasPrice:         numericToHex(tx.GasPrice),
+		Input:            hexutil.Bytes(tx.Data),
+	}
+
+	if strings.HasPrefix(tx.To, "0x") {
+		to := tx.To
+		_tx.To = &to
+	}
+
+	if _tx.Value == nil {
+		_tx.Value = (*hexutil.Big)(new(big.Int))
+	}
+
+	return _tx
+}
+
+// toRPCLogs - Converts events into JSON-RPC form, where tx index of each
+// one is looked up using `txIndex`, keyed by tx hash
+func toRPCLogs(events []*d.Event, txIndex map[string]uint) []*rpcLog {
+	logs := make([]*rpcLog, 0, len(events))
+
+	for _, e := range events {
+		logs = append(logs, &rpcLog{
+			Address:          e.Origin,
+			Topics:           append(make([]string, 0, len(e.Topics)), e.Topics...),
+			Data:             hexutil.Bytes(e.Data),
+			BlockNumber:      hexutil.Uint64(e.BlockNumber),
+			BlockHash:        e.BlockHash,
+			TransactionHash:  e.TransactionHash,
+			TransactionIndex: hexutil.Uint64(txIndex[e.TransactionHash]),
+			LogIndex:         hexutil.Uint64(e.Index),
+		})
+	}
+
+	return logs
+}
+
+// parseRPCParams - Decodes positional params of JSON-RPC call into given
+// variables, where trailing ones are optional, when `required` is lesser
+func parseRPCParams(params []json.RawMessage, required int, values ...interface{}) *rpcError {
+	if len(params) < required || len(params) > len(values) {
+		return rpcFailure(rpcInvalidParams, "expected %d params, got %d", len(values), len(params))
+	}
+
+	for k, v := range params {
+		if err := json.Unmarshal(v, values[k]); err != nil {
+			return rpcFailure(rpcInvalidParams, "bad param at position %d", k)
+		}
+	}
+
+	return nil
+}
+
+// parseRPCBlockNumber - Resolves block number/ tag into block number, where
+// tags denoting chain head point to latest block indexed by `ette`
+func parseRPCBlockNumber(_db db.Store, number string) (uint64, *rpcError) {
+	switch number {
+
+	case "", "latest", "pending", "safe", "finalized":
+		return _db.GetCurrentBlockNumber(), nil
+	case "earliest":
+		return 0, nil
+
+	}
+
+	_number, err := hexutil.DecodeUint64(number)
+	if err != nil {
+		return 0, rpcFailure(rpcInvalidParams, "bad block number")
+	}
+
+	return _number, nil
+}
+
+// rpcBlockResult - Looks up tx(s) of block & converts it into JSON-RPC form,
+// where block not being found results into `null`
+func rpcBlockResult(_db db.Store, block *d.Block, full bool) (interface{}, *rpcError) {
+	if block == nil {
+		return nil, nil
+	}
+
+	return toRPCBlock(block, _db.GetTransactionsByBlockHash(common.HexToHash(block.Hash), nil), full), nil
+}
+
+// rpcGetBlockByNumber - Handler for `eth_getBlockByNumber`
+func rpcGetBlockByNumber(_db db.Store, params []json.RawMessage) (interface{}, *rpcError) {
+	var number string
+	var full bool
+
+	if err := parseRPCParams(params, 1, &number, &full); err != nil {
+		return nil, err
+	}
+
+	_number, err := parseRPCBlockNumber(_db, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcBlockResult(_db, _db.GetBlockByNumber(_number), full)
+}
+
+// rpcGetBlockByHash - Handler for `eth_getBlockByHash`
+func rpcGetBlockByHash(_db db.Store, params []json.RawMessage) (interface{}, *rpcError) {
+	var hash common.Hash
+	var full bool
+
+	if err := parseRPCParams(params, 1, &hash, &full); err != nil {
+		return nil, err
+	}
+
+	return rpcBlockResult(_db, _db.GetBlockByHash(hash), full)
+}
+
+// rpcGetTransactionByHash - Handler for `eth_getTransactionByHash`
+func rpcGetTransactionByHash(_db db.Store, params []json.RawMessage) (interface{}, *rpcError) {
+	var hash common.Hash
+
+	if err := parseRPCParams(params, 1, &hash); err != nil {
+		return nil, err
+	}
+
+	tx := _db.GetTransactionByHash(hash)
+	if tx == nil {
+		return nil, nil
+	}
+
+	return toRPCTransaction(tx), nil
+}
+
+// rpcGetTransactionReceipt - Handler for `eth_getTransactionReceipt`, built
+// using tx & events emitted during its execution
+func rpcGetTransactionReceipt(_db db.Store, params []json.RawMessage) (interface{}, *rpcError) {
+	var hash common.Hash
+
+	if err := parseRPCParams(params, 1, &hash); err != nil {
+		return nil, err
+	}
+
+	tx := _db.GetTransactionByHash(hash)
+	if tx == nil {
+		return nil, nil
+	}
+
+	_tx := toRPCTransaction(tx)
+
+	receipt := &rpcReceipt{
+		TransactionHash:   tx.Hash,
+		TransactionIndex:  _tx.TransactionIndex,
+		BlockHash:         tx.BlockHash,
+		BlockNumber:       _tx.BlockNumber,
+		From:              tx.From,
+		To:                _tx.To,
+		Status:            hexutil.Uint64(tx.State),
+		EffectiveGasPrice: _tx.GasPrice,
+		Logs:              make([]*rpcLog, 0),
+	}
+
+	if strings.HasPrefix(tx.Contract, "0x") {
+		contract := tx.Contract
+		receipt.ContractAddress = &contract
+	}
+
+	if events := _db.GetEventsByTransactionHash(hash, nil); events != nil {
+		receipt.Logs = toRPCLogs(events.Events, map[string]uint{tx.Hash: tx.Index})
+	}
+
+	return receipt, nil
+}
+
+// rpcGetLogs - Handler for `eth_getLogs`, where block number range can't be
+// wider than `BlockRange`
+func rpcGetLogs(_db db.Store, params []json.RawMessage) (interface{}, *rpcError) {
+	var filter rpcFilter
+
+	if err := parseRPCParams(params, 1, &filter); err != nil {
+		return nil, err
+	}
+
+	// Address is either single one or array of them
+	var contracts []string
+	if len(filter.Address) != 0 && !bytes.Equal(filter.Address, []byte("null")) {
+		var address string
+		if err := json.Unmarshal(filter.Address, &address); err == nil {
+			contracts = []string{address}
+		} else if err := json.Unmarshal(filter.Address, &contracts); err != nil {
+			return nil, rpcFailure(rpcInvalidParams, "bad address")
+		}
+	}
+
+	var topics [][]string
+	if len(filter.Topics) != 0 && !bytes.Equal(filter.Topics, []byte("null")) {
+		_topics, err := cmn.ParseEventTopics(filter.Topics)
+		if err != nil {
+			return nil, rpcFailure(rpcInvalidParams, "bad topics")
+		}
+
+		topics = _topics
+	}
+
+	_filter, _err := cmn.ParseEventFilter(contracts, topics)
+	if _err != nil {
+		return nil, rpcFailure(rpcInvalidParams, strings.ToLower(_err.Error()))
+	}
+
+	var from, to uint64
+
+	if filter.BlockHash != nil {
+		block := _db.GetBlockByHash(*filter.BlockHash)
+		if block == nil {
+			return nil, rpcFailure(rpcInvalidParams, "unknown block")
+		}
+
+		from, to = block.Number, block.Number
+	} else {
+		var err *rpcError
+
+		if from, err = parseRPCBlockNumber(_db, filter.FromBlock); err != nil {
+			return nil, err
+		}
+
+		if to, err = parseRPCBlockNumber(_db, filter.ToBlock); err != nil {
+			return nil, err
+		}
+	}
+
+	if from > to || !(to-from < cfg.GetBlockNumberRange()) {
+		return nil, rpcFailure(rpcInvalidParams, "bad block range, at max %d blocks can be queried", cfg.GetBlockNumberRange())
+	}
+
+	events := _db.GetEventsByBlockNumberRangeWithFilter(_filter, from, to, nil)
+	if events == nil {
+		return make([]*rpcLog, 0), nil
+	}
+
+	// Position of tx(s) in respective blocks, looked up all at once
+	hashes := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, e := range events.Events {
+		if seen[e.TransactionHash] {
+			continue
+		}
+
+		seen[e.TransactionHash] = true
+		hashes = append(hashes, e.TransactionHash)
+	}
+
+	return toRPCLogs(events.Events, _db.GetTransactionIndexes(from, to, hashes)), nil
+}
+
+// rpcBlockNumber - Handler for `eth_blockNumber`, returning latest block
+// indexed by `ette`
+func rpcBlockNumber(_db db.Store, params []json.RawMessage) (interface{}, *rpcError) {
+	if err := parseRPCParams(params, 0); err != nil {
+		return nil, err
+	}
+
+	return hexutil.Uint64(_db.GetCurrentBlockNumber()), nil
+}
+
+// rpcMethods - Supported JSON-RPC methods, all served from indexed data
+var rpcMethods = map[string]func(db.Store, []json.RawMessage) (interface{}, *rpcError){
+	"eth_getBlockByNumber":      rpcGetBlockByNumber,
+	"eth_getBlockByHash":        rpcGetBlockByHash,
+	"eth_getTransactionByHash":  rpcGetTransactionByHash,
+	"eth_getTransactionReceipt": rpcGetTransactionReceipt,
+	"eth_getLogs":               rpcGetLogs,
+	"eth_blockNumber":           rpcBlockNumber,
+}
+
+// handleRPCCall - Invokes requested method, building response to be sent back
+func handleRPCCall(_db db.Store, req *rpcRequest) *rpcResponse {
+	resp := &rpcResponse{JSONRPC: "2.0", ID: req.ID}
+	if len(resp.ID) == 0 {
+		resp.ID = json.RawMessage("null")
+	}
+
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		resp.Error = rpcFailure(rpcInvalidRequest, "invalid request")
+		return resp
+	}
+
+	method, ok := rpcMethods[req.Method]
+	if !ok {
+		resp.Error = rpcFailure(rpcMethodNotFound, "the method %s does not exist/is not available", req.Method)
+		return resp
+	}
+
+	result, err := method(_db, req.Params)
+	if err != nil {
+		resp.Error = err
+		return resp
+	}
+
+	data, _err := json.Marshal(result)
+	if _err != nil {
+		resp.Error = rpcFailure(rpcInternalError, "failed to encode result")
+		return resp
+	}
+
+	resp.Result = data
+	return resp
+}
+
+// handleRPC - Handles JSON-RPC request body, holding either single call
+// or batch of them, returning encoded response, along with encoded length of
+// response to each call, so that each one can be counted against plan
+//
+// Batch can't have more than `rpcMaxBatchSize` calls
+func handleRPC(_db db.Store, body []byte) ([]byte, []uint64, error) {
+	body = bytes.TrimSpace(body)
+
+	failure := func(code int, message string) ([]byte, []uint64, error) {
+		data, err := json.Marshal(&rpcResponse{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: rpcFailure(code, message)})
+		return data, []uint64{uint64(len(data))}, err
+	}
+
+	// Batch of calls, responded to in same order
+	if len(body) != 0 && body[0] == '[' {
+		var reqs []*rpcRequest
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return failure(rpcParseError, "parse error")
+		}
+
+		if len(reqs) == 0 {
+			return failure(rpcInvalidRequest, "empty batch")
+		}
+
+		if len(reqs) > rpcMaxBatchSize {
+			return failure(rpcInvalidRequest, fmt.Sprintf("batch too large, at max %d calls allowed", rpcMaxBatchSize))
+		}
+
+		resps := make([]json.RawMessage, 0, len(reqs))
+		lengths := make([]uint64, 0, len(reqs))
+
+		for _, req := range reqs {
+			if req == nil {
+				req = &rpcRequest{}
+			}
+
+			data, err := json.Marshal(handleRPCCall(_db, req))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			resps = append(resps, data)
+			lengths = append(lengths, uint64(len(data)))
+		}
+
+		data, err := json.Marshal(resps)
+		return data, lengths, err
+	}
+
+	var req rpcRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return failure(rpcParseError, "parse error")
+	}
+
+	data, err := json.Marshal(handleRPCCall(_db, &req))
+	return data, []uint64{uint64(len(data))}, err
+}
@@ -0,0 +1,182 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/itzmeanjan/ette/app/db"
+)
+
+// rpcTestStore - Store serving only latest block number, which is
+// all non-failing calls in these tests need
+type rpcTestStore struct {
+	db.Store
+}
+
+func (rpcTestStore) GetCurrentBlockNumber() uint64 {
+	return 0x10
+}
+
+func TestHandleRPC(t *testing.T) {
+	tooLarge := make([]string, rpcMaxBatchSize+1)
+	for k := range tooLarge {
+		tooLarge[k] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"eth_blockNumber"}`, k)
+	}
+
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+		calls    int
+	}{
+		{
+			"single call",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`,
+			`{"jsonrpc":"2.0","id":1,"result":"0x10"}`,
+			1,
+		},
+		{
+			"parse error",
+			`{"jsonrpc":`,
+			`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"parse error"}}`,
+			1,
+		},
+		{
+			"bad version",
+			`{"jsonrpc":"1.0","id":"a","method":"eth_blockNumber"}`,
+			`{"jsonrpc":"2.0","id":"a","error":{"code":-32600,"message":"invalid request"}}`,
+			1,
+		},
+		{
+			"unknown method",
+			`{"jsonrpc":"2.0","id":2,"method":"eth_sendRawTransaction"}`,
+			`{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"the method eth_sendRawTransaction does not exist/is not available"}}`,
+			1,
+		},
+		{
+			"bad params",
+			`{"jsonrpc":"2.0","id":3,"method":"eth_blockNumber","params":[1]}`,
+			`{"jsonrpc":"2.0","id":3,"error":{"code":-32602,"message":"expected 0 params, got 1"}}`,
+			1,
+		},
+		{
+			"empty batch",
+			`[]`,
+			`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"empty batch"}}`,
+			1,
+		},
+		{
+			"batch",
+			` [{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}, null, {"jsonrpc":"2.0","id":2,"method":"eth_foo"}]`,
+			`[{"jsonrpc":"2.0","id":1,"result":"0x10"},{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"invalid request"}},{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"the method eth_foo does not exist/is not available"}}]`,
+			3,
+		},
+		{
+			"batch too large",
+			"[" + strings.Join(tooLarge, ",") + "]",
+			fmt.Sprintf(`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"batch too large, at max %d calls allowed"}}`, rpcMaxBatchSize),
+			1,
+		},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			data, lengths, err := handleRPC(rpcTestStore{}, []byte(v.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(data) != v.expected {
+				t.Fatalf("expected %s, found %s", v.expected, data)
+			}
+
+			if len(lengths) != v.calls {
+				t.Fatalf("expected %d calls counted, found %d", v.calls, len(lengths))
+			}
+
+			// Batch response lengths are those of each encoded call
+			var resps []json.RawMessage
+			if json.Unmarshal(data, &resps) == nil {
+				for k, r := range resps {
+					if uint64(len(r)) != lengths[k] {
+						t.Fatalf("expected length %d for call %d, found %d", len(r), k, lengths[k])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestParseRPCBlockNumber(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected uint64
+		err      bool
+	}{
+		{"", 0x10, false},
+		{"latest", 0x10, false},
+		{"pending", 0x10, false},
+		{"safe", 0x10, false},
+		{"finalized", 0x10, false},
+		{"earliest", 0, false},
+		{"0x0", 0, false},
+		{"0x1b4", 0x1b4, false},
+		{"436", 0, true},
+		{"0x", 0, true},
+		{"0x01", 0, true},
+	}
+
+	for _, v := range tests {
+		t.Run(v.number, func(t *testing.T) {
+			number, err := parseRPCBlockNumber(rpcTestStore{}, v.number)
+
+			if v.err {
+				if err == nil || err.Code != rpcInvalidParams {
+					t.Fatalf("expected invalid params error, found %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected %d, found error: %s", v.expected, err.Message)
+			}
+
+			if number != v.expected {
+				t.Fatalf("expected %d, found %d", v.expected, number)
+			}
+		})
+	}
+}
+
+func TestParseRPCParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params string
+		err    bool
+	}{
+		{"required only", `["0x1"]`, false},
+		{"with optional", `["0x1", true]`, false},
+		{"missing required", `[]`, true},
+		{"too many", `["0x1", true, 1]`, true},
+		{"bad type", `[1]`, true},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			var params []json.RawMessage
+			if err := json.Unmarshal([]byte(v.params), &params); err != nil {
+				t.Fatal(err)
+			}
+
+			var number string
+			var full bool
+
+			if err := parseRPCParams(params, 1, &number, &full); (err != nil) != v.err {
+				t.Fatalf("expected error %v, found %v", v.err, err)
+			}
+		})
+	}
+}
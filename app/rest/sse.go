@@ -0,0 +1,162 @@
+package rest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	d "github.com/itzmeanjan/ette/app/data"
+	ps "github.com/itzmeanjan/ette/app/pubsub"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// How many recently published pieces of data are kept, so that
+	// SSE clients reconnecting with `Last-Event-ID` can resume
+	sseReplaySize = 10000
+	// How often comment is sent to idle SSE clients, so that proxies
+	// in between don't close connection
+	sseKeepAliveInterval = 15 * time.Second
+)
+
+// sseEvent - Piece of real-time data published on Redis, along with
+// identifier it's delivered to SSE clients with
+type sseEvent struct {
+	id      uint64
+	topic   string
+	payload string
+	matches func(*ps.SubscriptionRequest) bool
+}
+
+// sseHub - Listens for real-time data published on block, tx & event topics,
+// once for all SSE clients, keeping recent ones around for replaying
+//
+// Identifiers start from current time in microseconds, so that ones handed
+// out after restart are always larger than ones handed out before it, while
+// being consecutive otherwise, which is why each one has its fixed slot in
+// replay ring buffer
+type sseHub struct {
+	lock   *sync.RWMutex
+	events []*sseEvent
+	count  int
+	next   uint64
+	notify chan struct{}
+}
+
+// newSSEHub - Creates hub, with nothing to be replayed yet
+func newSSEHub() *sseHub {
+	return &sseHub{
+		lock:   &sync.RWMutex{},
+		events: make([]*sseEvent, sseReplaySize),
+		next:   uint64(time.Now().UnixNano() / 1000),
+		notify: make(chan struct{}),
+	}
+}
+
+// Listen - Subscribes to block, tx & event topics, keeping each piece of
+// data published there, until context gets cancelled
+func (h *sseHub) Listen(ctx context.Context, redisInfo *d.RedisInfo) {
+
+	pubsub := redisInfo.Client.Subscribe(ctx, redisInfo.BlockPublishTopic, redisInfo.TxPublishTopic, redisInfo.EventPublishTopic)
+	defer func() {
+		if err := pubsub.Close(); err != nil {
+			log.WithError(err).Error("Failed to close SSE pubsub subscription")
+		}
+	}()
+
+	messages := pubsub.Channel()
+
+	for {
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case m, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var topic string
+
+			switch m.Channel {
+
+			case redisInfo.BlockPublishTopic:
+				topic = "block"
+			case redisInfo.TxPublishTopic:
+				topic = "transaction"
+			case redisInfo.EventPublishTopic:
+				topic = "event"
+			default:
+				continue
+
+			}
+
+			matches := ps.PublishedDataMatcher(topic, m.Payload)
+			if matches == nil {
+				continue
+			}
+
+			h.Put(topic, m.Payload, matches)
+
+		}
+
+	}
+
+}
+
+// Put - Keeps published data, overwriting oldest one when replay buffer is
+// full, while waking up all SSE clients waiting for new data
+func (h *sseHub) Put(topic string, payload string, matches func(*ps.SubscriptionRequest) bool) {
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.events[h.next%sseReplaySize] = &sseEvent{id: h.next, topic: topic, payload: payload, matches: matches}
+	h.next++
+
+	if h.count < sseReplaySize {
+		h.count++
+	}
+
+	close(h.notify)
+	h.notify = make(chan struct{})
+
+}
+
+// After - Returns all kept data published after one with given identifier,
+// along with channel, which gets closed when anything new is published
+func (h *sseHub) After(id uint64) ([]*sseEvent, <-chan struct{}) {
+
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	if id >= h.next {
+		return nil, h.notify
+	}
+
+	from := id + 1
+	if oldest := h.next - uint64(h.count); from < oldest {
+		from = oldest
+	}
+
+	events := make([]*sseEvent, 0, h.next-from)
+	for i := from; i < h.next; i++ {
+		events = append(events, h.events[i%sseReplaySize])
+	}
+
+	return events, h.notify
+
+}
+
+// Last - Identifier of most recently published data, so that client
+// connecting without `Last-Event-ID` gets only data published from now on
+func (h *sseHub) Last() uint64 {
+
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.next - 1
+
+}
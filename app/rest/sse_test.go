@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"testing"
+
+	ps "github.com/itzmeanjan/ette/app/pubsub"
+)
+
+func TestSSEHubReplay(t *testing.T) {
+	h := newSSEHub()
+	first := h.next
+
+	matches := func(*ps.SubscriptionRequest) bool { return true }
+	for i := 0; i < sseReplaySize+10; i++ {
+		h.Put("block", "{}", matches)
+	}
+
+	if last := h.Last(); last != first+sseReplaySize+9 {
+		t.Fatalf("expected last id %d, found %d", first+sseReplaySize+9, last)
+	}
+
+	tests := []struct {
+		name  string
+		after uint64
+		count int
+		from  uint64
+	}{
+		{"older than kept", first, sseReplaySize, first + 10},
+		{"within kept", first + sseReplaySize, 9, first + sseReplaySize + 1},
+		{"latest", first + sseReplaySize + 9, 0, 0},
+		{"from future", first + 2*sseReplaySize, 0, 0},
+		{"max id", ^uint64(0), 0, 0},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			events, notify := h.After(v.after)
+			if notify == nil {
+				t.Fatal("expected notification channel")
+			}
+
+			if len(events) != v.count {
+				t.Fatalf("expected %d events, found %d", v.count, len(events))
+			}
+
+			for k, e := range events {
+				if e.id != v.from+uint64(k) {
+					t.Fatalf("expected id %d at %d, found %d", v.from+uint64(k), k, e.id)
+				}
+			}
+		})
+	}
+}
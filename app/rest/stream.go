@@ -0,0 +1,302 @@
+package rest
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+)
+
+// Streamed response formats, client can ask for using `Accept` header
+const (
+	ndjsonFormat = "application/x-ndjson"
+	csvFormat    = "text/csv"
+)
+
+// How many rows are written before flushing response, so that client
+// starts receiving them, while query is still being run
+const streamFlushInterval = 100
+
+// How many rows are counted as single delivery against subscription plan,
+// which is also how streamed response gets limited to what's left of plan
+const streamRowsPerDelivery = 1000
+
+// errRowBudgetExhausted - Streamed response has as many rows as can be
+// delivered, as per what was left of subscription plan, when it started
+var errRowBudgetExhausted = errors.New("Crossed Allowed Rate Limit")
+
+// row - Single block/ tx/ event, which can be written as line of
+// NDJSON or record of CSV
+type row interface {
+	MarshalJSON() ([]byte, error)
+	CSVHeader() []string
+	CSVRecord() []string
+}
+
+// streamFormat - Streamed response format asked for by client, empty when
+// response is to be delivered as single JSON document
+func streamFormat(c *gin.Context) string {
+	for _, v := range strings.Split(c.GetHeader("Accept"), ",") {
+		switch strings.TrimSpace(strings.Split(v, ";")[0]) {
+		case ndjsonFormat:
+			return ndjsonFormat
+		case csvFormat:
+			return csvFormat
+		}
+	}
+
+	return ""
+}
+
+// rowsOf - Rows present in query result, in order
+func rowsOf(result interface{}) []row {
+	rows := make([]row, 0)
+
+	switch v := result.(type) {
+
+	case *d.Block:
+		rows = append(rows, v)
+	case *d.Blocks:
+		for _, b := range v.Blocks {
+			rows = append(rows, b)
+		}
+	case *d.Transaction:
+		rows = append(rows, v)
+	case *d.Transactions:
+		for _, t := range v.Transactions {
+			rows = append(rows, t)
+		}
+	case *d.Event:
+		rows = append(rows, v)
+	case *d.Events:
+		for _, e := range v.Events {
+			rows = append(rows, e)
+		}
+
+	}
+
+	return rows
+}
+
+// nextCursorOf - Cursor to next page of paginated query result, if any
+func nextCursorOf(result interface{}) string {
+	switch v := result.(type) {
+
+	case *d.Blocks:
+		return v.Next
+	case *d.Transactions:
+		return v.Next
+	case *d.Events:
+		return v.Next
+
+	}
+
+	return ""
+}
+
+// countingWriter - Keeps track of how many bytes written to client,
+// to be recorded for rate limiting
+type countingWriter struct {
+	writer  io.Writer
+	written uint64
+}
+
+// Write - Writes to underlying writer, counting bytes written
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.writer.Write(p)
+	c.written += uint64(n)
+
+	return n, err
+}
+
+// rowWriter - Writes rows to client in streamed format, one by one, where
+// response headers are sent along with first row, so that it's still
+// possible to respond with error, when there's nothing to write
+//
+// At max `budget` rows are written, where bytes written for every
+// `streamRowsPerDelivery` rows are kept, to be recorded as one delivery
+// each, once streaming is done
+type rowWriter struct {
+	c          *gin.Context
+	format     string
+	out        *countingWriter
+	csv        *csv.Writer
+	rows       uint64
+	budget     uint64
+	charged    uint64
+	deliveries []uint64
+}
+
+// newRowWriter - Creates writer for streaming at max `budget` rows to
+// client in given format
+func newRowWriter(c *gin.Context, format string, budget uint64) *rowWriter {
+	out := &countingWriter{writer: c.Writer}
+
+	return &rowWriter{c: c, format: format, out: out, csv: csv.NewWriter(out), budget: budget, deliveries: make([]uint64, 0)}
+}
+
+// Write - Writes row to client, flushing periodically, while refusing to
+// write more than budgeted rows
+func (r *rowWriter) Write(_row row) error {
+	if r.rows >= r.budget {
+		return errRowBudgetExhausted
+	}
+
+	if r.rows == 0 {
+		r.c.Header("Content-Type", r.format)
+		r.c.Status(http.StatusOK)
+
+		if r.format == csvFormat {
+			if err := r.csv.Write(_row.CSVHeader()); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch r.format {
+
+	case csvFormat:
+		if err := r.csv.Write(_row.CSVRecord()); err != nil {
+			return err
+		}
+
+	default:
+		data, err := _row.MarshalJSON()
+		if err != nil {
+			return err
+		}
+
+		if _, err := r.out.Write(append(data, '\n')); err != nil {
+			return err
+		}
+
+	}
+
+	r.rows++
+
+	if r.rows%streamFlushInterval == 0 {
+		if err := r.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if r.rows%streamRowsPerDelivery == 0 {
+		r.closeDelivery()
+	}
+
+	return nil
+}
+
+// closeDelivery - Keeps bytes written since last delivery, if any, as one delivery
+func (r *rowWriter) closeDelivery() {
+	r.csv.Flush()
+
+	if pending := r.out.written - r.charged; pending != 0 {
+		r.deliveries = append(r.deliveries, pending)
+		r.charged = r.out.written
+	}
+}
+
+// Deliveries - Bytes written for each delivery, including rows written
+// after last full one, to be invoked once streaming is done
+func (r *rowWriter) Deliveries() []uint64 {
+	r.closeDelivery()
+	return r.deliveries
+}
+
+// Flush - Sends all rows written so far to client
+func (r *rowWriter) Flush() error {
+	r.csv.Flush()
+	if err := r.csv.Error(); err != nil {
+		return err
+	}
+
+	r.c.Writer.Flush()
+	return nil
+}
+
+// Rows - How many rows written so far
+func (r *rowWriter) Rows() uint64 {
+	return r.rows
+}
+
+// respondWithStream - Streams rows, handed over by `stream` as soon as they're
+// read from database, to client in asked format i.e. NDJSON/ CSV, where every
+// `streamRowsPerDelivery` rows are recorded as one data delivery
+//
+// Database cursor is kept open while rows are written, when no other query can
+// be run on same connection i.e. sqlite has only one, so what's left of plan is
+// looked up before streaming & deliveries are recorded once it's done
+//
+// Failure after first row is written can't be reported to client anymore,
+// other than by abruptly ending response, so it's only logged
+func respondWithStream(_db db.Store, c *gin.Context, endpoint string, format string, stream func(write func(row) error) error) {
+
+	user := _db.GetUserFromAPIKey(c.GetHeader("APIKey"))
+	if user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"msg": "Bad API Key",
+		})
+		return
+	}
+
+	remaining := _db.GetRemainingDeliveries(user.Address)
+	if remaining == 0 {
+		metrics.RateLimited("rest")
+
+		c.JSON(http.StatusTooManyRequests, gin.H{
+			"msg": "Crossed Allowed Rate Limit",
+		})
+		return
+	}
+
+	writer := newRowWriter(c, format, remaining*streamRowsPerDelivery)
+
+	err := stream(writer.Write)
+
+	if endpoint != "" {
+		_db.PutDataDeliveryInfos(user.Address, endpoint, writer.Deliveries())
+	}
+
+	if writer.Rows() == 0 {
+
+		if err != nil {
+			requestLogger(c).WithError(err).Error("Failed to stream rows")
+
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"msg": "Failed to query data",
+			})
+			return
+		}
+
+		c.JSON(http.StatusNotFound, gin.H{
+			"msg": "Not found",
+		})
+		return
+
+	}
+
+	// Streaming got stopped, because plan got exhausted, while rows
+	// written till then are sent to client
+	if errors.Is(err, errRowBudgetExhausted) {
+		metrics.RateLimited("rest")
+		requestLogger(c).WithField("rows", writer.Rows()).Warn("Stopped streaming rows, crossed allowed rate limit")
+
+		err = nil
+	}
+
+	if err == nil {
+		err = writer.Flush()
+	}
+
+	if err != nil {
+		requestLogger(c).WithError(err).Error("Failed to stream rows")
+	}
+
+}
@@ -0,0 +1,184 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+)
+
+func TestRowWriterDeliveries(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		format     string
+		rows       int
+		budget     uint64
+		deliveries int
+		written    int
+	}{
+		{"ndjson, less than one delivery", ndjsonFormat, 10, streamRowsPerDelivery, 1, 10},
+		{"ndjson, few deliveries", ndjsonFormat, 2*streamRowsPerDelivery + 1, 3 * streamRowsPerDelivery, 3, 2*streamRowsPerDelivery + 1},
+		{"csv, exact deliveries", csvFormat, 2 * streamRowsPerDelivery, 2 * streamRowsPerDelivery, 2, 2 * streamRowsPerDelivery},
+		{"stopped on budget", csvFormat, 3 * streamRowsPerDelivery, streamRowsPerDelivery, 1, streamRowsPerDelivery},
+	}
+
+	for _, v := range tests {
+		t.Run(v.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+
+			writer := newRowWriter(c, v.format, v.budget)
+
+			var err error
+			for i := 0; i < v.rows && err == nil; i++ {
+				err = writer.Write(&d.Block{Number: uint64(i), Hash: "0x01"})
+			}
+
+			if stopped := err == errRowBudgetExhausted; stopped != (uint64(v.rows) > v.budget) {
+				t.Fatalf("unexpected error state : %v", err)
+			}
+
+			deliveries := writer.Deliveries()
+			if len(deliveries) != v.deliveries {
+				t.Fatalf("expected %d deliveries, found %d", v.deliveries, len(deliveries))
+			}
+
+			if writer.Rows() != uint64(v.written) {
+				t.Fatalf("expected %d rows written, found %d", v.written, writer.Rows())
+			}
+
+			total := uint64(0)
+			for _, c := range deliveries {
+				total += c
+			}
+
+			if total != uint64(recorder.Body.Len()) {
+				t.Fatalf("expected %d bytes charged, found %d", recorder.Body.Len(), total)
+			}
+		})
+	}
+}
+
+// openTestStore - Store backed by fresh sqlite database in test's temporary
+// directory, which has only one connection, same as in production
+func openTestStore(t *testing.T) db.Store {
+	dir := t.TempDir()
+
+	lines := []string{
+		"RPCUrl=https://node.example/key",
+		"WebsocketUrl=wss://node.example/key",
+		"Database=sqlite",
+		"SQLitePath=" + filepath.Join(dir, "ette.db"),
+		"RedisAddress=localhost:6379",
+		"EtteMode=3",
+	}
+
+	file := filepath.Join(dir, ".env")
+	if err := os.WriteFile(file, []byte(strings.Join(lines, "\n")+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cfg.Read(file); err != nil {
+		t.Fatal(err)
+	}
+
+	return db.Connect()
+}
+
+func TestRespondWithStreamOnSQLite(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	_db := openTestStore(t)
+
+	// Plan allows exporting three deliveries worth of rows in a day
+	if _, err := _db.ReloadSubscriptionPlans([]*db.Plan{{Name: "basic", DeliveryCount: 3}}); err != nil {
+		t.Fatal(err)
+	}
+
+	address := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	if !_db.RegisterNewApp(address) {
+		t.Fatal("failed to register app")
+	}
+
+	apps := _db.GetAppsByUserAddress(address)
+	if len(apps) != 1 {
+		t.Fatalf("expected one app, found %d", len(apps))
+	}
+
+	count := streamRowsPerDelivery + streamRowsPerDelivery/2
+	for i := 1; i <= count; i++ {
+		block := &db.Blocks{Hash: fmt.Sprintf("0x%064x", i), Number: uint64(i), Time: uint64(1000 + i), Difficulty: "0"}
+
+		if err := _db.StoreBlock(&db.PackedBlock{Block: block}, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// respond - Streams all blocks, failing test, if it doesn't complete
+	respond := func() *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+
+		c, _ := gin.CreateTestContext(recorder)
+		c.Request = httptest.NewRequest(http.MethodGet, "/v1/block", nil)
+		c.Request.Header.Set("APIKey", apps[0].APIKey)
+
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+
+			respondWithStream(_db, c, "/v1/block", ndjsonFormat, func(write func(row) error) error {
+				return _db.StreamBlocksByNumberRange(1, uint64(count), func(b *d.Block) error { return write(b) })
+			})
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(30 * time.Second):
+			t.Fatal("streaming rows didn't complete")
+		}
+
+		return recorder
+	}
+
+	tests := []struct {
+		name      string
+		status    int
+		rows      int
+		remaining uint64
+	}{
+		{"whole range", http.StatusOK, count, 1},
+		{"stopped when plan exhausted", http.StatusOK, streamRowsPerDelivery, 0},
+		{"rate limited", http.StatusTooManyRequests, 0, 0},
+	}
+
+	for _, v := range tests {
+		recorder := respond()
+
+		if recorder.Code != v.status {
+			t.Fatalf("%s : expected status %d, found %d", v.name, v.status, recorder.Code)
+		}
+
+		if v.rows != 0 {
+			if rows := strings.Count(recorder.Body.String(), "\n"); rows != v.rows {
+				t.Fatalf("%s : expected %d rows, found %d", v.name, v.rows, rows)
+			}
+		}
+
+		if remaining := _db.GetRemainingDeliveries(address.Hex()); remaining != v.remaining {
+			t.Fatalf("%s : expected %d deliveries remaining, found %d", v.name, v.remaining, remaining)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/gin-gonic/gin"
+	"github.com/itzmeanjan/ette/app/tracing"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceRequest - Starts server span for each request, named after route
+// template, continuing trace propagated by client in `traceparent` header,
+// if any, so that handlers can create child spans using request context
+func traceRequest(c *gin.Context) {
+
+	route := c.FullPath()
+	if route == "" {
+		route = "unmatched"
+	}
+
+	ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), c.Request.Header)
+
+	ctx, span := tracing.Tracer().Start(ctx, fmt.Sprintf("%s %s", c.Request.Method, route),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(semconv.HTTPServerAttributesFromHTTPRequest("ette", route, c.Request)...))
+	defer span.End()
+
+	c.Request = c.Request.WithContext(ctx)
+
+	c.Next()
+
+	status := c.Writer.Status()
+
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(status)...)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCode(status))
+
+	if user := c.GetString(userKey); user != "" {
+		span.SetAttributes(semconv.EnduserIDKey.String(user))
+	}
+
+}
+
+// traceGraphQL - Starts span for each GraphQL operation, named after
+// operation, or first field queried when operation isn't named
+func traceGraphQL(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+
+	name := "anonymous"
+
+	if oc := graphql.GetOperationContext(ctx); oc.OperationName != "" {
+		name = oc.OperationName
+	} else if oc.Operation != nil && len(oc.Operation.SelectionSet) != 0 {
+		if field, ok := oc.Operation.SelectionSet[0].(*ast.Field); ok {
+			name = field.Name
+		}
+	}
+
+	ctx, span := tracing.Start(ctx, fmt.Sprintf("graphql %s", name), label.String("graphql.operation", name))
+	defer span.End()
+
+	resp := next(ctx)
+	if resp != nil && len(resp.Errors) != 0 {
+		tracing.Fail(ctx, resp.Errors)
+	}
+
+	return resp
+
+}
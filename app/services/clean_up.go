@@ -1,19 +1,17 @@
 package services
 
 import (
-	"log"
 	"time"
 
-	"github.com/gookit/color"
 	"github.com/itzmeanjan/ette/app/db"
-	"gorm.io/gorm"
+	log "github.com/sirupsen/logrus"
 )
 
 // DeliveryHistoryCleanUpService - This function is supposed to be run as
 // an independent go routine, which will invoke self every 24 hours &
 // attempt to clean up all delivery histories for all clients
 // older than recent 24 hours
-func DeliveryHistoryCleanUpService(_db *gorm.DB) {
+func DeliveryHistoryCleanUpService(_db db.Store) {
 
 	for {
 
@@ -22,11 +20,11 @@ func DeliveryHistoryCleanUpService(_db *gorm.DB) {
 		// This syntax invokes following function 24 hours later, last time it completed its job
 		case <-time.After(time.Hour * time.Duration(24)):
 
-			log.Printf(color.Green.Sprintf("[*] Attempting to clean delivery history older than 24 hours"))
+			log.Debug("Attempting to clean delivery history older than 24 hours")
 
-			db.DropOldDeliveryHistories(_db)
+			_db.DropOldDeliveryHistories()
 
-			log.Printf(color.Green.Sprintf("[+] Cleaned delivery history older than 24 hours"))
+			log.Debug("Cleaned delivery history older than 24 hours")
 
 		}
 
@@ -2,30 +2,30 @@ package app
 
 import (
 	"context"
-	"log"
 	"sync"
 
 	"github.com/go-redis/redis/v8"
 	cfg "github.com/itzmeanjan/ette/app/config"
 	d "github.com/itzmeanjan/ette/app/data"
 	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/logger"
 	q "github.com/itzmeanjan/ette/app/queue"
 	"github.com/itzmeanjan/ette/app/rest/graph"
-	"gorm.io/gorm"
+	log "github.com/sirupsen/logrus"
 )
 
 // Setting ground up i.e. acquiring resources required & determining with
 // some basic checks whether we can proceed to next step or not
-func bootstrap(configFile, subscriptionPlansFile string) (*d.BlockChainNodeConnection, *redis.Client, *d.RedisInfo, *gorm.DB, *d.StatusHolder, *q.BlockProcessorQueue) {
+func bootstrap(configFile, subscriptionPlansFile string) (*d.BlockChainNodeConnection, *redis.Client, *d.RedisInfo, db.Store, *d.StatusHolder, *q.BlockProcessorQueue) {
 
+	// Configuration is validated while being read, so any
+	// problem found in `.env`/ `ETTE_*` variables is reported here
 	err := cfg.Read(configFile)
 	if err != nil {
-		log.Fatalf("[!] Failed to read `.env` : %s\n", err.Error())
+		log.WithError(err).Fatal("Failed to read configuration")
 	}
 
-	if !(cfg.Get("EtteMode") == "1" || cfg.Get("EtteMode") == "2" || cfg.Get("EtteMode") == "3" || cfg.Get("EtteMode") == "4" || cfg.Get("EtteMode") == "5") {
-		log.Fatalf("[!] Failed to find `EtteMode` in configuration file\n")
-	}
+	logger.Setup()
 
 	// Maintaining both HTTP & Websocket based connection to blockchain
 	_connection := &d.BlockChainNodeConnection{
@@ -36,31 +36,35 @@ func bootstrap(configFile, subscriptionPlansFile string) (*d.BlockChainNodeConne
 	_redisClient := getRedisClient()
 
 	if _redisClient == nil {
-		log.Fatalf("[!] Failed to connect to Redis Server\n")
+		log.Fatal("Failed to connect to Redis Server")
 	}
 
 	if err := _redisClient.FlushAll(context.Background()).Err(); err != nil {
-		log.Printf("[!] Failed to flush all keys from redis : %s\n", err.Error())
+		log.WithError(err).Warn("Failed to flush all keys from redis")
 	}
 
 	_db := db.Connect()
 
 	// Populating subscription plans from `.plans.json` into
 	// database table, at application start up
-	db.PersistAllSubscriptionPlans(_db, subscriptionPlansFile)
-
-	// Passing db handle, to graph package, so that it can be used
-	// for resolving graphQL queries
-	graph.GetDatabaseConnection(_db)
+	_db.PersistAllSubscriptionPlans(subscriptionPlansFile)
 
 	_status := &d.StatusHolder{
 		State: &d.SyncState{
-			BlockCountAtStartUp:     db.GetBlockCount(_db),
-			MaxBlockNumberAtStartUp: db.GetCurrentBlockNumber(_db),
+			BlockCountAtStartUp:     _db.GetBlockCount(),
+			MaxBlockNumberAtStartUp: _db.GetCurrentBlockNumber(),
 		},
 		Mutex: &sync.RWMutex{},
 	}
 
+	// Query results of confirmed blocks are served from redis,
+	// when enabled, which is why status is required
+	_db = db.WithCache(_db, _redisClient, _status)
+
+	// Passing db handle, to graph package, so that it can be used
+	// for resolving graphQL queries
+	graph.GetDatabaseConnection(_db)
+
 	_redisInfo := &d.RedisInfo{
 		Client:            _redisClient,
 		BlockPublishTopic: "block",
@@ -69,7 +73,7 @@ func bootstrap(configFile, subscriptionPlansFile string) (*d.BlockChainNodeConne
 	}
 
 	// This is block processor queue
-	_queue := q.New(db.GetCurrentBlockNumber(_db))
+	_queue := q.New(_db.GetCurrentBlockNumber())
 
 	return _connection, _redisClient, _redisInfo, _db, _status, _queue
 
@@ -5,20 +5,19 @@ import (
 	"github.com/itzmeanjan/ette/app/data"
 	_db "github.com/itzmeanjan/ette/app/db"
 	pb "github.com/itzmeanjan/ette/app/pb"
-	"gorm.io/gorm"
 )
 
 // BlockToProtoBuf - Creating proto buffer compatible data
 // format for block data, which can be easily serialized & deserialized
 // for taking snapshot and restoring from it
-func BlockToProtoBuf(block *data.Block, db *gorm.DB) *pb.Block {
+func BlockToProtoBuf(block *data.Block, db _db.Store) *pb.Block {
 
 	_block := &pb.Block{
 		Hash:                block.Hash,
 		Number:              block.Number,
 		Time:                block.Time,
 		ParentHash:          block.ParentHash,
-		Difficulty:          block.Difficulty,
+		Difficulty:          string(block.Difficulty),
 		GasUsed:             block.GasUsed,
 		GasLimit:            block.GasLimit,
 		Nonce:               block.Nonce,
@@ -31,7 +30,7 @@ func BlockToProtoBuf(block *data.Block, db *gorm.DB) *pb.Block {
 		ExtraData:           block.ExtraData,
 	}
 
-	txs := _db.GetTransactionsByBlockHash(db, common.HexToHash(block.Hash))
+	txs := db.GetTransactionsByBlockHash(common.HexToHash(block.Hash), nil)
 	if txs == nil {
 		return _block
 	}
@@ -50,7 +49,7 @@ func ProtoBufToBlock(block *pb.Block) *_db.PackedBlock {
 		Number:              block.Number,
 		Time:                block.Time,
 		ParentHash:          block.ParentHash,
-		Difficulty:          block.Difficulty,
+		Difficulty:          data.Numeric(block.Difficulty),
 		GasUsed:             block.GasUsed,
 		GasLimit:            block.GasLimit,
 		Nonce:               block.Nonce,
@@ -69,9 +68,29 @@ func ProtoBufToBlock(block *pb.Block) *_db.PackedBlock {
 		}
 	}
 
+	txs := ProtoBufToTransactions(block.Transactions)
+
+	// Snapshot doesn't carry block number & time with tx(s) & events,
+	// which are denormalised onto them, so filling those from block
+	//
+	// Tx(s) are written in order of their index in block, so
+	// index is same as position
+	for k, t := range txs {
+
+		t.Tx.BlockNumber = block.Number
+		t.Tx.BlockTime = block.Time
+		t.Tx.TxIndex = uint(k)
+
+		for _, e := range t.Events {
+			e.BlockNumber = block.Number
+			e.BlockTime = block.Time
+		}
+
+	}
+
 	return &_db.PackedBlock{
 		Block:        _block,
-		Transactions: ProtoBufToTransactions(block.Transactions),
+		Transactions: txs,
 	}
 
 }
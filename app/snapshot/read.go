@@ -3,7 +3,6 @@ package snapshot
 import (
 	"encoding/binary"
 	"io"
-	"log"
 	"os"
 	"runtime"
 
@@ -11,8 +10,8 @@ import (
 	cfg "github.com/itzmeanjan/ette/app/config"
 	_db "github.com/itzmeanjan/ette/app/db"
 	pb "github.com/itzmeanjan/ette/app/pb"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
-	"gorm.io/gorm"
 )
 
 // RestoreFromSnapshot - Given path to snapshot file and database handle
@@ -23,13 +22,13 @@ import (
 // using multiple workers
 //
 // Workers to be hired from worker pool of specific size.
-func RestoreFromSnapshot(db *gorm.DB, file string) (bool, uint64) {
+func RestoreFromSnapshot(db _db.Store, file string) (bool, uint64) {
 
 	// Opening file in read only mode
 	fd, err := os.OpenFile(file, os.O_RDONLY, 0644)
 	if err != nil {
 
-		log.Printf("[!] Error : %s\n", err.Error())
+		log.WithError(err).WithField("file", file).Error("Failed to open snapshot file")
 		return false, 0
 
 	}
@@ -64,7 +63,7 @@ func RestoreFromSnapshot(db *gorm.DB, file string) (bool, uint64) {
 				break
 			}
 
-			log.Printf("[!] Failed to read chunk size : %s\n", err.Error())
+			log.WithError(err).Error("Failed to read chunk size")
 			break
 
 		}
@@ -80,7 +79,7 @@ func RestoreFromSnapshot(db *gorm.DB, file string) (bool, uint64) {
 
 		if _, err = fd.Read(data); err != nil {
 
-			log.Printf("[!] Failed to read chunk : %s\n", err.Error())
+			log.WithError(err).Error("Failed to read chunk")
 			break
 
 		}
@@ -124,7 +123,7 @@ func RestoreFromSnapshot(db *gorm.DB, file string) (bool, uint64) {
 //
 // Also letting coordinator go routine know that this worker
 // has completed its job
-func ProcessBlock(db *gorm.DB, data []byte, control chan bool) {
+func ProcessBlock(db _db.Store, data []byte, control chan bool) {
 
 	block := UnmarshalData(data)
 	if block == nil {
@@ -136,9 +135,9 @@ func ProcessBlock(db *gorm.DB, data []byte, control chan bool) {
 	// easily used for persisting whole block data into DB
 	_block := ProtoBufToBlock(block)
 
-	if err := _db.StoreBlock(db, _block, nil, nil); err != nil {
+	if err := db.StoreBlock(_block, nil, nil); err != nil {
 
-		log.Printf("[!] Failed to restore block : %s\n", err.Error())
+		log.WithError(err).Error("Failed to restore block")
 
 		control <- false
 		return
@@ -156,7 +155,7 @@ func UnmarshalData(data []byte) *pb.Block {
 	block := &pb.Block{}
 	if err := proto.Unmarshal(data, block); err != nil {
 
-		log.Printf("[!] Failed to deserialize chunk : %s\n", err.Error())
+		log.WithError(err).Error("Failed to deserialize chunk")
 		return nil
 
 	}
@@ -190,7 +189,7 @@ func UnmarshalCoordinator(control <-chan bool, count <-chan uint64, done chan bo
 
 		case c := <-control:
 			if !c {
-				log.Fatalf("[!] Error received by unmarshal coordinator\n")
+				log.Fatal("Error received by unmarshal coordinator")
 			}
 
 			// some worker just let us know it completed
@@ -5,30 +5,29 @@ import (
 	"github.com/itzmeanjan/ette/app/data"
 	_db "github.com/itzmeanjan/ette/app/db"
 	pb "github.com/itzmeanjan/ette/app/pb"
-	"gorm.io/gorm"
 )
 
 // TransactionToProtoBuf - Creating proto buffer compatible data
 // format for transaction data, which can be easily serialized & deserialized
 // for taking snapshot and restoring from it
-func TransactionToProtoBuf(tx *data.Transaction, db *gorm.DB) *pb.Transaction {
+func TransactionToProtoBuf(tx *data.Transaction, db _db.Store) *pb.Transaction {
 
 	_tx := &pb.Transaction{
 		Hash:      tx.Hash,
 		From:      tx.From,
 		To:        tx.To,
 		Contract:  tx.Contract,
-		Value:     tx.Contract,
+		Value:     string(tx.Value),
 		Data:      tx.Data,
 		Gas:       tx.Gas,
-		GasPrice:  tx.GasPrice,
-		Cost:      tx.Cost,
+		GasPrice:  string(tx.GasPrice),
+		Cost:      string(tx.Cost),
 		Nonce:     tx.Nonce,
 		State:     tx.State,
 		BlockHash: tx.BlockHash,
 	}
 
-	events := _db.GetEventsByTransactionHash(db, common.HexToHash(tx.Hash))
+	events := db.GetEventsByTransactionHash(common.HexToHash(tx.Hash), nil)
 	if events == nil {
 		return _tx
 	}
@@ -41,7 +40,7 @@ func TransactionToProtoBuf(tx *data.Transaction, db *gorm.DB) *pb.Transaction {
 // TransactionsToProtoBuf - Creating proto buffer compatible data
 // format for transactions data, which can be easily serialized & deserialized
 // for taking snapshot and restoring from it
-func TransactionsToProtoBuf(txs *data.Transactions, db *gorm.DB) []*pb.Transaction {
+func TransactionsToProtoBuf(txs *data.Transactions, db _db.Store) []*pb.Transaction {
 
 	_txs := make([]*pb.Transaction, len(txs.Transactions))
 
@@ -62,11 +61,11 @@ func ProtoBufToTransaction(tx *pb.Transaction) *_db.PackedTransaction {
 		From:      tx.From,
 		To:        tx.To,
 		Contract:  tx.Contract,
-		Value:     tx.Contract,
+		Value:     data.Numeric(tx.Value),
 		Data:      tx.Data,
 		Gas:       tx.Gas,
-		GasPrice:  tx.GasPrice,
-		Cost:      tx.Cost,
+		GasPrice:  data.Numeric(tx.GasPrice),
+		Cost:      data.Numeric(tx.Cost),
 		Nonce:     tx.Nonce,
 		State:     tx.State,
 		BlockHash: tx.BlockHash,
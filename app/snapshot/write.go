@@ -3,15 +3,14 @@ package snapshot
 import (
 	"encoding/binary"
 	"io"
-	"log"
 	"os"
 	"runtime"
 
 	wp "github.com/gammazero/workerpool"
 	cfg "github.com/itzmeanjan/ette/app/config"
 	_db "github.com/itzmeanjan/ette/app/db"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
-	"gorm.io/gorm"
 )
 
 // TakeSnapshot - Given sink file path & number of blocks to be read from database
@@ -22,12 +21,12 @@ import (
 // This kind of encoding mechanism helps us in encoding & decoding efficiently while
 // gracefully using resources i.e. buffered processing, we get to snapshot very large datasets
 // while consuming too much memory.
-func TakeSnapshot(db *gorm.DB, file string, start uint64, end uint64, count uint64) bool {
+func TakeSnapshot(db _db.Store, file string, start uint64, end uint64, count uint64) bool {
 
 	// checking given block number range correctness
 	if !(start <= end) {
 
-		log.Printf("[!] Ascending block number range required\n")
+		log.WithFields(log.Fields{"from": start, "to": end}).Error("Ascending block number range required")
 		return false
 
 	}
@@ -35,7 +34,7 @@ func TakeSnapshot(db *gorm.DB, file string, start uint64, end uint64, count uint
 	// Truncating/ opening for write/ creating data file, where to store protocol buffer encoded data
 	fd, err := os.OpenFile(file, os.O_TRUNC|os.O_WRONLY|os.O_CREATE, 0644)
 	if err != nil {
-		log.Printf("[!] Failed to open snapshot file : %s\n", err.Error())
+		log.WithError(err).Error("Failed to open snapshot file")
 		return false
 	}
 
@@ -67,7 +66,7 @@ func TakeSnapshot(db *gorm.DB, file string, start uint64, end uint64, count uint
 	for i := start; i <= end; i += step {
 
 		// fetch block numbers, given range & attempt to process them concurrently
-		blocks := _db.GetAllBlockNumbersInRange(db, i, i+step-1)
+		blocks := db.GetAllBlockNumbersInRange(i, i+step-1)
 		if blocks == nil {
 			continue
 		}
@@ -80,7 +79,7 @@ func TakeSnapshot(db *gorm.DB, file string, start uint64, end uint64, count uint
 
 				pool.Submit(func() {
 
-					_block := _db.GetBlockByNumber(db, num)
+					_block := db.GetBlockByNumber(num)
 					if _block == nil {
 						return
 					}
@@ -88,7 +87,7 @@ func TakeSnapshot(db *gorm.DB, file string, start uint64, end uint64, count uint
 					_protocolBufferedBlock, err := proto.Marshal(BlockToProtoBuf(_block, db))
 					if err != nil {
 
-						log.Printf("[!] Failed to serialize block : %s\n", err.Error())
+						log.WithError(err).Error("Failed to serialize block")
 						return
 
 					}
@@ -147,7 +146,7 @@ func PutIntoSink(fd io.Writer, count uint64, data chan []byte, done chan bool) {
 		// first write size of proto message in 4 byte space
 		if _, err := fd.Write(buf); err != nil {
 
-			log.Printf("[!] Failed to write chunk size : %s\n", err.Error())
+			log.WithError(err).Error("Failed to write chunk size")
 			break
 
 		}
@@ -155,7 +154,7 @@ func PutIntoSink(fd io.Writer, count uint64, data chan []byte, done chan bool) {
 		// then write actual message
 		if _, err := fd.Write(d); err != nil {
 
-			log.Printf("[!] Failed to write chunk : %s\n", err.Error())
+			log.WithError(err).Error("Failed to write chunk")
 			break
 
 		}
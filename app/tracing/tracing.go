@@ -0,0 +1,91 @@
+package tracing
+
+import (
+	"context"
+	"os"
+
+	cfg "github.com/itzmeanjan/ette/app/config"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/propagation"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Name of instrumentation, all spans created by `ette` are attributed to
+const instrumentation = "github.com/itzmeanjan/ette"
+
+// Setup - Starts exporting spans using `TraceExporter`, returning function
+// to be invoked, during shutdown, for flushing spans not yet exported
+//
+// When tracing isn't enabled, global tracer provider is kept as it's i.e.
+// no-op one, so that creating spans costs nothing
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+
+	// Trace context is propagated using W3C `traceparent` header
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !cfg.Current().TracingEnabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.ServiceNameKey.String("ette"))),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	log.WithField("exporter", cfg.Current().TraceExporter).Info("Exporting traces")
+
+	return provider.Shutdown, nil
+
+}
+
+// newExporter - Creates span exporter, chosen using `TraceExporter` i.e.
+// either OTLP collector, over gRPC, or stdout, for local testing
+func newExporter(ctx context.Context) (exporttrace.SpanExporter, error) {
+
+	if cfg.Current().TraceExporter == "stdout" {
+		return stdout.NewExporter(stdout.WithWriter(os.Stdout), stdout.WithoutMetricExport())
+	}
+
+	opts := []otlpgrpc.Option{otlpgrpc.WithEndpoint(cfg.Current().OTLPEndpoint)}
+	if cfg.Current().IsOTLPInsecure() {
+		opts = append(opts, otlpgrpc.WithInsecure())
+	}
+
+	return otlp.NewExporter(ctx, otlpgrpc.NewDriver(opts...))
+
+}
+
+// Tracer - Returns tracer, all spans created by `ette` are to be started with
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentation)
+}
+
+// Start - Starts span, as child of one present in context, if any
+func Start(ctx context.Context, name string, attrs ...label.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// Fail - Marks span present in context failed, recording error, which caused it
+func Fail(ctx context.Context, err error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,136 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// Address ranges webhooks can't be delivered to, because they're reachable
+// only from inside network `ette` is running in i.e. loopback, private,
+// link-local ( cloud metadata endpoints ) & unspecified ones
+var blockedNetworks = func() []*net.IPNet {
+	cidrs := []string{
+		"0.0.0.0/8",
+		"10.0.0.0/8",
+		"100.64.0.0/10",
+		"127.0.0.0/8",
+		"169.254.0.0/16",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"::/128",
+		"::1/128",
+		"fc00::/7",
+		"fe80::/10",
+	}
+
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, v := range cidrs {
+		_, network, err := net.ParseCIDR(v)
+		if err != nil {
+			panic(err)
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks
+}()
+
+// ErrBlockedAddress - Webhook resolves to address, which is not publicly routable
+var ErrBlockedAddress = errors.New("webhook resolves to non-public address")
+
+// IsBlockedIP - Checks whether webhook deliveries to given address are to be refused
+func IsBlockedIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() {
+		return true
+	}
+
+	for _, v := range blockedNetworks {
+		if v.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateURL - Checks whether webhook URL is acceptable i.e. http(s) one, whose
+// host resolves only to publicly routable addresses
+//
+// Resolved addresses are checked again, when connecting, because DNS
+// answers can change after webhook gets registered
+func ValidateURL(ctx context.Context, raw string) error {
+	_url, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+
+	if !(_url.Scheme == "http" || _url.Scheme == "https") || _url.Hostname() == "" {
+		return errors.New("webhook URL must be http(s) one, with host")
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, _url.Hostname())
+	if err != nil {
+		return err
+	}
+
+	for _, v := range addrs {
+		if IsBlockedIP(v.IP) {
+			return ErrBlockedAddress
+		}
+	}
+
+	return nil
+}
+
+// guardedDial - Invoked after address got resolved, but before connecting,
+// refusing to connect to blocked ones
+func guardedDial(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("bad address `%s`", address)
+	}
+
+	if IsBlockedIP(ip) {
+		return ErrBlockedAddress
+	}
+
+	return nil
+}
+
+// newClient - HTTP client to be used for webhook deliveries, which only
+// connects to publicly routable addresses & doesn't follow redirects, so
+// that it can't be made to reach internal services
+func newClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   requestTimeout,
+		KeepAlive: 30 * time.Second,
+		Control:   guardedDial,
+	}
+
+	return &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   requestTimeout,
+			ExpectContinueTimeout: time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return errors.New("webhook redirects aren't followed")
+		},
+	}
+}
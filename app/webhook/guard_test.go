@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsBlockedIP(t *testing.T) {
+	tests := []struct {
+		ip      string
+		blocked bool
+	}{
+		{"127.0.0.1", true},
+		{"10.1.2.3", true},
+		{"172.16.0.1", true},
+		{"192.168.1.1", true},
+		{"169.254.169.254", true},
+		{"100.64.0.1", true},
+		{"0.0.0.0", true},
+		{"::1", true},
+		{"::", true},
+		{"fe80::1", true},
+		{"fd00::1", true},
+		{"::ffff:127.0.0.1", true},
+		{"8.8.8.8", false},
+		{"2606:4700:4700::1111", false},
+	}
+
+	for _, v := range tests {
+		t.Run(v.ip, func(t *testing.T) {
+			if blocked := IsBlockedIP(net.ParseIP(v.ip)); blocked != v.blocked {
+				t.Fatalf("expected blocked %v, found %v", v.blocked, blocked)
+			}
+		})
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{"ftp://8.8.8.8/hook", false},
+		{"http:///hook", false},
+		{"http://127.0.0.1:8080/hook", false},
+		{"http://169.254.169.254/latest/meta-data", false},
+		{"https://[::1]/hook", false},
+		{"https://8.8.8.8/hook", true},
+	}
+
+	for _, v := range tests {
+		t.Run(v.url, func(t *testing.T) {
+			if err := ValidateURL(context.Background(), v.url); (err == nil) != v.valid {
+				t.Fatalf("expected valid %v, found error %v", v.valid, err)
+			}
+		})
+	}
+}
+
+func TestClientRefusesLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	if _, err := newClient().Post(server.URL, "application/json", nil); err == nil {
+		t.Fatal("expected delivery to loopback address to be refused")
+	}
+}
@@ -0,0 +1,362 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	d "github.com/itzmeanjan/ette/app/data"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/metrics"
+	ps "github.com/itzmeanjan/ette/app/pubsub"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// How many deliveries are attempted concurrently
+	workerCount = 8
+	// How many deliveries can wait for being attempted, beyond
+	// which new ones are dropped, instead of blocking pubsub listener
+	queueSize = 1024
+	// How many times delivery is attempted, before giving up
+	maxAttempts = 5
+	// Delay before first retry, which gets doubled for each next one
+	retryBackoff = 2 * time.Second
+	// How long single attempt can take, including reading response
+	requestTimeout = 10 * time.Second
+	// How often registered webhooks are re-read from database
+	refreshInterval = 10 * time.Second
+	// How long delivery log is kept for
+	deliveryRetention = 7 * 24 * time.Hour
+	// How long reason of failure, kept in delivery log, can be
+	maxErrorLength = 500
+)
+
+// delivery - Payload to be delivered to webhook, along with its log entry,
+// which is persisted by worker, when it picks delivery up for first time
+type delivery struct {
+	webhook   *db.Webhooks
+	entry     *db.WebhookDeliveries
+	payload   []byte
+	persisted bool
+}
+
+// Dispatcher - Listens for real-time data published to Redis, same as websocket
+// consumers do, & delivers it to all webhooks, whose subscription name matches,
+// signing payload using webhook's secret
+type Dispatcher struct {
+	DB       db.Store
+	Redis    *d.RedisInfo
+	Client   *http.Client
+	Webhooks []*db.Webhooks
+	Lock     *sync.RWMutex
+	Queue    chan *delivery
+}
+
+// Start - Starts dispatching real-time data to webhooks, until context gets
+// cancelled, to be run as independent go routine
+func Start(ctx context.Context, _db db.Store, _redis *d.RedisInfo) {
+
+	dispatcher := &Dispatcher{
+		DB:     _db,
+		Redis:  _redis,
+		Client: newClient(),
+		Lock:   &sync.RWMutex{},
+		Queue:  make(chan *delivery, queueSize),
+	}
+
+	dispatcher.Refresh()
+
+	for i := 0; i < workerCount; i++ {
+		go dispatcher.Work(ctx)
+	}
+
+	dispatcher.Listen(ctx)
+
+}
+
+// Refresh - Re-reads enabled webhooks from database, so that ones registered/
+// toggled/ deleted by users get picked up
+func (w *Dispatcher) Refresh() {
+
+	webhooks := w.DB.GetEnabledWebhooks()
+	if webhooks == nil {
+		return
+	}
+
+	w.Lock.Lock()
+	defer w.Lock.Unlock()
+
+	w.Webhooks = webhooks
+
+}
+
+// Listen - Subscribes to block, tx & event topics, dispatching each piece of
+// data published there, while periodically refreshing webhooks & dropping
+// old delivery log entries
+func (w *Dispatcher) Listen(ctx context.Context) {
+
+	pubsub := w.Redis.Client.Subscribe(ctx, w.Redis.BlockPublishTopic, w.Redis.TxPublishTopic, w.Redis.EventPublishTopic)
+	defer func() {
+		if err := pubsub.Close(); err != nil {
+			log.WithError(err).Error("Failed to close webhook pubsub subscription")
+		}
+	}()
+
+	refresh := time.NewTicker(refreshInterval)
+	defer refresh.Stop()
+
+	cleanUp := time.NewTicker(time.Hour)
+	defer cleanUp.Stop()
+
+	messages := pubsub.Channel()
+
+	for {
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case <-refresh.C:
+			w.Refresh()
+
+		case <-cleanUp.C:
+			w.DB.DropOldWebhookDeliveries(time.Now().UTC().Add(-deliveryRetention))
+
+		case m, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			w.Dispatch(m)
+
+		}
+
+	}
+
+}
+
+// Dispatch - Finds out webhooks interested in published data & queues
+// delivery to each of them
+//
+// Nothing is written to database here, so that pubsub listener doesn't
+// fall behind, when database is slow
+func (w *Dispatcher) Dispatch(m *redis.Message) {
+
+	var topic string
+
+	switch m.Channel {
+
+	case w.Redis.BlockPublishTopic:
+		topic = "block"
+	case w.Redis.TxPublishTopic:
+		topic = "transaction"
+	case w.Redis.EventPublishTopic:
+		topic = "event"
+	default:
+		return
+
+	}
+
+	matches := ps.PublishedDataMatcher(topic, m.Payload)
+	if matches == nil {
+		return
+	}
+
+	// -- Obtaining read lock
+	w.Lock.RLock()
+	webhooks := w.Webhooks
+	w.Lock.RUnlock()
+	// -- Unlocking shared resource
+
+	for _, v := range webhooks {
+
+		if !matches(&ps.SubscriptionRequest{Name: v.Name}) {
+			continue
+		}
+
+		entry := &db.WebhookDeliveries{
+			ID:         uuid.New().String(),
+			WebhookID:  v.ID,
+			Topic:      topic,
+			DataLength: uint64(len(m.Payload)),
+			TimeStamp:  time.Now().UTC(),
+		}
+
+		w.Enqueue(&delivery{webhook: v, entry: entry, payload: []byte(m.Payload)})
+
+	}
+
+}
+
+// Enqueue - Queues delivery to be attempted by one of workers, where it's
+// dropped, when queue is full
+//
+// Drop is recorded in delivery log only for retries, which are already
+// persisted, because fresh ones are queued from pubsub listener
+func (w *Dispatcher) Enqueue(_delivery *delivery) {
+
+	select {
+
+	case w.Queue <- _delivery:
+
+	default:
+
+		if !_delivery.persisted {
+			log.WithFields(log.Fields{"webhook": _delivery.webhook.ID, "topic": _delivery.entry.Topic}).Warn("Dropped webhook delivery, queue full")
+			return
+		}
+
+		_delivery.entry.Error = "Delivery queue full"
+		w.DB.UpdateWebhookDelivery(_delivery.entry)
+
+	}
+
+}
+
+// Work - Attempts deliveries from queue, one after another, until
+// context gets cancelled
+func (w *Dispatcher) Work(ctx context.Context) {
+
+	for {
+
+		select {
+
+		case <-ctx.Done():
+			return
+
+		case v := <-w.Queue:
+			w.Attempt(ctx, v)
+
+		}
+
+	}
+
+}
+
+// Attempt - Attempts to deliver payload to webhook, given API key it's registered
+// against is still enabled & its user is under rate limit, scheduling retry with
+// exponential backoff, when it fails
+//
+// Successful delivery is counted against subscription plan of user, same as
+// data delivered over websocket
+func (w *Dispatcher) Attempt(ctx context.Context, _delivery *delivery) {
+
+	if !_delivery.persisted {
+		if !w.DB.PutWebhookDelivery(_delivery.entry) {
+			return
+		}
+
+		_delivery.persisted = true
+	}
+
+	user := w.DB.GetUserFromAPIKey(_delivery.webhook.APIKey)
+	if user == nil || !user.Enabled {
+
+		_delivery.entry.Error = "Bad API Key"
+		w.DB.UpdateWebhookDelivery(_delivery.entry)
+		return
+
+	}
+
+	if !w.DB.IsUnderRateLimit(user.Address) {
+
+		metrics.RateLimited("webhook")
+
+		_delivery.entry.Error = "Crossed Allowed Rate Limit"
+		w.DB.UpdateWebhookDelivery(_delivery.entry)
+		return
+
+	}
+
+	now := time.Now().UTC()
+
+	_delivery.entry.Attempts++
+	_delivery.entry.LastAttempt = &now
+	_delivery.entry.StatusCode, _delivery.entry.Error = w.Post(ctx, _delivery)
+	_delivery.entry.Delivered = _delivery.entry.Error == ""
+
+	if len(_delivery.entry.Error) > maxErrorLength {
+		_delivery.entry.Error = _delivery.entry.Error[:maxErrorLength]
+	}
+
+	w.DB.UpdateWebhookDelivery(_delivery.entry)
+
+	if _delivery.entry.Delivered {
+		w.DB.PutDataDeliveryInfo(user.Address, fmt.Sprintf("/v1/webhook/%s", _delivery.entry.Topic), uint64(len(_delivery.payload)))
+		metrics.Delivered("webhook", _delivery.entry.Topic)
+		return
+	}
+
+	if _delivery.entry.Attempts >= maxAttempts {
+		log.WithFields(log.Fields{"webhook": _delivery.webhook.ID, "user": user.Address, "attempts": _delivery.entry.Attempts, "error": _delivery.entry.Error}).Warn("Failed to deliver to webhook")
+		return
+	}
+
+	time.AfterFunc(retryBackoff<<(_delivery.entry.Attempts-1), func() {
+
+		select {
+		case <-ctx.Done():
+		default:
+			w.Enqueue(_delivery)
+		}
+
+	})
+
+}
+
+// Post - POST-s payload to webhook, returning status code received, if any,
+// along with reason of failure, which is empty when webhook responded with 2xx
+//
+// Payload is signed using HMAC-SHA256, keyed with webhook's secret, which is
+// sent in `X-Ette-Signature` header
+func (w *Dispatcher) Post(ctx context.Context, _delivery *delivery) (int, string) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, _delivery.webhook.URL, bytes.NewReader(_delivery.payload))
+	if err != nil {
+		return 0, err.Error()
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ette")
+	req.Header.Set("X-Ette-Delivery", _delivery.entry.ID)
+	req.Header.Set("X-Ette-Subscription", _delivery.webhook.Name)
+	req.Header.Set("X-Ette-Signature", fmt.Sprintf("sha256=%s", Sign(_delivery.webhook.Secret, _delivery.payload)))
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return 0, err.Error()
+	}
+
+	defer resp.Body.Close()
+
+	// Response body isn't of any use, but reading it lets
+	// underlying connection be reused
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Sprintf("Responded with %s", resp.Status)
+	}
+
+	return resp.StatusCode, ""
+
+}
+
+// Sign - Computes hex encoded HMAC-SHA256 of payload, keyed with secret
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -1,13 +1,15 @@
 -- Note : This file is never going to be used by `ette`
 --
--- This is provided more sake of better human readability
+-- This is provided more sake of better human readability, reflecting
+-- schema after applying all versioned migrations, which are defined in
+-- `app/db/migrations.go` & applied using `ette migrate up` or during start up
 
 create table blocks (
     hash char(66) primary key,
-    number varchar not null unique,
+    number bigint not null unique,
     time bigint not null,
     parenthash char(66) not null,
-    difficulty varchar not null,
+    difficulty numeric(78,0) not null,
     gasused bigint not null,
     gaslimit bigint not null,
     nonce varchar not null,
@@ -17,7 +19,8 @@ create table blocks (
     unclehash char(66) not null,
     txroothash char(66) not null,
     receiptroothash char(66) not null,
-    extradata bytea
+    extradata bytea,
+    basefee numeric(78,0)
 );
 
 create index on blocks(number asc);
@@ -25,41 +28,106 @@ create index on blocks(time asc);
 
 create table transactions (
     hash char(66) primary key,
-    from char(42) not null,
-    to char(42),
+    "from" char(42) not null,
+    "to" char(42),
     contract char(42),
-    value varchar,
+    value numeric(78,0),
     data bytea,
     gas bigint not null,
-    gasprice varchar not null,
-    cost varchar not null,
+    gasprice numeric(78,0) not null,
+    cost numeric(78,0) not null,
     nonce bigint not null,
     state smallint not null,
     blockhash char(66) not null,
+    blocknumber bigint not null,
+    blocktime bigint not null,
+    txindex integer not null default 0,
     foreign key (blockhash) references blocks(hash) on delete cascade
 );
 
-create index on transactions(from);
-create index on transactions(to);
+create index on transactions("from", blocknumber);
+create index on transactions("from", blocktime);
+create index on transactions("to", blocknumber);
+create index on transactions("to", blocktime);
 create index on transactions(contract);
 create index on transactions(nonce);
 create index on transactions(blockhash);
+create index on transactions(blocknumber);
+create index on transactions(blocktime);
+create index on transactions(blocknumber, txindex);
+create index on transactions(value);
 
 create table events (
     origin char(42) not null,
-    index integer not null,
+    "index" integer not null,
     topics text[] not null,
+    topic0 char(66),
+    topic1 char(66),
+    topic2 char(66),
+    topic3 char(66),
     data bytea,
     txhash char(66) not null,
     blockhash char(66) not null,
-    primary key (blockhash, index),
+    blocknumber bigint not null,
+    blocktime bigint not null,
+    primary key (blockhash, "index"),
     foreign key (txhash) references transactions(hash) on delete cascade,
     foreign key (blockhash) references blocks(hash) on delete cascade
 );
 
-create index on events(origin);
 create index on events(txhash);
-create index on events using gin(topics);
+create index on events(blocknumber);
+create index on events(blocktime);
+create index on events(origin, blocknumber);
+create index on events(origin, blocktime);
+create index on events(topic0);
+create index on events(topic1);
+create index on events(topic2);
+create index on events(topic3);
+create index on events(origin, topic0);
+create index on events(origin, topic1);
+create index on events(origin, topic2);
+create index on events(origin, topic3);
+
+-- When `Partitioning` is enabled, `blocks`, `transactions` & `events` are range
+-- partitioned by block number i.e. `partition by range (number)` & `partition by range (blocknumber)`,
+-- where block number becomes part of their primary keys & foreign keys, partitions being
+-- named as `<table>_from_<first block number>`
+
+create table accounts (
+    address char(42) primary key,
+    firstseen bigint not null,
+    lastseen bigint not null,
+    sent bigint not null default 0,
+    received bigint not null default 0,
+    deployed bigint not null default 0,
+    emitted bigint not null default 0,
+    nonce bigint,
+    createdat bigint
+);
+
+create table chain_stats (
+    period varchar(4) not null,
+    start bigint not null,
+    blocks bigint not null default 0,
+    txcount bigint not null default 0,
+    gasused bigint not null default 0,
+    gaspricesum numeric(78,0) not null default 0,
+    basefeesum numeric(78,0) not null default 0,
+    basefeeblocks bigint not null default 0,
+    activesenders bigint not null default 0,
+    contracts bigint not null default 0,
+    events bigint not null default 0,
+    primary key (period, start)
+);
+
+create table chain_stats_senders (
+    period varchar(4) not null,
+    start bigint not null,
+    address char(42) not null,
+    txcount bigint not null,
+    primary key (period, start, address)
+);
 
 create table users (
     address char(42) not null,
@@ -94,3 +162,38 @@ create table subscription_details (
 );
 
 create index on subscription_details(subscriptionplan);
+
+create table webhooks (
+    id uuid default gen_random_uuid() primary key,
+    apikey char(66) not null,
+    name varchar(1000) not null,
+    url varchar(2000) not null,
+    secret char(64) not null,
+    enabled boolean not null default true,
+    ts timestamp not null,
+    foreign key (apikey) references users(apikey) on delete cascade
+);
+
+create index on webhooks(apikey);
+
+create table webhook_deliveries (
+    id uuid default gen_random_uuid() primary key,
+    webhookid uuid not null,
+    topic varchar(20) not null,
+    datalength bigint not null,
+    attempts bigint not null default 0,
+    statuscode integer not null default 0,
+    error varchar(500) not null default '',
+    delivered boolean not null default false,
+    ts timestamp not null,
+    lastattempt timestamp,
+    foreign key (webhookid) references webhooks(id) on delete cascade
+);
+
+create index on webhook_deliveries(webhookid, ts);
+
+create table schema_migrations (
+    version bigint primary key,
+    description varchar(255) not null,
+    appliedat timestamp not null
+);
@@ -1,22 +1,135 @@
 package main
 
 import (
-	"log"
+	"fmt"
+	"os"
 	"path/filepath"
 
 	"github.com/itzmeanjan/ette/app"
+	cfg "github.com/itzmeanjan/ette/app/config"
+	"github.com/itzmeanjan/ette/app/db"
+	"github.com/itzmeanjan/ette/app/logger"
+	log "github.com/sirupsen/logrus"
 )
 
+const usage = "supported : `ette`, `ette config check` or `ette migrate up|down|status`"
+
 func main() {
 	configFile, err := filepath.Abs(".env")
 	if err != nil {
-		log.Fatalf("[!] Failed to find `.env` : %s\n", err.Error())
+		log.WithError(err).Fatal("Failed to find `.env`")
+	}
+
+	if len(os.Args) > 1 {
+		if len(os.Args) != 3 {
+			log.Fatal("Unknown command, " + usage)
+		}
+
+		switch os.Args[1] {
+		case "config":
+			checkConfig(configFile, os.Args[2])
+		case "migrate":
+			migrate(configFile, os.Args[2])
+		default:
+			log.Fatal("Unknown command, " + usage)
+		}
+
+		return
 	}
 
 	subscriptionPlansFile, err := filepath.Abs(".plans.json")
 	if err != nil {
-		log.Fatalf("[!] Failed to find `.plans.json` : %s\n", err.Error())
+		log.WithError(err).Fatal("Failed to find `.plans.json`")
 	}
 
 	app.Run(configFile, subscriptionPlansFile)
 }
+
+// `ette config check` : validates configuration & prints
+// effective one, with secrets redacted, without starting `ette`
+func checkConfig(configFile string, action string) {
+	if action != "check" {
+		log.Fatal("Unknown command, " + usage)
+	}
+
+	if err := cfg.Read(configFile); err != nil {
+		log.WithError(err).Fatal("Failed to read configuration")
+	}
+
+	if err := cfg.Current().Write(os.Stdout); err != nil {
+		log.WithError(err).Fatal("Failed to write configuration")
+	}
+}
+
+// `ette migrate up|down|status` : manages versioned schema
+// migrations, without starting `ette`
+func migrate(configFile string, action string) {
+	if !(action == "up" || action == "down" || action == "status") {
+		log.Fatal("Unknown command, " + usage)
+	}
+
+	if err := cfg.Read(configFile); err != nil {
+		log.WithError(err).Fatal("Failed to read configuration")
+	}
+
+	logger.Setup()
+
+	migrator := db.NewMigrator()
+
+	// `log.Fatal` exits without running deferred calls, so connection
+	// is released explicitly, before bailing out
+	fatal := func(err error, msg string) {
+		migrator.Close()
+		log.WithError(err).Fatal(msg)
+	}
+
+	switch action {
+
+	case "up":
+		applied, err := migrator.Up()
+		for _, v := range applied {
+			log.WithFields(log.Fields{"version": v.Version, "description": v.Description}).Info("Applied migration")
+		}
+
+		if err != nil {
+			fatal(err, "Failed to apply migrations")
+		}
+
+		if len(applied) == 0 {
+			log.Info("Nothing to apply")
+		}
+
+	case "down":
+		reverted, err := migrator.Down()
+		if err != nil {
+			fatal(err, "Failed to revert migration")
+		}
+
+		if reverted == nil {
+			log.Info("Nothing to revert")
+			break
+		}
+
+		log.WithFields(log.Fields{"version": reverted.Version, "description": reverted.Description}).Info("Reverted migration")
+
+	case "status":
+		status, err := migrator.Status()
+		if err != nil {
+			fatal(err, "Failed to get migration status")
+		}
+
+		for _, v := range status {
+			if v.Applied {
+				fmt.Printf("%4d  applied at %s  %s\n", v.Version, v.AppliedAt.Format("2006-01-02 15:04:05"), v.Description)
+				continue
+			}
+
+			fmt.Printf("%4d  pending                         %s\n", v.Version, v.Description)
+		}
+
+	}
+
+	if err := migrator.Close(); err != nil {
+		log.WithError(err).Error("Failed to close database connection")
+	}
+}